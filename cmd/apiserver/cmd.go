@@ -4,6 +4,7 @@ import (
 	go_web "go-web"
 	"go-web/pkg/config"
 	"go-web/pkg/log"
+	"go-web/pkg/password"
 	"os"
 	"runtime/debug"
 
@@ -32,12 +33,27 @@ func main() {
 		os.Exit(1)
 	}
 
+	// 初始化密码哈希算法
+	if err := password.Setup(cfg); err != nil {
+		logger.Sugar().Errorf("password setup error: %v", err)
+		os.Exit(1)
+	}
+
 	// 执行数据库迁移
 	if err := go_web.Migrate(cfg); err != nil {
 		logger.Sugar().Errorf("db migrate error: %v", err)
 		os.Exit(1)
 	}
 
+	// 执行子命令
+	if len(os.Args) > 1 && os.Args[1] == "import-users" {
+		if err := importUsers(cfg, logger, os.Args[2:]); err != nil {
+			logger.Sugar().Errorf("import users error: %v", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	// 创建并启动服务器
 	server, err := Create(cfg)
 	if err != nil {
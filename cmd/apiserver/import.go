@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"go-web/pkg/config"
+	"go-web/pkg/mysql"
+	"go-web/pkg/tenancy"
+	"go-web/pkg/userimport"
+	"go-web/pkg/viewer"
+
+	"go.uber.org/zap"
+)
+
+// importUsers 执行 import-users 子命令，从 CSV 或 JSONL 文件批量导入用户
+func importUsers(cfg *config.Config, logger *zap.Logger, args []string) error {
+	fs := flag.NewFlagSet("import-users", flag.ContinueOnError)
+	file := fs.String("file", "", "CSV or JSONL file to import, - reads from stdin")
+	format := fs.String("format", "", "file format, csv or jsonl; inferred from the file extension by default")
+	tenant := fs.String("tenant", cfg.Tenant.Default, "slug of the tenant to import users into")
+	batchSize := fs.Int("batch-size", userimport.DefaultBatchSize, "number of users written per batch")
+	dryRun := fs.Bool("dry-run", false, "validate the file without writing users")
+	reportPath := fs.String("report", "", "CSV file receiving rows that failed or were skipped")
+	offset := fs.Int("offset", 0, "skip records on lines up to and including this one, to resume an interrupted import")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *file == "" {
+		return errors.New("-file is required")
+	}
+
+	f := userimport.Format(strings.ToLower(*format))
+	if f == "" {
+		switch strings.ToLower(filepath.Ext(*file)) {
+		case ".csv":
+			f = userimport.FormatCSV
+		case ".jsonl", ".ndjson":
+			f = userimport.FormatJSONL
+		default:
+			return errors.New("cannot infer the file format, use -format")
+		}
+	}
+
+	var in io.Reader = os.Stdin
+	if *file != "-" {
+		src, err := os.Open(*file)
+		if err != nil {
+			return err
+		}
+		defer src.Close()
+		in = src
+	}
+
+	var report io.Writer
+	if *reportPath != "" {
+		out, err := os.Create(*reportPath)
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+		report = out
+	}
+
+	client := mysql.NewMysql(cfg, logger)
+	ctx := viewer.NewSystemContext(context.Background())
+	tenantID, err := tenancy.NewResolver(cfg, client).Resolve(ctx, *tenant)
+	if err != nil {
+		return fmt.Errorf("failed to resolve tenant %q: %w", *tenant, err)
+	}
+	ctx = tenancy.NewContext(ctx, tenantID)
+
+	result, err := userimport.New(client, logger).Run(ctx, in, report, userimport.Options{
+		Format:    f,
+		BatchSize: *batchSize,
+		DryRun:    *dryRun,
+		Offset:    *offset,
+	})
+	if result != nil {
+		logger.Info("import users finished",
+			zap.Bool("dry_run", *dryRun),
+			zap.Int("read", result.Read),
+			zap.Int("imported", result.Imported),
+			zap.Int("skipped", result.Skipped),
+			zap.Int("failed", result.Failed),
+			zap.Int("committed_line", result.Committed),
+		)
+	}
+	if err != nil {
+		return err
+	}
+	if result.Failed > 0 {
+		return fmt.Errorf("%d rows failed", result.Failed)
+	}
+	return nil
+}
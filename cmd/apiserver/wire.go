@@ -7,11 +7,19 @@ import (
 	go_web "go-web"
 	"go-web/interface/http"
 	"go-web/interface/router"
+	"go-web/pkg/auth"
 	"go-web/pkg/cache"
 	"go-web/pkg/config"
+	"go-web/pkg/erasure"
+	"go-web/pkg/export"
 	"go-web/pkg/log"
 	"go-web/pkg/mysql"
+	"go-web/pkg/notify"
+	"go-web/pkg/oidc"
 	"go-web/pkg/redis"
+	"go-web/pkg/storage"
+	"go-web/pkg/tenancy"
+	"go-web/pkg/upload"
 
 	"github.com/google/wire"
 )
@@ -25,6 +33,14 @@ func Create(cfg *config.Config) (*go_web.Server, error) {
 		redis.ProviderSet,
 		cache.ProviderSet,
 		router.ProviderSet,
+		auth.ProviderSet,
+		oidc.ProviderSet,
+		notify.ProviderSet,
+		tenancy.ProviderSet,
+		export.ProviderSet,
+		erasure.ProviderSet,
+		storage.ProviderSet,
+		upload.ProviderSet,
 	)
 	return nil, nil
 }
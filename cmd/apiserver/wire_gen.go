@@ -9,13 +9,23 @@ package main
 import (
 	"go-web"
 	"go-web/interface/http"
+	"go-web/interface/http/handler"
+	"go-web/interface/http/middleware"
 	"go-web/interface/resolvers"
 	"go-web/interface/router"
+	"go-web/pkg/auth"
 	"go-web/pkg/cache"
 	"go-web/pkg/config"
+	"go-web/pkg/erasure"
+	"go-web/pkg/export"
 	"go-web/pkg/log"
 	"go-web/pkg/mysql"
+	"go-web/pkg/notify"
+	"go-web/pkg/oidc"
 	"go-web/pkg/redis"
+	"go-web/pkg/storage"
+	"go-web/pkg/tenancy"
+	"go-web/pkg/upload"
 )
 
 // Injectors from wire.go:
@@ -27,14 +37,44 @@ func Create(cfg *config.Config) (*go_web.Server, error) {
 	if err != nil {
 		return nil, err
 	}
+	tokenManager := auth.NewTokenManager(cfg)
 	client := mysql.NewMysql(cfg, logger)
+	tokenService := auth.NewTokenService(tokenManager, redisClient, client, logger)
+	sessionStore := auth.NewSessionStore(cfg, redisClient, client, logger)
+	apiKeyService := auth.NewAPIKeyService(client, logger)
+	resolver := tenancy.NewResolver(cfg, client)
 	service := redis.NewRedis(context)
-	graphConfig := resolvers.NewConfig(client, service, logger)
+	authenticator := auth.NewAuthenticator(client, logger)
+	authMetrics := middleware.NewAuthMetrics()
+	loginGuard := auth.NewLoginGuard(cfg, redisClient, authMetrics, logger)
+	twoFactorService := auth.NewTwoFactorService(cfg, client, redisClient, loginGuard, logger)
+	notifier, err := notify.NewNotifier(cfg, logger)
+	if err != nil {
+		return nil, err
+	}
+	passwordService := auth.NewPasswordService(cfg, client, notifier, tokenService, sessionStore, logger)
+	emailService := auth.NewEmailService(cfg, client, notifier, logger)
+	exportService, err := export.NewService(cfg, client, logger)
+	if err != nil {
+		return nil, err
+	}
+	storageStorage, err := storage.NewStorage(cfg, logger)
+	if err != nil {
+		return nil, err
+	}
+	uploadService := upload.NewService(cfg, client, storageStorage, logger)
+	erasureService := erasure.NewService(cfg, client, tokenService, sessionStore, exportService, uploadService, logger)
+	graphConfig := resolvers.NewConfig(cfg, client, service, logger, authenticator, tokenService, sessionStore, apiKeyService, twoFactorService, loginGuard, passwordService, emailService, exportService, erasureService, uploadService)
 	client2 := redis.ProvideGoRedisClient(service)
-	server := resolvers.NewGraphqlHandler(graphConfig, client, client2, logger)
-	initRoutersFunc := router.CreateInitRoutesFunc(server)
-	engine := http.NewRouter(logger, redisClient, initRoutersFunc)
+	server := resolvers.NewGraphqlHandler(cfg, graphConfig, client, client2, logger)
+	registry := oidc.NewRegistry(cfg)
+	oidcService := auth.NewOIDCService(cfg, registry, redisClient, client, logger)
+	oidcHandler := handler.NewOIDCHandler(cfg, oidcService, tokenService, sessionStore, twoFactorService, logger)
+	exportHandler := handler.NewExportHandler(exportService, logger)
+	fileHandler := handler.NewFileHandler(uploadService, logger)
+	initRoutersFunc := router.CreateInitRoutesFunc(server, oidcHandler, exportHandler, fileHandler)
+	engine := http.NewRouter(cfg, logger, redisClient, tokenService, sessionStore, apiKeyService, resolver, initRoutersFunc)
 	httpServer := http.NewServer(logger, engine)
-	go_webServer := go_web.NewServer(context, httpServer, logger, cfg)
+	go_webServer := go_web.NewServer(context, httpServer, erasureService, logger, cfg)
 	return go_webServer, nil
 }
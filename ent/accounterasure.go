@@ -0,0 +1,208 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"go-web/ent/accounterasure"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// AccountErasure is the model entity for the AccountErasure schema.
+type AccountErasure struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// 所属租户
+	TenantID uint64 `json:"tenant_id,omitempty"`
+	// 注销的用户
+	UserID uint64 `json:"user_id,omitempty"`
+	// 注销状态
+	Status accounterasure.Status `json:"status,omitempty"`
+	// 执行时间，此前可以撤销
+	ScheduledFor time.Time `json:"scheduled_for,omitempty"`
+	// 最近一次开始执行的时间
+	StartedAt *time.Time `json:"started_at,omitempty"`
+	// 完成时间
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	// 撤销时间
+	CancelledAt *time.Time `json:"cancelled_at,omitempty"`
+	// 各类数据删除或匿名化的记录数
+	Results map[string]int `json:"results,omitempty"`
+	// 申请时间
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AccountErasure) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case accounterasure.FieldResults:
+			values[i] = new([]byte)
+		case accounterasure.FieldID, accounterasure.FieldTenantID, accounterasure.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case accounterasure.FieldStatus:
+			values[i] = new(sql.NullString)
+		case accounterasure.FieldScheduledFor, accounterasure.FieldStartedAt, accounterasure.FieldCompletedAt, accounterasure.FieldCancelledAt, accounterasure.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AccountErasure fields.
+func (ae *AccountErasure) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case accounterasure.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ae.ID = uint64(value.Int64)
+		case accounterasure.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				ae.TenantID = uint64(value.Int64)
+			}
+		case accounterasure.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				ae.UserID = uint64(value.Int64)
+			}
+		case accounterasure.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				ae.Status = accounterasure.Status(value.String)
+			}
+		case accounterasure.FieldScheduledFor:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field scheduled_for", values[i])
+			} else if value.Valid {
+				ae.ScheduledFor = value.Time
+			}
+		case accounterasure.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				ae.StartedAt = new(time.Time)
+				*ae.StartedAt = value.Time
+			}
+		case accounterasure.FieldCompletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
+			} else if value.Valid {
+				ae.CompletedAt = new(time.Time)
+				*ae.CompletedAt = value.Time
+			}
+		case accounterasure.FieldCancelledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field cancelled_at", values[i])
+			} else if value.Valid {
+				ae.CancelledAt = new(time.Time)
+				*ae.CancelledAt = value.Time
+			}
+		case accounterasure.FieldResults:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field results", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ae.Results); err != nil {
+					return fmt.Errorf("unmarshal field results: %w", err)
+				}
+			}
+		case accounterasure.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ae.CreatedAt = value.Time
+			}
+		default:
+			ae.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AccountErasure.
+// This includes values selected through modifiers, order, etc.
+func (ae *AccountErasure) Value(name string) (ent.Value, error) {
+	return ae.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AccountErasure.
+// Note that you need to call AccountErasure.Unwrap() before calling this method if this AccountErasure
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ae *AccountErasure) Update() *AccountErasureUpdateOne {
+	return NewAccountErasureClient(ae.config).UpdateOne(ae)
+}
+
+// Unwrap unwraps the AccountErasure entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ae *AccountErasure) Unwrap() *AccountErasure {
+	_tx, ok := ae.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AccountErasure is not a transactional entity")
+	}
+	ae.config.driver = _tx.drv
+	return ae
+}
+
+// String implements the fmt.Stringer.
+func (ae *AccountErasure) String() string {
+	var builder strings.Builder
+	builder.WriteString("AccountErasure(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ae.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", ae.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", ae.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", ae.Status))
+	builder.WriteString(", ")
+	builder.WriteString("scheduled_for=")
+	builder.WriteString(ae.ScheduledFor.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := ae.StartedAt; v != nil {
+		builder.WriteString("started_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := ae.CompletedAt; v != nil {
+		builder.WriteString("completed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := ae.CancelledAt; v != nil {
+		builder.WriteString("cancelled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("results=")
+	builder.WriteString(fmt.Sprintf("%v", ae.Results))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ae.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AccountErasures is a parsable slice of AccountErasure.
+type AccountErasures []*AccountErasure
@@ -0,0 +1,445 @@
+// Code generated by ent, DO NOT EDIT.
+
+package accounterasure
+
+import (
+	"go-web/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint64) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldEQ(FieldTenantID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uint64) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldEQ(FieldUserID, v))
+}
+
+// ScheduledFor applies equality check predicate on the "scheduled_for" field. It's identical to ScheduledForEQ.
+func ScheduledFor(v time.Time) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldEQ(FieldScheduledFor, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// CompletedAt applies equality check predicate on the "completed_at" field. It's identical to CompletedAtEQ.
+func CompletedAt(v time.Time) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CancelledAt applies equality check predicate on the "cancelled_at" field. It's identical to CancelledAtEQ.
+func CancelledAt(v time.Time) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldEQ(FieldCancelledAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint64) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint64) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint64) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint64) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint64) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint64) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint64) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint64) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldLTE(FieldTenantID, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uint64) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uint64) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uint64) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uint64) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uint64) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uint64) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uint64) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uint64) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldLTE(FieldUserID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ScheduledForEQ applies the EQ predicate on the "scheduled_for" field.
+func ScheduledForEQ(v time.Time) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldEQ(FieldScheduledFor, v))
+}
+
+// ScheduledForNEQ applies the NEQ predicate on the "scheduled_for" field.
+func ScheduledForNEQ(v time.Time) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldNEQ(FieldScheduledFor, v))
+}
+
+// ScheduledForIn applies the In predicate on the "scheduled_for" field.
+func ScheduledForIn(vs ...time.Time) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldIn(FieldScheduledFor, vs...))
+}
+
+// ScheduledForNotIn applies the NotIn predicate on the "scheduled_for" field.
+func ScheduledForNotIn(vs ...time.Time) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldNotIn(FieldScheduledFor, vs...))
+}
+
+// ScheduledForGT applies the GT predicate on the "scheduled_for" field.
+func ScheduledForGT(v time.Time) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldGT(FieldScheduledFor, v))
+}
+
+// ScheduledForGTE applies the GTE predicate on the "scheduled_for" field.
+func ScheduledForGTE(v time.Time) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldGTE(FieldScheduledFor, v))
+}
+
+// ScheduledForLT applies the LT predicate on the "scheduled_for" field.
+func ScheduledForLT(v time.Time) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldLT(FieldScheduledFor, v))
+}
+
+// ScheduledForLTE applies the LTE predicate on the "scheduled_for" field.
+func ScheduledForLTE(v time.Time) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldLTE(FieldScheduledFor, v))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// StartedAtIsNil applies the IsNil predicate on the "started_at" field.
+func StartedAtIsNil() predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldIsNull(FieldStartedAt))
+}
+
+// StartedAtNotNil applies the NotNil predicate on the "started_at" field.
+func StartedAtNotNil() predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldNotNull(FieldStartedAt))
+}
+
+// CompletedAtEQ applies the EQ predicate on the "completed_at" field.
+func CompletedAtEQ(v time.Time) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtNEQ applies the NEQ predicate on the "completed_at" field.
+func CompletedAtNEQ(v time.Time) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldNEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtIn applies the In predicate on the "completed_at" field.
+func CompletedAtIn(vs ...time.Time) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtNotIn applies the NotIn predicate on the "completed_at" field.
+func CompletedAtNotIn(vs ...time.Time) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldNotIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtGT applies the GT predicate on the "completed_at" field.
+func CompletedAtGT(v time.Time) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldGT(FieldCompletedAt, v))
+}
+
+// CompletedAtGTE applies the GTE predicate on the "completed_at" field.
+func CompletedAtGTE(v time.Time) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldGTE(FieldCompletedAt, v))
+}
+
+// CompletedAtLT applies the LT predicate on the "completed_at" field.
+func CompletedAtLT(v time.Time) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldLT(FieldCompletedAt, v))
+}
+
+// CompletedAtLTE applies the LTE predicate on the "completed_at" field.
+func CompletedAtLTE(v time.Time) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldLTE(FieldCompletedAt, v))
+}
+
+// CompletedAtIsNil applies the IsNil predicate on the "completed_at" field.
+func CompletedAtIsNil() predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldIsNull(FieldCompletedAt))
+}
+
+// CompletedAtNotNil applies the NotNil predicate on the "completed_at" field.
+func CompletedAtNotNil() predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldNotNull(FieldCompletedAt))
+}
+
+// CancelledAtEQ applies the EQ predicate on the "cancelled_at" field.
+func CancelledAtEQ(v time.Time) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldEQ(FieldCancelledAt, v))
+}
+
+// CancelledAtNEQ applies the NEQ predicate on the "cancelled_at" field.
+func CancelledAtNEQ(v time.Time) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldNEQ(FieldCancelledAt, v))
+}
+
+// CancelledAtIn applies the In predicate on the "cancelled_at" field.
+func CancelledAtIn(vs ...time.Time) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldIn(FieldCancelledAt, vs...))
+}
+
+// CancelledAtNotIn applies the NotIn predicate on the "cancelled_at" field.
+func CancelledAtNotIn(vs ...time.Time) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldNotIn(FieldCancelledAt, vs...))
+}
+
+// CancelledAtGT applies the GT predicate on the "cancelled_at" field.
+func CancelledAtGT(v time.Time) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldGT(FieldCancelledAt, v))
+}
+
+// CancelledAtGTE applies the GTE predicate on the "cancelled_at" field.
+func CancelledAtGTE(v time.Time) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldGTE(FieldCancelledAt, v))
+}
+
+// CancelledAtLT applies the LT predicate on the "cancelled_at" field.
+func CancelledAtLT(v time.Time) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldLT(FieldCancelledAt, v))
+}
+
+// CancelledAtLTE applies the LTE predicate on the "cancelled_at" field.
+func CancelledAtLTE(v time.Time) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldLTE(FieldCancelledAt, v))
+}
+
+// CancelledAtIsNil applies the IsNil predicate on the "cancelled_at" field.
+func CancelledAtIsNil() predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldIsNull(FieldCancelledAt))
+}
+
+// CancelledAtNotNil applies the NotNil predicate on the "cancelled_at" field.
+func CancelledAtNotNil() predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldNotNull(FieldCancelledAt))
+}
+
+// ResultsIsNil applies the IsNil predicate on the "results" field.
+func ResultsIsNil() predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldIsNull(FieldResults))
+}
+
+// ResultsNotNil applies the NotNil predicate on the "results" field.
+func ResultsNotNil() predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldNotNull(FieldResults))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AccountErasure) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AccountErasure) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AccountErasure) predicate.AccountErasure {
+	return predicate.AccountErasure(sql.NotPredicates(p))
+}
@@ -0,0 +1,926 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-web/ent/accounterasure"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AccountErasureCreate is the builder for creating a AccountErasure entity.
+type AccountErasureCreate struct {
+	config
+	mutation *AccountErasureMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (aec *AccountErasureCreate) SetTenantID(u uint64) *AccountErasureCreate {
+	aec.mutation.SetTenantID(u)
+	return aec
+}
+
+// SetUserID sets the "user_id" field.
+func (aec *AccountErasureCreate) SetUserID(u uint64) *AccountErasureCreate {
+	aec.mutation.SetUserID(u)
+	return aec
+}
+
+// SetStatus sets the "status" field.
+func (aec *AccountErasureCreate) SetStatus(a accounterasure.Status) *AccountErasureCreate {
+	aec.mutation.SetStatus(a)
+	return aec
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (aec *AccountErasureCreate) SetNillableStatus(a *accounterasure.Status) *AccountErasureCreate {
+	if a != nil {
+		aec.SetStatus(*a)
+	}
+	return aec
+}
+
+// SetScheduledFor sets the "scheduled_for" field.
+func (aec *AccountErasureCreate) SetScheduledFor(t time.Time) *AccountErasureCreate {
+	aec.mutation.SetScheduledFor(t)
+	return aec
+}
+
+// SetStartedAt sets the "started_at" field.
+func (aec *AccountErasureCreate) SetStartedAt(t time.Time) *AccountErasureCreate {
+	aec.mutation.SetStartedAt(t)
+	return aec
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (aec *AccountErasureCreate) SetNillableStartedAt(t *time.Time) *AccountErasureCreate {
+	if t != nil {
+		aec.SetStartedAt(*t)
+	}
+	return aec
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (aec *AccountErasureCreate) SetCompletedAt(t time.Time) *AccountErasureCreate {
+	aec.mutation.SetCompletedAt(t)
+	return aec
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (aec *AccountErasureCreate) SetNillableCompletedAt(t *time.Time) *AccountErasureCreate {
+	if t != nil {
+		aec.SetCompletedAt(*t)
+	}
+	return aec
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (aec *AccountErasureCreate) SetCancelledAt(t time.Time) *AccountErasureCreate {
+	aec.mutation.SetCancelledAt(t)
+	return aec
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (aec *AccountErasureCreate) SetNillableCancelledAt(t *time.Time) *AccountErasureCreate {
+	if t != nil {
+		aec.SetCancelledAt(*t)
+	}
+	return aec
+}
+
+// SetResults sets the "results" field.
+func (aec *AccountErasureCreate) SetResults(m map[string]int) *AccountErasureCreate {
+	aec.mutation.SetResults(m)
+	return aec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (aec *AccountErasureCreate) SetCreatedAt(t time.Time) *AccountErasureCreate {
+	aec.mutation.SetCreatedAt(t)
+	return aec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (aec *AccountErasureCreate) SetNillableCreatedAt(t *time.Time) *AccountErasureCreate {
+	if t != nil {
+		aec.SetCreatedAt(*t)
+	}
+	return aec
+}
+
+// SetID sets the "id" field.
+func (aec *AccountErasureCreate) SetID(u uint64) *AccountErasureCreate {
+	aec.mutation.SetID(u)
+	return aec
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (aec *AccountErasureCreate) SetNillableID(u *uint64) *AccountErasureCreate {
+	if u != nil {
+		aec.SetID(*u)
+	}
+	return aec
+}
+
+// Mutation returns the AccountErasureMutation object of the builder.
+func (aec *AccountErasureCreate) Mutation() *AccountErasureMutation {
+	return aec.mutation
+}
+
+// Save creates the AccountErasure in the database.
+func (aec *AccountErasureCreate) Save(ctx context.Context) (*AccountErasure, error) {
+	if err := aec.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, aec.sqlSave, aec.mutation, aec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (aec *AccountErasureCreate) SaveX(ctx context.Context) *AccountErasure {
+	v, err := aec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aec *AccountErasureCreate) Exec(ctx context.Context) error {
+	_, err := aec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aec *AccountErasureCreate) ExecX(ctx context.Context) {
+	if err := aec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aec *AccountErasureCreate) defaults() error {
+	if _, ok := aec.mutation.Status(); !ok {
+		v := accounterasure.DefaultStatus
+		aec.mutation.SetStatus(v)
+	}
+	if _, ok := aec.mutation.CreatedAt(); !ok {
+		if accounterasure.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized accounterasure.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := accounterasure.DefaultCreatedAt()
+		aec.mutation.SetCreatedAt(v)
+	}
+	if _, ok := aec.mutation.ID(); !ok {
+		if accounterasure.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized accounterasure.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := accounterasure.DefaultID()
+		aec.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aec *AccountErasureCreate) check() error {
+	if _, ok := aec.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "AccountErasure.tenant_id"`)}
+	}
+	if _, ok := aec.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "AccountErasure.user_id"`)}
+	}
+	if _, ok := aec.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "AccountErasure.status"`)}
+	}
+	if v, ok := aec.mutation.Status(); ok {
+		if err := accounterasure.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "AccountErasure.status": %w`, err)}
+		}
+	}
+	if _, ok := aec.mutation.ScheduledFor(); !ok {
+		return &ValidationError{Name: "scheduled_for", err: errors.New(`ent: missing required field "AccountErasure.scheduled_for"`)}
+	}
+	if _, ok := aec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AccountErasure.created_at"`)}
+	}
+	if v, ok := aec.mutation.ID(); ok {
+		if err := accounterasure.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "AccountErasure.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (aec *AccountErasureCreate) sqlSave(ctx context.Context) (*AccountErasure, error) {
+	if err := aec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := aec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, aec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	aec.mutation.id = &_node.ID
+	aec.mutation.done = true
+	return _node, nil
+}
+
+func (aec *AccountErasureCreate) createSpec() (*AccountErasure, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AccountErasure{config: aec.config}
+		_spec = sqlgraph.NewCreateSpec(accounterasure.Table, sqlgraph.NewFieldSpec(accounterasure.FieldID, field.TypeUint64))
+	)
+	_spec.OnConflict = aec.conflict
+	if id, ok := aec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := aec.mutation.TenantID(); ok {
+		_spec.SetField(accounterasure.FieldTenantID, field.TypeUint64, value)
+		_node.TenantID = value
+	}
+	if value, ok := aec.mutation.UserID(); ok {
+		_spec.SetField(accounterasure.FieldUserID, field.TypeUint64, value)
+		_node.UserID = value
+	}
+	if value, ok := aec.mutation.Status(); ok {
+		_spec.SetField(accounterasure.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := aec.mutation.ScheduledFor(); ok {
+		_spec.SetField(accounterasure.FieldScheduledFor, field.TypeTime, value)
+		_node.ScheduledFor = value
+	}
+	if value, ok := aec.mutation.StartedAt(); ok {
+		_spec.SetField(accounterasure.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = &value
+	}
+	if value, ok := aec.mutation.CompletedAt(); ok {
+		_spec.SetField(accounterasure.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
+	if value, ok := aec.mutation.CancelledAt(); ok {
+		_spec.SetField(accounterasure.FieldCancelledAt, field.TypeTime, value)
+		_node.CancelledAt = &value
+	}
+	if value, ok := aec.mutation.Results(); ok {
+		_spec.SetField(accounterasure.FieldResults, field.TypeJSON, value)
+		_node.Results = value
+	}
+	if value, ok := aec.mutation.CreatedAt(); ok {
+		_spec.SetField(accounterasure.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AccountErasure.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AccountErasureUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (aec *AccountErasureCreate) OnConflict(opts ...sql.ConflictOption) *AccountErasureUpsertOne {
+	aec.conflict = opts
+	return &AccountErasureUpsertOne{
+		create: aec,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AccountErasure.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (aec *AccountErasureCreate) OnConflictColumns(columns ...string) *AccountErasureUpsertOne {
+	aec.conflict = append(aec.conflict, sql.ConflictColumns(columns...))
+	return &AccountErasureUpsertOne{
+		create: aec,
+	}
+}
+
+type (
+	// AccountErasureUpsertOne is the builder for "upsert"-ing
+	//  one AccountErasure node.
+	AccountErasureUpsertOne struct {
+		create *AccountErasureCreate
+	}
+
+	// AccountErasureUpsert is the "OnConflict" setter.
+	AccountErasureUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetStatus sets the "status" field.
+func (u *AccountErasureUpsert) SetStatus(v accounterasure.Status) *AccountErasureUpsert {
+	u.Set(accounterasure.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *AccountErasureUpsert) UpdateStatus() *AccountErasureUpsert {
+	u.SetExcluded(accounterasure.FieldStatus)
+	return u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *AccountErasureUpsert) SetStartedAt(v time.Time) *AccountErasureUpsert {
+	u.Set(accounterasure.FieldStartedAt, v)
+	return u
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *AccountErasureUpsert) UpdateStartedAt() *AccountErasureUpsert {
+	u.SetExcluded(accounterasure.FieldStartedAt)
+	return u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *AccountErasureUpsert) ClearStartedAt() *AccountErasureUpsert {
+	u.SetNull(accounterasure.FieldStartedAt)
+	return u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *AccountErasureUpsert) SetCompletedAt(v time.Time) *AccountErasureUpsert {
+	u.Set(accounterasure.FieldCompletedAt, v)
+	return u
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *AccountErasureUpsert) UpdateCompletedAt() *AccountErasureUpsert {
+	u.SetExcluded(accounterasure.FieldCompletedAt)
+	return u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *AccountErasureUpsert) ClearCompletedAt() *AccountErasureUpsert {
+	u.SetNull(accounterasure.FieldCompletedAt)
+	return u
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (u *AccountErasureUpsert) SetCancelledAt(v time.Time) *AccountErasureUpsert {
+	u.Set(accounterasure.FieldCancelledAt, v)
+	return u
+}
+
+// UpdateCancelledAt sets the "cancelled_at" field to the value that was provided on create.
+func (u *AccountErasureUpsert) UpdateCancelledAt() *AccountErasureUpsert {
+	u.SetExcluded(accounterasure.FieldCancelledAt)
+	return u
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (u *AccountErasureUpsert) ClearCancelledAt() *AccountErasureUpsert {
+	u.SetNull(accounterasure.FieldCancelledAt)
+	return u
+}
+
+// SetResults sets the "results" field.
+func (u *AccountErasureUpsert) SetResults(v map[string]int) *AccountErasureUpsert {
+	u.Set(accounterasure.FieldResults, v)
+	return u
+}
+
+// UpdateResults sets the "results" field to the value that was provided on create.
+func (u *AccountErasureUpsert) UpdateResults() *AccountErasureUpsert {
+	u.SetExcluded(accounterasure.FieldResults)
+	return u
+}
+
+// ClearResults clears the value of the "results" field.
+func (u *AccountErasureUpsert) ClearResults() *AccountErasureUpsert {
+	u.SetNull(accounterasure.FieldResults)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.AccountErasure.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(accounterasure.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AccountErasureUpsertOne) UpdateNewValues() *AccountErasureUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(accounterasure.FieldID)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(accounterasure.FieldTenantID)
+		}
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(accounterasure.FieldUserID)
+		}
+		if _, exists := u.create.mutation.ScheduledFor(); exists {
+			s.SetIgnore(accounterasure.FieldScheduledFor)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(accounterasure.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AccountErasure.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AccountErasureUpsertOne) Ignore() *AccountErasureUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AccountErasureUpsertOne) DoNothing() *AccountErasureUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AccountErasureCreate.OnConflict
+// documentation for more info.
+func (u *AccountErasureUpsertOne) Update(set func(*AccountErasureUpsert)) *AccountErasureUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AccountErasureUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *AccountErasureUpsertOne) SetStatus(v accounterasure.Status) *AccountErasureUpsertOne {
+	return u.Update(func(s *AccountErasureUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *AccountErasureUpsertOne) UpdateStatus() *AccountErasureUpsertOne {
+	return u.Update(func(s *AccountErasureUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *AccountErasureUpsertOne) SetStartedAt(v time.Time) *AccountErasureUpsertOne {
+	return u.Update(func(s *AccountErasureUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *AccountErasureUpsertOne) UpdateStartedAt() *AccountErasureUpsertOne {
+	return u.Update(func(s *AccountErasureUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *AccountErasureUpsertOne) ClearStartedAt() *AccountErasureUpsertOne {
+	return u.Update(func(s *AccountErasureUpsert) {
+		s.ClearStartedAt()
+	})
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *AccountErasureUpsertOne) SetCompletedAt(v time.Time) *AccountErasureUpsertOne {
+	return u.Update(func(s *AccountErasureUpsert) {
+		s.SetCompletedAt(v)
+	})
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *AccountErasureUpsertOne) UpdateCompletedAt() *AccountErasureUpsertOne {
+	return u.Update(func(s *AccountErasureUpsert) {
+		s.UpdateCompletedAt()
+	})
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *AccountErasureUpsertOne) ClearCompletedAt() *AccountErasureUpsertOne {
+	return u.Update(func(s *AccountErasureUpsert) {
+		s.ClearCompletedAt()
+	})
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (u *AccountErasureUpsertOne) SetCancelledAt(v time.Time) *AccountErasureUpsertOne {
+	return u.Update(func(s *AccountErasureUpsert) {
+		s.SetCancelledAt(v)
+	})
+}
+
+// UpdateCancelledAt sets the "cancelled_at" field to the value that was provided on create.
+func (u *AccountErasureUpsertOne) UpdateCancelledAt() *AccountErasureUpsertOne {
+	return u.Update(func(s *AccountErasureUpsert) {
+		s.UpdateCancelledAt()
+	})
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (u *AccountErasureUpsertOne) ClearCancelledAt() *AccountErasureUpsertOne {
+	return u.Update(func(s *AccountErasureUpsert) {
+		s.ClearCancelledAt()
+	})
+}
+
+// SetResults sets the "results" field.
+func (u *AccountErasureUpsertOne) SetResults(v map[string]int) *AccountErasureUpsertOne {
+	return u.Update(func(s *AccountErasureUpsert) {
+		s.SetResults(v)
+	})
+}
+
+// UpdateResults sets the "results" field to the value that was provided on create.
+func (u *AccountErasureUpsertOne) UpdateResults() *AccountErasureUpsertOne {
+	return u.Update(func(s *AccountErasureUpsert) {
+		s.UpdateResults()
+	})
+}
+
+// ClearResults clears the value of the "results" field.
+func (u *AccountErasureUpsertOne) ClearResults() *AccountErasureUpsertOne {
+	return u.Update(func(s *AccountErasureUpsert) {
+		s.ClearResults()
+	})
+}
+
+// Exec executes the query.
+func (u *AccountErasureUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AccountErasureCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AccountErasureUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AccountErasureUpsertOne) ID(ctx context.Context) (id uint64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AccountErasureUpsertOne) IDX(ctx context.Context) uint64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AccountErasureCreateBulk is the builder for creating many AccountErasure entities in bulk.
+type AccountErasureCreateBulk struct {
+	config
+	err      error
+	builders []*AccountErasureCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AccountErasure entities in the database.
+func (aecb *AccountErasureCreateBulk) Save(ctx context.Context) ([]*AccountErasure, error) {
+	if aecb.err != nil {
+		return nil, aecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(aecb.builders))
+	nodes := make([]*AccountErasure, len(aecb.builders))
+	mutators := make([]Mutator, len(aecb.builders))
+	for i := range aecb.builders {
+		func(i int, root context.Context) {
+			builder := aecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AccountErasureMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, aecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = aecb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, aecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, aecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aecb *AccountErasureCreateBulk) SaveX(ctx context.Context) []*AccountErasure {
+	v, err := aecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aecb *AccountErasureCreateBulk) Exec(ctx context.Context) error {
+	_, err := aecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aecb *AccountErasureCreateBulk) ExecX(ctx context.Context) {
+	if err := aecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AccountErasure.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AccountErasureUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (aecb *AccountErasureCreateBulk) OnConflict(opts ...sql.ConflictOption) *AccountErasureUpsertBulk {
+	aecb.conflict = opts
+	return &AccountErasureUpsertBulk{
+		create: aecb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AccountErasure.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (aecb *AccountErasureCreateBulk) OnConflictColumns(columns ...string) *AccountErasureUpsertBulk {
+	aecb.conflict = append(aecb.conflict, sql.ConflictColumns(columns...))
+	return &AccountErasureUpsertBulk{
+		create: aecb,
+	}
+}
+
+// AccountErasureUpsertBulk is the builder for "upsert"-ing
+// a bulk of AccountErasure nodes.
+type AccountErasureUpsertBulk struct {
+	create *AccountErasureCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AccountErasure.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(accounterasure.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AccountErasureUpsertBulk) UpdateNewValues() *AccountErasureUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(accounterasure.FieldID)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(accounterasure.FieldTenantID)
+			}
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(accounterasure.FieldUserID)
+			}
+			if _, exists := b.mutation.ScheduledFor(); exists {
+				s.SetIgnore(accounterasure.FieldScheduledFor)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(accounterasure.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AccountErasure.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AccountErasureUpsertBulk) Ignore() *AccountErasureUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AccountErasureUpsertBulk) DoNothing() *AccountErasureUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AccountErasureCreateBulk.OnConflict
+// documentation for more info.
+func (u *AccountErasureUpsertBulk) Update(set func(*AccountErasureUpsert)) *AccountErasureUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AccountErasureUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *AccountErasureUpsertBulk) SetStatus(v accounterasure.Status) *AccountErasureUpsertBulk {
+	return u.Update(func(s *AccountErasureUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *AccountErasureUpsertBulk) UpdateStatus() *AccountErasureUpsertBulk {
+	return u.Update(func(s *AccountErasureUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *AccountErasureUpsertBulk) SetStartedAt(v time.Time) *AccountErasureUpsertBulk {
+	return u.Update(func(s *AccountErasureUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *AccountErasureUpsertBulk) UpdateStartedAt() *AccountErasureUpsertBulk {
+	return u.Update(func(s *AccountErasureUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *AccountErasureUpsertBulk) ClearStartedAt() *AccountErasureUpsertBulk {
+	return u.Update(func(s *AccountErasureUpsert) {
+		s.ClearStartedAt()
+	})
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *AccountErasureUpsertBulk) SetCompletedAt(v time.Time) *AccountErasureUpsertBulk {
+	return u.Update(func(s *AccountErasureUpsert) {
+		s.SetCompletedAt(v)
+	})
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *AccountErasureUpsertBulk) UpdateCompletedAt() *AccountErasureUpsertBulk {
+	return u.Update(func(s *AccountErasureUpsert) {
+		s.UpdateCompletedAt()
+	})
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *AccountErasureUpsertBulk) ClearCompletedAt() *AccountErasureUpsertBulk {
+	return u.Update(func(s *AccountErasureUpsert) {
+		s.ClearCompletedAt()
+	})
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (u *AccountErasureUpsertBulk) SetCancelledAt(v time.Time) *AccountErasureUpsertBulk {
+	return u.Update(func(s *AccountErasureUpsert) {
+		s.SetCancelledAt(v)
+	})
+}
+
+// UpdateCancelledAt sets the "cancelled_at" field to the value that was provided on create.
+func (u *AccountErasureUpsertBulk) UpdateCancelledAt() *AccountErasureUpsertBulk {
+	return u.Update(func(s *AccountErasureUpsert) {
+		s.UpdateCancelledAt()
+	})
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (u *AccountErasureUpsertBulk) ClearCancelledAt() *AccountErasureUpsertBulk {
+	return u.Update(func(s *AccountErasureUpsert) {
+		s.ClearCancelledAt()
+	})
+}
+
+// SetResults sets the "results" field.
+func (u *AccountErasureUpsertBulk) SetResults(v map[string]int) *AccountErasureUpsertBulk {
+	return u.Update(func(s *AccountErasureUpsert) {
+		s.SetResults(v)
+	})
+}
+
+// UpdateResults sets the "results" field to the value that was provided on create.
+func (u *AccountErasureUpsertBulk) UpdateResults() *AccountErasureUpsertBulk {
+	return u.Update(func(s *AccountErasureUpsert) {
+		s.UpdateResults()
+	})
+}
+
+// ClearResults clears the value of the "results" field.
+func (u *AccountErasureUpsertBulk) ClearResults() *AccountErasureUpsertBulk {
+	return u.Update(func(s *AccountErasureUpsert) {
+		s.ClearResults()
+	})
+}
+
+// Exec executes the query.
+func (u *AccountErasureUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AccountErasureCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AccountErasureCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AccountErasureUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
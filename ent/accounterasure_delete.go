@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"go-web/ent/accounterasure"
+	"go-web/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AccountErasureDelete is the builder for deleting a AccountErasure entity.
+type AccountErasureDelete struct {
+	config
+	hooks    []Hook
+	mutation *AccountErasureMutation
+}
+
+// Where appends a list predicates to the AccountErasureDelete builder.
+func (aed *AccountErasureDelete) Where(ps ...predicate.AccountErasure) *AccountErasureDelete {
+	aed.mutation.Where(ps...)
+	return aed
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (aed *AccountErasureDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, aed.sqlExec, aed.mutation, aed.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aed *AccountErasureDelete) ExecX(ctx context.Context) int {
+	n, err := aed.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (aed *AccountErasureDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(accounterasure.Table, sqlgraph.NewFieldSpec(accounterasure.FieldID, field.TypeUint64))
+	if ps := aed.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, aed.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	aed.mutation.done = true
+	return affected, err
+}
+
+// AccountErasureDeleteOne is the builder for deleting a single AccountErasure entity.
+type AccountErasureDeleteOne struct {
+	aed *AccountErasureDelete
+}
+
+// Where appends a list predicates to the AccountErasureDelete builder.
+func (aedo *AccountErasureDeleteOne) Where(ps ...predicate.AccountErasure) *AccountErasureDeleteOne {
+	aedo.aed.mutation.Where(ps...)
+	return aedo
+}
+
+// Exec executes the deletion query.
+func (aedo *AccountErasureDeleteOne) Exec(ctx context.Context) error {
+	n, err := aedo.aed.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{accounterasure.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aedo *AccountErasureDeleteOne) ExecX(ctx context.Context) {
+	if err := aedo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
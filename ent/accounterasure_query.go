@@ -0,0 +1,588 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-web/ent/accounterasure"
+	"go-web/ent/predicate"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AccountErasureQuery is the builder for querying AccountErasure entities.
+type AccountErasureQuery struct {
+	config
+	ctx        *QueryContext
+	order      []accounterasure.OrderOption
+	inters     []Interceptor
+	predicates []predicate.AccountErasure
+	loadTotal  []func(context.Context, []*AccountErasure) error
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the AccountErasureQuery builder.
+func (aeq *AccountErasureQuery) Where(ps ...predicate.AccountErasure) *AccountErasureQuery {
+	aeq.predicates = append(aeq.predicates, ps...)
+	return aeq
+}
+
+// Limit the number of records to be returned by this query.
+func (aeq *AccountErasureQuery) Limit(limit int) *AccountErasureQuery {
+	aeq.ctx.Limit = &limit
+	return aeq
+}
+
+// Offset to start from.
+func (aeq *AccountErasureQuery) Offset(offset int) *AccountErasureQuery {
+	aeq.ctx.Offset = &offset
+	return aeq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (aeq *AccountErasureQuery) Unique(unique bool) *AccountErasureQuery {
+	aeq.ctx.Unique = &unique
+	return aeq
+}
+
+// Order specifies how the records should be ordered.
+func (aeq *AccountErasureQuery) Order(o ...accounterasure.OrderOption) *AccountErasureQuery {
+	aeq.order = append(aeq.order, o...)
+	return aeq
+}
+
+// First returns the first AccountErasure entity from the query.
+// Returns a *NotFoundError when no AccountErasure was found.
+func (aeq *AccountErasureQuery) First(ctx context.Context) (*AccountErasure, error) {
+	nodes, err := aeq.Limit(1).All(setContextOp(ctx, aeq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{accounterasure.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (aeq *AccountErasureQuery) FirstX(ctx context.Context) *AccountErasure {
+	node, err := aeq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first AccountErasure ID from the query.
+// Returns a *NotFoundError when no AccountErasure ID was found.
+func (aeq *AccountErasureQuery) FirstID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = aeq.Limit(1).IDs(setContextOp(ctx, aeq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{accounterasure.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (aeq *AccountErasureQuery) FirstIDX(ctx context.Context) uint64 {
+	id, err := aeq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single AccountErasure entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one AccountErasure entity is found.
+// Returns a *NotFoundError when no AccountErasure entities are found.
+func (aeq *AccountErasureQuery) Only(ctx context.Context) (*AccountErasure, error) {
+	nodes, err := aeq.Limit(2).All(setContextOp(ctx, aeq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{accounterasure.Label}
+	default:
+		return nil, &NotSingularError{accounterasure.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (aeq *AccountErasureQuery) OnlyX(ctx context.Context) *AccountErasure {
+	node, err := aeq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only AccountErasure ID in the query.
+// Returns a *NotSingularError when more than one AccountErasure ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (aeq *AccountErasureQuery) OnlyID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = aeq.Limit(2).IDs(setContextOp(ctx, aeq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{accounterasure.Label}
+	default:
+		err = &NotSingularError{accounterasure.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (aeq *AccountErasureQuery) OnlyIDX(ctx context.Context) uint64 {
+	id, err := aeq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of AccountErasures.
+func (aeq *AccountErasureQuery) All(ctx context.Context) ([]*AccountErasure, error) {
+	ctx = setContextOp(ctx, aeq.ctx, "All")
+	if err := aeq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*AccountErasure, *AccountErasureQuery]()
+	return withInterceptors[[]*AccountErasure](ctx, aeq, qr, aeq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (aeq *AccountErasureQuery) AllX(ctx context.Context) []*AccountErasure {
+	nodes, err := aeq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of AccountErasure IDs.
+func (aeq *AccountErasureQuery) IDs(ctx context.Context) (ids []uint64, err error) {
+	if aeq.ctx.Unique == nil && aeq.path != nil {
+		aeq.Unique(true)
+	}
+	ctx = setContextOp(ctx, aeq.ctx, "IDs")
+	if err = aeq.Select(accounterasure.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (aeq *AccountErasureQuery) IDsX(ctx context.Context) []uint64 {
+	ids, err := aeq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (aeq *AccountErasureQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, aeq.ctx, "Count")
+	if err := aeq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, aeq, querierCount[*AccountErasureQuery](), aeq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (aeq *AccountErasureQuery) CountX(ctx context.Context) int {
+	count, err := aeq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (aeq *AccountErasureQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, aeq.ctx, "Exist")
+	switch _, err := aeq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (aeq *AccountErasureQuery) ExistX(ctx context.Context) bool {
+	exist, err := aeq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the AccountErasureQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (aeq *AccountErasureQuery) Clone() *AccountErasureQuery {
+	if aeq == nil {
+		return nil
+	}
+	return &AccountErasureQuery{
+		config:     aeq.config,
+		ctx:        aeq.ctx.Clone(),
+		order:      append([]accounterasure.OrderOption{}, aeq.order...),
+		inters:     append([]Interceptor{}, aeq.inters...),
+		predicates: append([]predicate.AccountErasure{}, aeq.predicates...),
+		// clone intermediate query.
+		sql:  aeq.sql.Clone(),
+		path: aeq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID uint64 `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.AccountErasure.Query().
+//		GroupBy(accounterasure.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (aeq *AccountErasureQuery) GroupBy(field string, fields ...string) *AccountErasureGroupBy {
+	aeq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &AccountErasureGroupBy{build: aeq}
+	grbuild.flds = &aeq.ctx.Fields
+	grbuild.label = accounterasure.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID uint64 `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.AccountErasure.Query().
+//		Select(accounterasure.FieldTenantID).
+//		Scan(ctx, &v)
+func (aeq *AccountErasureQuery) Select(fields ...string) *AccountErasureSelect {
+	aeq.ctx.Fields = append(aeq.ctx.Fields, fields...)
+	sbuild := &AccountErasureSelect{AccountErasureQuery: aeq}
+	sbuild.label = accounterasure.Label
+	sbuild.flds, sbuild.scan = &aeq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a AccountErasureSelect configured with the given aggregations.
+func (aeq *AccountErasureQuery) Aggregate(fns ...AggregateFunc) *AccountErasureSelect {
+	return aeq.Select().Aggregate(fns...)
+}
+
+func (aeq *AccountErasureQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range aeq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, aeq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range aeq.ctx.Fields {
+		if !accounterasure.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if aeq.path != nil {
+		prev, err := aeq.path(ctx)
+		if err != nil {
+			return err
+		}
+		aeq.sql = prev
+	}
+	if accounterasure.Policy == nil {
+		return errors.New("ent: uninitialized accounterasure.Policy (forgotten import ent/runtime?)")
+	}
+	if err := accounterasure.Policy.EvalQuery(ctx, aeq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (aeq *AccountErasureQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*AccountErasure, error) {
+	var (
+		nodes = []*AccountErasure{}
+		_spec = aeq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*AccountErasure).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &AccountErasure{config: aeq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(aeq.modifiers) > 0 {
+		_spec.Modifiers = aeq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, aeq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	for i := range aeq.loadTotal {
+		if err := aeq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (aeq *AccountErasureQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := aeq.querySpec()
+	if len(aeq.modifiers) > 0 {
+		_spec.Modifiers = aeq.modifiers
+	}
+	_spec.Node.Columns = aeq.ctx.Fields
+	if len(aeq.ctx.Fields) > 0 {
+		_spec.Unique = aeq.ctx.Unique != nil && *aeq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, aeq.driver, _spec)
+}
+
+func (aeq *AccountErasureQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(accounterasure.Table, accounterasure.Columns, sqlgraph.NewFieldSpec(accounterasure.FieldID, field.TypeUint64))
+	_spec.From = aeq.sql
+	if unique := aeq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if aeq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := aeq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, accounterasure.FieldID)
+		for i := range fields {
+			if fields[i] != accounterasure.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := aeq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := aeq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := aeq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := aeq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (aeq *AccountErasureQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(aeq.driver.Dialect())
+	t1 := builder.Table(accounterasure.Table)
+	columns := aeq.ctx.Fields
+	if len(columns) == 0 {
+		columns = accounterasure.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if aeq.sql != nil {
+		selector = aeq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if aeq.ctx.Unique != nil && *aeq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range aeq.modifiers {
+		m(selector)
+	}
+	for _, p := range aeq.predicates {
+		p(selector)
+	}
+	for _, p := range aeq.order {
+		p(selector)
+	}
+	if offset := aeq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := aeq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (aeq *AccountErasureQuery) ForUpdate(opts ...sql.LockOption) *AccountErasureQuery {
+	if aeq.driver.Dialect() == dialect.Postgres {
+		aeq.Unique(false)
+	}
+	aeq.modifiers = append(aeq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return aeq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (aeq *AccountErasureQuery) ForShare(opts ...sql.LockOption) *AccountErasureQuery {
+	if aeq.driver.Dialect() == dialect.Postgres {
+		aeq.Unique(false)
+	}
+	aeq.modifiers = append(aeq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return aeq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (aeq *AccountErasureQuery) Modify(modifiers ...func(s *sql.Selector)) *AccountErasureSelect {
+	aeq.modifiers = append(aeq.modifiers, modifiers...)
+	return aeq.Select()
+}
+
+// AccountErasureGroupBy is the group-by builder for AccountErasure entities.
+type AccountErasureGroupBy struct {
+	selector
+	build *AccountErasureQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (aegb *AccountErasureGroupBy) Aggregate(fns ...AggregateFunc) *AccountErasureGroupBy {
+	aegb.fns = append(aegb.fns, fns...)
+	return aegb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (aegb *AccountErasureGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, aegb.build.ctx, "GroupBy")
+	if err := aegb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AccountErasureQuery, *AccountErasureGroupBy](ctx, aegb.build, aegb, aegb.build.inters, v)
+}
+
+func (aegb *AccountErasureGroupBy) sqlScan(ctx context.Context, root *AccountErasureQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(aegb.fns))
+	for _, fn := range aegb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*aegb.flds)+len(aegb.fns))
+		for _, f := range *aegb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*aegb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := aegb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// AccountErasureSelect is the builder for selecting fields of AccountErasure entities.
+type AccountErasureSelect struct {
+	*AccountErasureQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (aes *AccountErasureSelect) Aggregate(fns ...AggregateFunc) *AccountErasureSelect {
+	aes.fns = append(aes.fns, fns...)
+	return aes
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (aes *AccountErasureSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, aes.ctx, "Select")
+	if err := aes.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AccountErasureQuery, *AccountErasureSelect](ctx, aes.AccountErasureQuery, aes, aes.inters, v)
+}
+
+func (aes *AccountErasureSelect) sqlScan(ctx context.Context, root *AccountErasureQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(aes.fns))
+	for _, fn := range aes.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*aes.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := aes.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (aes *AccountErasureSelect) Modify(modifiers ...func(s *sql.Selector)) *AccountErasureSelect {
+	aes.modifiers = append(aes.modifiers, modifiers...)
+	return aes
+}
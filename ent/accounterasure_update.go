@@ -0,0 +1,444 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-web/ent/accounterasure"
+	"go-web/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AccountErasureUpdate is the builder for updating AccountErasure entities.
+type AccountErasureUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AccountErasureMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AccountErasureUpdate builder.
+func (aeu *AccountErasureUpdate) Where(ps ...predicate.AccountErasure) *AccountErasureUpdate {
+	aeu.mutation.Where(ps...)
+	return aeu
+}
+
+// SetStatus sets the "status" field.
+func (aeu *AccountErasureUpdate) SetStatus(a accounterasure.Status) *AccountErasureUpdate {
+	aeu.mutation.SetStatus(a)
+	return aeu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (aeu *AccountErasureUpdate) SetNillableStatus(a *accounterasure.Status) *AccountErasureUpdate {
+	if a != nil {
+		aeu.SetStatus(*a)
+	}
+	return aeu
+}
+
+// SetStartedAt sets the "started_at" field.
+func (aeu *AccountErasureUpdate) SetStartedAt(t time.Time) *AccountErasureUpdate {
+	aeu.mutation.SetStartedAt(t)
+	return aeu
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (aeu *AccountErasureUpdate) SetNillableStartedAt(t *time.Time) *AccountErasureUpdate {
+	if t != nil {
+		aeu.SetStartedAt(*t)
+	}
+	return aeu
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (aeu *AccountErasureUpdate) ClearStartedAt() *AccountErasureUpdate {
+	aeu.mutation.ClearStartedAt()
+	return aeu
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (aeu *AccountErasureUpdate) SetCompletedAt(t time.Time) *AccountErasureUpdate {
+	aeu.mutation.SetCompletedAt(t)
+	return aeu
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (aeu *AccountErasureUpdate) SetNillableCompletedAt(t *time.Time) *AccountErasureUpdate {
+	if t != nil {
+		aeu.SetCompletedAt(*t)
+	}
+	return aeu
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (aeu *AccountErasureUpdate) ClearCompletedAt() *AccountErasureUpdate {
+	aeu.mutation.ClearCompletedAt()
+	return aeu
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (aeu *AccountErasureUpdate) SetCancelledAt(t time.Time) *AccountErasureUpdate {
+	aeu.mutation.SetCancelledAt(t)
+	return aeu
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (aeu *AccountErasureUpdate) SetNillableCancelledAt(t *time.Time) *AccountErasureUpdate {
+	if t != nil {
+		aeu.SetCancelledAt(*t)
+	}
+	return aeu
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (aeu *AccountErasureUpdate) ClearCancelledAt() *AccountErasureUpdate {
+	aeu.mutation.ClearCancelledAt()
+	return aeu
+}
+
+// SetResults sets the "results" field.
+func (aeu *AccountErasureUpdate) SetResults(m map[string]int) *AccountErasureUpdate {
+	aeu.mutation.SetResults(m)
+	return aeu
+}
+
+// ClearResults clears the value of the "results" field.
+func (aeu *AccountErasureUpdate) ClearResults() *AccountErasureUpdate {
+	aeu.mutation.ClearResults()
+	return aeu
+}
+
+// Mutation returns the AccountErasureMutation object of the builder.
+func (aeu *AccountErasureUpdate) Mutation() *AccountErasureMutation {
+	return aeu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (aeu *AccountErasureUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, aeu.sqlSave, aeu.mutation, aeu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aeu *AccountErasureUpdate) SaveX(ctx context.Context) int {
+	affected, err := aeu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (aeu *AccountErasureUpdate) Exec(ctx context.Context) error {
+	_, err := aeu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aeu *AccountErasureUpdate) ExecX(ctx context.Context) {
+	if err := aeu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aeu *AccountErasureUpdate) check() error {
+	if v, ok := aeu.mutation.Status(); ok {
+		if err := accounterasure.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "AccountErasure.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (aeu *AccountErasureUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AccountErasureUpdate {
+	aeu.modifiers = append(aeu.modifiers, modifiers...)
+	return aeu
+}
+
+func (aeu *AccountErasureUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := aeu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(accounterasure.Table, accounterasure.Columns, sqlgraph.NewFieldSpec(accounterasure.FieldID, field.TypeUint64))
+	if ps := aeu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aeu.mutation.Status(); ok {
+		_spec.SetField(accounterasure.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := aeu.mutation.StartedAt(); ok {
+		_spec.SetField(accounterasure.FieldStartedAt, field.TypeTime, value)
+	}
+	if aeu.mutation.StartedAtCleared() {
+		_spec.ClearField(accounterasure.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := aeu.mutation.CompletedAt(); ok {
+		_spec.SetField(accounterasure.FieldCompletedAt, field.TypeTime, value)
+	}
+	if aeu.mutation.CompletedAtCleared() {
+		_spec.ClearField(accounterasure.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := aeu.mutation.CancelledAt(); ok {
+		_spec.SetField(accounterasure.FieldCancelledAt, field.TypeTime, value)
+	}
+	if aeu.mutation.CancelledAtCleared() {
+		_spec.ClearField(accounterasure.FieldCancelledAt, field.TypeTime)
+	}
+	if value, ok := aeu.mutation.Results(); ok {
+		_spec.SetField(accounterasure.FieldResults, field.TypeJSON, value)
+	}
+	if aeu.mutation.ResultsCleared() {
+		_spec.ClearField(accounterasure.FieldResults, field.TypeJSON)
+	}
+	_spec.AddModifiers(aeu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, aeu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accounterasure.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	aeu.mutation.done = true
+	return n, nil
+}
+
+// AccountErasureUpdateOne is the builder for updating a single AccountErasure entity.
+type AccountErasureUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AccountErasureMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetStatus sets the "status" field.
+func (aeuo *AccountErasureUpdateOne) SetStatus(a accounterasure.Status) *AccountErasureUpdateOne {
+	aeuo.mutation.SetStatus(a)
+	return aeuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (aeuo *AccountErasureUpdateOne) SetNillableStatus(a *accounterasure.Status) *AccountErasureUpdateOne {
+	if a != nil {
+		aeuo.SetStatus(*a)
+	}
+	return aeuo
+}
+
+// SetStartedAt sets the "started_at" field.
+func (aeuo *AccountErasureUpdateOne) SetStartedAt(t time.Time) *AccountErasureUpdateOne {
+	aeuo.mutation.SetStartedAt(t)
+	return aeuo
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (aeuo *AccountErasureUpdateOne) SetNillableStartedAt(t *time.Time) *AccountErasureUpdateOne {
+	if t != nil {
+		aeuo.SetStartedAt(*t)
+	}
+	return aeuo
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (aeuo *AccountErasureUpdateOne) ClearStartedAt() *AccountErasureUpdateOne {
+	aeuo.mutation.ClearStartedAt()
+	return aeuo
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (aeuo *AccountErasureUpdateOne) SetCompletedAt(t time.Time) *AccountErasureUpdateOne {
+	aeuo.mutation.SetCompletedAt(t)
+	return aeuo
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (aeuo *AccountErasureUpdateOne) SetNillableCompletedAt(t *time.Time) *AccountErasureUpdateOne {
+	if t != nil {
+		aeuo.SetCompletedAt(*t)
+	}
+	return aeuo
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (aeuo *AccountErasureUpdateOne) ClearCompletedAt() *AccountErasureUpdateOne {
+	aeuo.mutation.ClearCompletedAt()
+	return aeuo
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (aeuo *AccountErasureUpdateOne) SetCancelledAt(t time.Time) *AccountErasureUpdateOne {
+	aeuo.mutation.SetCancelledAt(t)
+	return aeuo
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (aeuo *AccountErasureUpdateOne) SetNillableCancelledAt(t *time.Time) *AccountErasureUpdateOne {
+	if t != nil {
+		aeuo.SetCancelledAt(*t)
+	}
+	return aeuo
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (aeuo *AccountErasureUpdateOne) ClearCancelledAt() *AccountErasureUpdateOne {
+	aeuo.mutation.ClearCancelledAt()
+	return aeuo
+}
+
+// SetResults sets the "results" field.
+func (aeuo *AccountErasureUpdateOne) SetResults(m map[string]int) *AccountErasureUpdateOne {
+	aeuo.mutation.SetResults(m)
+	return aeuo
+}
+
+// ClearResults clears the value of the "results" field.
+func (aeuo *AccountErasureUpdateOne) ClearResults() *AccountErasureUpdateOne {
+	aeuo.mutation.ClearResults()
+	return aeuo
+}
+
+// Mutation returns the AccountErasureMutation object of the builder.
+func (aeuo *AccountErasureUpdateOne) Mutation() *AccountErasureMutation {
+	return aeuo.mutation
+}
+
+// Where appends a list predicates to the AccountErasureUpdate builder.
+func (aeuo *AccountErasureUpdateOne) Where(ps ...predicate.AccountErasure) *AccountErasureUpdateOne {
+	aeuo.mutation.Where(ps...)
+	return aeuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (aeuo *AccountErasureUpdateOne) Select(field string, fields ...string) *AccountErasureUpdateOne {
+	aeuo.fields = append([]string{field}, fields...)
+	return aeuo
+}
+
+// Save executes the query and returns the updated AccountErasure entity.
+func (aeuo *AccountErasureUpdateOne) Save(ctx context.Context) (*AccountErasure, error) {
+	return withHooks(ctx, aeuo.sqlSave, aeuo.mutation, aeuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aeuo *AccountErasureUpdateOne) SaveX(ctx context.Context) *AccountErasure {
+	node, err := aeuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (aeuo *AccountErasureUpdateOne) Exec(ctx context.Context) error {
+	_, err := aeuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aeuo *AccountErasureUpdateOne) ExecX(ctx context.Context) {
+	if err := aeuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aeuo *AccountErasureUpdateOne) check() error {
+	if v, ok := aeuo.mutation.Status(); ok {
+		if err := accounterasure.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "AccountErasure.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (aeuo *AccountErasureUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AccountErasureUpdateOne {
+	aeuo.modifiers = append(aeuo.modifiers, modifiers...)
+	return aeuo
+}
+
+func (aeuo *AccountErasureUpdateOne) sqlSave(ctx context.Context) (_node *AccountErasure, err error) {
+	if err := aeuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(accounterasure.Table, accounterasure.Columns, sqlgraph.NewFieldSpec(accounterasure.FieldID, field.TypeUint64))
+	id, ok := aeuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AccountErasure.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := aeuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, accounterasure.FieldID)
+		for _, f := range fields {
+			if !accounterasure.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != accounterasure.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := aeuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aeuo.mutation.Status(); ok {
+		_spec.SetField(accounterasure.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := aeuo.mutation.StartedAt(); ok {
+		_spec.SetField(accounterasure.FieldStartedAt, field.TypeTime, value)
+	}
+	if aeuo.mutation.StartedAtCleared() {
+		_spec.ClearField(accounterasure.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := aeuo.mutation.CompletedAt(); ok {
+		_spec.SetField(accounterasure.FieldCompletedAt, field.TypeTime, value)
+	}
+	if aeuo.mutation.CompletedAtCleared() {
+		_spec.ClearField(accounterasure.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := aeuo.mutation.CancelledAt(); ok {
+		_spec.SetField(accounterasure.FieldCancelledAt, field.TypeTime, value)
+	}
+	if aeuo.mutation.CancelledAtCleared() {
+		_spec.ClearField(accounterasure.FieldCancelledAt, field.TypeTime)
+	}
+	if value, ok := aeuo.mutation.Results(); ok {
+		_spec.SetField(accounterasure.FieldResults, field.TypeJSON, value)
+	}
+	if aeuo.mutation.ResultsCleared() {
+		_spec.ClearField(accounterasure.FieldResults, field.TypeJSON)
+	}
+	_spec.AddModifiers(aeuo.modifiers...)
+	_node = &AccountErasure{config: aeuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, aeuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accounterasure.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	aeuo.mutation.done = true
+	return _node, nil
+}
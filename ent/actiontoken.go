@@ -0,0 +1,196 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"go-web/ent/actiontoken"
+	"go-web/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ActionToken is the model entity for the ActionToken schema.
+type ActionToken struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// 令牌用途
+	Kind actiontoken.Kind `json:"kind,omitempty"`
+	// 令牌 SHA-256 哈希
+	TokenHash string `json:"-"`
+	// 所属用户
+	UserID uint64 `json:"user_id,omitempty"`
+	// 过期时间
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// 使用或作废时间
+	UsedAt *time.Time `json:"used_at,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ActionTokenQuery when eager-loading is set.
+	Edges        ActionTokenEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ActionTokenEdges holds the relations/edges for other nodes in the graph.
+type ActionTokenEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ActionTokenEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.User == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ActionToken) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case actiontoken.FieldID, actiontoken.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case actiontoken.FieldKind, actiontoken.FieldTokenHash:
+			values[i] = new(sql.NullString)
+		case actiontoken.FieldExpiresAt, actiontoken.FieldUsedAt, actiontoken.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ActionToken fields.
+func (at *ActionToken) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case actiontoken.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			at.ID = uint64(value.Int64)
+		case actiontoken.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				at.Kind = actiontoken.Kind(value.String)
+			}
+		case actiontoken.FieldTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_hash", values[i])
+			} else if value.Valid {
+				at.TokenHash = value.String
+			}
+		case actiontoken.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				at.UserID = uint64(value.Int64)
+			}
+		case actiontoken.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				at.ExpiresAt = value.Time
+			}
+		case actiontoken.FieldUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field used_at", values[i])
+			} else if value.Valid {
+				at.UsedAt = new(time.Time)
+				*at.UsedAt = value.Time
+			}
+		case actiontoken.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				at.CreatedAt = value.Time
+			}
+		default:
+			at.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ActionToken.
+// This includes values selected through modifiers, order, etc.
+func (at *ActionToken) Value(name string) (ent.Value, error) {
+	return at.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the ActionToken entity.
+func (at *ActionToken) QueryUser() *UserQuery {
+	return NewActionTokenClient(at.config).QueryUser(at)
+}
+
+// Update returns a builder for updating this ActionToken.
+// Note that you need to call ActionToken.Unwrap() before calling this method if this ActionToken
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (at *ActionToken) Update() *ActionTokenUpdateOne {
+	return NewActionTokenClient(at.config).UpdateOne(at)
+}
+
+// Unwrap unwraps the ActionToken entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (at *ActionToken) Unwrap() *ActionToken {
+	_tx, ok := at.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ActionToken is not a transactional entity")
+	}
+	at.config.driver = _tx.drv
+	return at
+}
+
+// String implements the fmt.Stringer.
+func (at *ActionToken) String() string {
+	var builder strings.Builder
+	builder.WriteString("ActionToken(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", at.ID))
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", at.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("token_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", at.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(at.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := at.UsedAt; v != nil {
+		builder.WriteString("used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(at.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ActionTokens is a parsable slice of ActionToken.
+type ActionTokens []*ActionToken
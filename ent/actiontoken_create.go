@@ -0,0 +1,681 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-web/ent/actiontoken"
+	"go-web/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ActionTokenCreate is the builder for creating a ActionToken entity.
+type ActionTokenCreate struct {
+	config
+	mutation *ActionTokenMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetKind sets the "kind" field.
+func (atc *ActionTokenCreate) SetKind(a actiontoken.Kind) *ActionTokenCreate {
+	atc.mutation.SetKind(a)
+	return atc
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (atc *ActionTokenCreate) SetTokenHash(s string) *ActionTokenCreate {
+	atc.mutation.SetTokenHash(s)
+	return atc
+}
+
+// SetUserID sets the "user_id" field.
+func (atc *ActionTokenCreate) SetUserID(u uint64) *ActionTokenCreate {
+	atc.mutation.SetUserID(u)
+	return atc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (atc *ActionTokenCreate) SetExpiresAt(t time.Time) *ActionTokenCreate {
+	atc.mutation.SetExpiresAt(t)
+	return atc
+}
+
+// SetUsedAt sets the "used_at" field.
+func (atc *ActionTokenCreate) SetUsedAt(t time.Time) *ActionTokenCreate {
+	atc.mutation.SetUsedAt(t)
+	return atc
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (atc *ActionTokenCreate) SetNillableUsedAt(t *time.Time) *ActionTokenCreate {
+	if t != nil {
+		atc.SetUsedAt(*t)
+	}
+	return atc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (atc *ActionTokenCreate) SetCreatedAt(t time.Time) *ActionTokenCreate {
+	atc.mutation.SetCreatedAt(t)
+	return atc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (atc *ActionTokenCreate) SetNillableCreatedAt(t *time.Time) *ActionTokenCreate {
+	if t != nil {
+		atc.SetCreatedAt(*t)
+	}
+	return atc
+}
+
+// SetID sets the "id" field.
+func (atc *ActionTokenCreate) SetID(u uint64) *ActionTokenCreate {
+	atc.mutation.SetID(u)
+	return atc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (atc *ActionTokenCreate) SetNillableID(u *uint64) *ActionTokenCreate {
+	if u != nil {
+		atc.SetID(*u)
+	}
+	return atc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (atc *ActionTokenCreate) SetUser(u *User) *ActionTokenCreate {
+	return atc.SetUserID(u.ID)
+}
+
+// Mutation returns the ActionTokenMutation object of the builder.
+func (atc *ActionTokenCreate) Mutation() *ActionTokenMutation {
+	return atc.mutation
+}
+
+// Save creates the ActionToken in the database.
+func (atc *ActionTokenCreate) Save(ctx context.Context) (*ActionToken, error) {
+	if err := atc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, atc.sqlSave, atc.mutation, atc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (atc *ActionTokenCreate) SaveX(ctx context.Context) *ActionToken {
+	v, err := atc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (atc *ActionTokenCreate) Exec(ctx context.Context) error {
+	_, err := atc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atc *ActionTokenCreate) ExecX(ctx context.Context) {
+	if err := atc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (atc *ActionTokenCreate) defaults() error {
+	if _, ok := atc.mutation.CreatedAt(); !ok {
+		if actiontoken.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized actiontoken.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := actiontoken.DefaultCreatedAt()
+		atc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := atc.mutation.ID(); !ok {
+		if actiontoken.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized actiontoken.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := actiontoken.DefaultID()
+		atc.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (atc *ActionTokenCreate) check() error {
+	if _, ok := atc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "ActionToken.kind"`)}
+	}
+	if v, ok := atc.mutation.Kind(); ok {
+		if err := actiontoken.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "ActionToken.kind": %w`, err)}
+		}
+	}
+	if _, ok := atc.mutation.TokenHash(); !ok {
+		return &ValidationError{Name: "token_hash", err: errors.New(`ent: missing required field "ActionToken.token_hash"`)}
+	}
+	if v, ok := atc.mutation.TokenHash(); ok {
+		if err := actiontoken.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "ActionToken.token_hash": %w`, err)}
+		}
+	}
+	if _, ok := atc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "ActionToken.user_id"`)}
+	}
+	if _, ok := atc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "ActionToken.expires_at"`)}
+	}
+	if _, ok := atc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ActionToken.created_at"`)}
+	}
+	if v, ok := atc.mutation.ID(); ok {
+		if err := actiontoken.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "ActionToken.id": %w`, err)}
+		}
+	}
+	if _, ok := atc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "ActionToken.user"`)}
+	}
+	return nil
+}
+
+func (atc *ActionTokenCreate) sqlSave(ctx context.Context) (*ActionToken, error) {
+	if err := atc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := atc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, atc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	atc.mutation.id = &_node.ID
+	atc.mutation.done = true
+	return _node, nil
+}
+
+func (atc *ActionTokenCreate) createSpec() (*ActionToken, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ActionToken{config: atc.config}
+		_spec = sqlgraph.NewCreateSpec(actiontoken.Table, sqlgraph.NewFieldSpec(actiontoken.FieldID, field.TypeUint64))
+	)
+	_spec.OnConflict = atc.conflict
+	if id, ok := atc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := atc.mutation.Kind(); ok {
+		_spec.SetField(actiontoken.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := atc.mutation.TokenHash(); ok {
+		_spec.SetField(actiontoken.FieldTokenHash, field.TypeString, value)
+		_node.TokenHash = value
+	}
+	if value, ok := atc.mutation.ExpiresAt(); ok {
+		_spec.SetField(actiontoken.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := atc.mutation.UsedAt(); ok {
+		_spec.SetField(actiontoken.FieldUsedAt, field.TypeTime, value)
+		_node.UsedAt = &value
+	}
+	if value, ok := atc.mutation.CreatedAt(); ok {
+		_spec.SetField(actiontoken.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := atc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   actiontoken.UserTable,
+			Columns: []string{actiontoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ActionToken.Create().
+//		SetKind(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ActionTokenUpsert) {
+//			SetKind(v+v).
+//		}).
+//		Exec(ctx)
+func (atc *ActionTokenCreate) OnConflict(opts ...sql.ConflictOption) *ActionTokenUpsertOne {
+	atc.conflict = opts
+	return &ActionTokenUpsertOne{
+		create: atc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ActionToken.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (atc *ActionTokenCreate) OnConflictColumns(columns ...string) *ActionTokenUpsertOne {
+	atc.conflict = append(atc.conflict, sql.ConflictColumns(columns...))
+	return &ActionTokenUpsertOne{
+		create: atc,
+	}
+}
+
+type (
+	// ActionTokenUpsertOne is the builder for "upsert"-ing
+	//  one ActionToken node.
+	ActionTokenUpsertOne struct {
+		create *ActionTokenCreate
+	}
+
+	// ActionTokenUpsert is the "OnConflict" setter.
+	ActionTokenUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUsedAt sets the "used_at" field.
+func (u *ActionTokenUpsert) SetUsedAt(v time.Time) *ActionTokenUpsert {
+	u.Set(actiontoken.FieldUsedAt, v)
+	return u
+}
+
+// UpdateUsedAt sets the "used_at" field to the value that was provided on create.
+func (u *ActionTokenUpsert) UpdateUsedAt() *ActionTokenUpsert {
+	u.SetExcluded(actiontoken.FieldUsedAt)
+	return u
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (u *ActionTokenUpsert) ClearUsedAt() *ActionTokenUpsert {
+	u.SetNull(actiontoken.FieldUsedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ActionToken.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(actiontoken.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ActionTokenUpsertOne) UpdateNewValues() *ActionTokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(actiontoken.FieldID)
+		}
+		if _, exists := u.create.mutation.Kind(); exists {
+			s.SetIgnore(actiontoken.FieldKind)
+		}
+		if _, exists := u.create.mutation.TokenHash(); exists {
+			s.SetIgnore(actiontoken.FieldTokenHash)
+		}
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(actiontoken.FieldUserID)
+		}
+		if _, exists := u.create.mutation.ExpiresAt(); exists {
+			s.SetIgnore(actiontoken.FieldExpiresAt)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(actiontoken.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ActionToken.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ActionTokenUpsertOne) Ignore() *ActionTokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ActionTokenUpsertOne) DoNothing() *ActionTokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ActionTokenCreate.OnConflict
+// documentation for more info.
+func (u *ActionTokenUpsertOne) Update(set func(*ActionTokenUpsert)) *ActionTokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ActionTokenUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUsedAt sets the "used_at" field.
+func (u *ActionTokenUpsertOne) SetUsedAt(v time.Time) *ActionTokenUpsertOne {
+	return u.Update(func(s *ActionTokenUpsert) {
+		s.SetUsedAt(v)
+	})
+}
+
+// UpdateUsedAt sets the "used_at" field to the value that was provided on create.
+func (u *ActionTokenUpsertOne) UpdateUsedAt() *ActionTokenUpsertOne {
+	return u.Update(func(s *ActionTokenUpsert) {
+		s.UpdateUsedAt()
+	})
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (u *ActionTokenUpsertOne) ClearUsedAt() *ActionTokenUpsertOne {
+	return u.Update(func(s *ActionTokenUpsert) {
+		s.ClearUsedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ActionTokenUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ActionTokenCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ActionTokenUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ActionTokenUpsertOne) ID(ctx context.Context) (id uint64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ActionTokenUpsertOne) IDX(ctx context.Context) uint64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ActionTokenCreateBulk is the builder for creating many ActionToken entities in bulk.
+type ActionTokenCreateBulk struct {
+	config
+	err      error
+	builders []*ActionTokenCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ActionToken entities in the database.
+func (atcb *ActionTokenCreateBulk) Save(ctx context.Context) ([]*ActionToken, error) {
+	if atcb.err != nil {
+		return nil, atcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(atcb.builders))
+	nodes := make([]*ActionToken, len(atcb.builders))
+	mutators := make([]Mutator, len(atcb.builders))
+	for i := range atcb.builders {
+		func(i int, root context.Context) {
+			builder := atcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ActionTokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, atcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = atcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, atcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, atcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (atcb *ActionTokenCreateBulk) SaveX(ctx context.Context) []*ActionToken {
+	v, err := atcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (atcb *ActionTokenCreateBulk) Exec(ctx context.Context) error {
+	_, err := atcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atcb *ActionTokenCreateBulk) ExecX(ctx context.Context) {
+	if err := atcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ActionToken.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ActionTokenUpsert) {
+//			SetKind(v+v).
+//		}).
+//		Exec(ctx)
+func (atcb *ActionTokenCreateBulk) OnConflict(opts ...sql.ConflictOption) *ActionTokenUpsertBulk {
+	atcb.conflict = opts
+	return &ActionTokenUpsertBulk{
+		create: atcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ActionToken.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (atcb *ActionTokenCreateBulk) OnConflictColumns(columns ...string) *ActionTokenUpsertBulk {
+	atcb.conflict = append(atcb.conflict, sql.ConflictColumns(columns...))
+	return &ActionTokenUpsertBulk{
+		create: atcb,
+	}
+}
+
+// ActionTokenUpsertBulk is the builder for "upsert"-ing
+// a bulk of ActionToken nodes.
+type ActionTokenUpsertBulk struct {
+	create *ActionTokenCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ActionToken.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(actiontoken.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ActionTokenUpsertBulk) UpdateNewValues() *ActionTokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(actiontoken.FieldID)
+			}
+			if _, exists := b.mutation.Kind(); exists {
+				s.SetIgnore(actiontoken.FieldKind)
+			}
+			if _, exists := b.mutation.TokenHash(); exists {
+				s.SetIgnore(actiontoken.FieldTokenHash)
+			}
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(actiontoken.FieldUserID)
+			}
+			if _, exists := b.mutation.ExpiresAt(); exists {
+				s.SetIgnore(actiontoken.FieldExpiresAt)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(actiontoken.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ActionToken.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ActionTokenUpsertBulk) Ignore() *ActionTokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ActionTokenUpsertBulk) DoNothing() *ActionTokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ActionTokenCreateBulk.OnConflict
+// documentation for more info.
+func (u *ActionTokenUpsertBulk) Update(set func(*ActionTokenUpsert)) *ActionTokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ActionTokenUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUsedAt sets the "used_at" field.
+func (u *ActionTokenUpsertBulk) SetUsedAt(v time.Time) *ActionTokenUpsertBulk {
+	return u.Update(func(s *ActionTokenUpsert) {
+		s.SetUsedAt(v)
+	})
+}
+
+// UpdateUsedAt sets the "used_at" field to the value that was provided on create.
+func (u *ActionTokenUpsertBulk) UpdateUsedAt() *ActionTokenUpsertBulk {
+	return u.Update(func(s *ActionTokenUpsert) {
+		s.UpdateUsedAt()
+	})
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (u *ActionTokenUpsertBulk) ClearUsedAt() *ActionTokenUpsertBulk {
+	return u.Update(func(s *ActionTokenUpsert) {
+		s.ClearUsedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ActionTokenUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ActionTokenCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ActionTokenCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ActionTokenUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
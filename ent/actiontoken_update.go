@@ -0,0 +1,266 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-web/ent/actiontoken"
+	"go-web/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ActionTokenUpdate is the builder for updating ActionToken entities.
+type ActionTokenUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ActionTokenMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ActionTokenUpdate builder.
+func (atu *ActionTokenUpdate) Where(ps ...predicate.ActionToken) *ActionTokenUpdate {
+	atu.mutation.Where(ps...)
+	return atu
+}
+
+// SetUsedAt sets the "used_at" field.
+func (atu *ActionTokenUpdate) SetUsedAt(t time.Time) *ActionTokenUpdate {
+	atu.mutation.SetUsedAt(t)
+	return atu
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (atu *ActionTokenUpdate) SetNillableUsedAt(t *time.Time) *ActionTokenUpdate {
+	if t != nil {
+		atu.SetUsedAt(*t)
+	}
+	return atu
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (atu *ActionTokenUpdate) ClearUsedAt() *ActionTokenUpdate {
+	atu.mutation.ClearUsedAt()
+	return atu
+}
+
+// Mutation returns the ActionTokenMutation object of the builder.
+func (atu *ActionTokenUpdate) Mutation() *ActionTokenMutation {
+	return atu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (atu *ActionTokenUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, atu.sqlSave, atu.mutation, atu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (atu *ActionTokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := atu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (atu *ActionTokenUpdate) Exec(ctx context.Context) error {
+	_, err := atu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atu *ActionTokenUpdate) ExecX(ctx context.Context) {
+	if err := atu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (atu *ActionTokenUpdate) check() error {
+	if _, ok := atu.mutation.UserID(); atu.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ActionToken.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (atu *ActionTokenUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ActionTokenUpdate {
+	atu.modifiers = append(atu.modifiers, modifiers...)
+	return atu
+}
+
+func (atu *ActionTokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := atu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(actiontoken.Table, actiontoken.Columns, sqlgraph.NewFieldSpec(actiontoken.FieldID, field.TypeUint64))
+	if ps := atu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := atu.mutation.UsedAt(); ok {
+		_spec.SetField(actiontoken.FieldUsedAt, field.TypeTime, value)
+	}
+	if atu.mutation.UsedAtCleared() {
+		_spec.ClearField(actiontoken.FieldUsedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(atu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, atu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{actiontoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	atu.mutation.done = true
+	return n, nil
+}
+
+// ActionTokenUpdateOne is the builder for updating a single ActionToken entity.
+type ActionTokenUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ActionTokenMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUsedAt sets the "used_at" field.
+func (atuo *ActionTokenUpdateOne) SetUsedAt(t time.Time) *ActionTokenUpdateOne {
+	atuo.mutation.SetUsedAt(t)
+	return atuo
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (atuo *ActionTokenUpdateOne) SetNillableUsedAt(t *time.Time) *ActionTokenUpdateOne {
+	if t != nil {
+		atuo.SetUsedAt(*t)
+	}
+	return atuo
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (atuo *ActionTokenUpdateOne) ClearUsedAt() *ActionTokenUpdateOne {
+	atuo.mutation.ClearUsedAt()
+	return atuo
+}
+
+// Mutation returns the ActionTokenMutation object of the builder.
+func (atuo *ActionTokenUpdateOne) Mutation() *ActionTokenMutation {
+	return atuo.mutation
+}
+
+// Where appends a list predicates to the ActionTokenUpdate builder.
+func (atuo *ActionTokenUpdateOne) Where(ps ...predicate.ActionToken) *ActionTokenUpdateOne {
+	atuo.mutation.Where(ps...)
+	return atuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (atuo *ActionTokenUpdateOne) Select(field string, fields ...string) *ActionTokenUpdateOne {
+	atuo.fields = append([]string{field}, fields...)
+	return atuo
+}
+
+// Save executes the query and returns the updated ActionToken entity.
+func (atuo *ActionTokenUpdateOne) Save(ctx context.Context) (*ActionToken, error) {
+	return withHooks(ctx, atuo.sqlSave, atuo.mutation, atuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (atuo *ActionTokenUpdateOne) SaveX(ctx context.Context) *ActionToken {
+	node, err := atuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (atuo *ActionTokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := atuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atuo *ActionTokenUpdateOne) ExecX(ctx context.Context) {
+	if err := atuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (atuo *ActionTokenUpdateOne) check() error {
+	if _, ok := atuo.mutation.UserID(); atuo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ActionToken.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (atuo *ActionTokenUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ActionTokenUpdateOne {
+	atuo.modifiers = append(atuo.modifiers, modifiers...)
+	return atuo
+}
+
+func (atuo *ActionTokenUpdateOne) sqlSave(ctx context.Context) (_node *ActionToken, err error) {
+	if err := atuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(actiontoken.Table, actiontoken.Columns, sqlgraph.NewFieldSpec(actiontoken.FieldID, field.TypeUint64))
+	id, ok := atuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ActionToken.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := atuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, actiontoken.FieldID)
+		for _, f := range fields {
+			if !actiontoken.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != actiontoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := atuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := atuo.mutation.UsedAt(); ok {
+		_spec.SetField(actiontoken.FieldUsedAt, field.TypeTime, value)
+	}
+	if atuo.mutation.UsedAtCleared() {
+		_spec.ClearField(actiontoken.FieldUsedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(atuo.modifiers...)
+	_node = &ActionToken{config: atuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, atuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{actiontoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	atuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,262 @@
+// Package audit 提供记录实体变更的全局 ent hook。
+// 每次创建、更新、删除都会为受影响的实体写入一条 AuditLog，
+// 包含操作者、请求 ID 以及变更前后的字段差异，敏感字段只记录发生了变更。
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	gen "go-web/ent"
+	"go-web/ent/auditlog"
+	"go-web/ent/hook"
+	"go-web/pkg/requestid"
+	"go-web/pkg/viewer"
+
+	"entgo.io/ent"
+)
+
+// Redacted 敏感字段的值在审计记录中的占位符
+const Redacted = "[REDACTED]"
+
+// mutation 生成的实体变更都实现的方法
+type mutation interface {
+	ent.Mutation
+	Client() *gen.Client
+	ID() (uint64, bool)
+	IDs(ctx context.Context) ([]uint64, error)
+}
+
+// auditingKey 正在记录的变更。hook 将变更改写后重新提交（例如软删除把删除改为更新）时，
+// 同一个变更会再次经过本 hook，只由最外层记录一次
+type auditingKey struct{}
+
+// sensitive 敏感字段名称
+var sensitive = func() map[string]struct{} {
+	m := make(map[string]struct{}, len(gen.SensitiveFields))
+	for _, f := range gen.SensitiveFields {
+		m[f] = struct{}{}
+	}
+	return m
+}()
+
+// Hook 返回记录实体变更的 hook，通过 client.Use 对全部实体生效。
+// 变更前后的状态以系统身份读取；批量更新或删除只记录实际发生变化的实体，
+// 因此隐私策略缩小更新范围时不会产生多余记录。
+func Hook() ent.Hook {
+	return hook.On(record, ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne|ent.OpDelete|ent.OpDeleteOne)
+}
+
+// record 执行变更并写入审计记录，审计记录写入失败时整个变更返回错误
+func record(next ent.Mutator) ent.Mutator {
+	return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+		mu, ok := m.(mutation)
+		if !ok || m.Type() == gen.TypeAuditLog {
+			return next.Mutate(ctx, m)
+		}
+		if auditing, _ := ctx.Value(auditingKey{}).(ent.Mutation); auditing == m {
+			return next.Mutate(ctx, m)
+		}
+		ctx = context.WithValue(ctx, auditingKey{}, m)
+
+		client := mu.Client()
+		sysCtx := viewer.NewSystemContext(ctx)
+		// 后续 hook 可能改写操作类型，以提交时的操作为准
+		op := operation(m.Op())
+
+		var (
+			ids    []uint64
+			before map[uint64]any
+			err    error
+		)
+		if op != auditlog.OperationCreate {
+			if ids, err = mu.IDs(sysCtx); err != nil {
+				return nil, err
+			}
+			if len(ids) == 0 {
+				return next.Mutate(ctx, m)
+			}
+			if before, err = client.Snapshot(sysCtx, m.Type(), ids...); err != nil {
+				return nil, fmt.Errorf("audit: failed to load entities: %w", err)
+			}
+		}
+
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+
+		if op == auditlog.OperationCreate {
+			id, ok := mu.ID()
+			if !ok {
+				return v, nil
+			}
+			ids = []uint64{id}
+		}
+		after, err := client.Snapshot(sysCtx, m.Type(), ids...)
+		if err != nil {
+			return nil, fmt.Errorf("audit: failed to load entities: %w", err)
+		}
+
+		changed := changedSensitive(m)
+		builders := make([]*gen.AuditLogCreate, 0, len(ids))
+		for _, id := range ids {
+			if op != auditlog.OperationCreate && before[id] == nil {
+				// 实体不存在或不属于当前租户，变更没有作用于该实体
+				continue
+			}
+			if op == auditlog.OperationCreate && after[id] == nil {
+				// 批量写入时因唯一键冲突被忽略，实体未创建
+				continue
+			}
+			if op == auditlog.OperationDelete && after[id] != nil {
+				// 被隐私策略排除，实体未删除
+				continue
+			}
+			b, a := diff(fields(before[id]), fields(after[id]))
+			b, a = markSensitive(op, b, a, changed)
+			if op == auditlog.OperationUpdate && len(b) == 0 && len(a) == 0 {
+				// 实体未发生变化，例如被隐私策略排除或设置了相同的值
+				continue
+			}
+			entry := newEntry(ctx, client, m.Type(), id, op, b, a)
+			if tid, ok := tenantOf(after[id], before[id]); ok {
+				entry.SetTenantID(tid)
+			}
+			builders = append(builders, entry)
+		}
+		if len(builders) == 0 {
+			return v, nil
+		}
+		if err := client.AuditLog.CreateBulk(builders...).Exec(sysCtx); err != nil {
+			return nil, fmt.Errorf("audit: failed to write audit log: %w", err)
+		}
+		return v, nil
+	})
+}
+
+// newEntry 构造一条审计记录
+func newEntry(ctx context.Context, client *gen.Client, typ string, id uint64, op auditlog.Operation, before, after map[string]any) *gen.AuditLogCreate {
+	c := client.AuditLog.Create().
+		SetEntityType(typ).
+		SetEntityID(id).
+		SetOperation(op).
+		SetActorType(auditlog.ActorTypeAnonymous)
+	switch v := viewer.FromContext(ctx); {
+	case v != nil:
+		c.SetActorType(auditlog.ActorTypeUser).SetActorID(v.ID)
+	case viewer.IsSystem(ctx):
+		c.SetActorType(auditlog.ActorTypeSystem)
+	}
+	if rid := requestid.FromContext(ctx); rid != "" {
+		c.SetRequestID(rid)
+	}
+	if before != nil {
+		c.SetBefore(before)
+	}
+	if after != nil {
+		c.SetAfter(after)
+	}
+	return c
+}
+
+// tenantOf 返回实体所属的租户，审计记录与实体属于同一租户。
+// 实体不属于任何租户时返回 false，审计记录的租户取自 context。
+func tenantOf(entities ...any) (uint64, bool) {
+	for _, e := range entities {
+		v := reflect.ValueOf(e)
+		if v.Kind() != reflect.Pointer || v.IsNil() {
+			continue
+		}
+		if f := v.Elem().FieldByName("TenantID"); f.IsValid() && f.Kind() == reflect.Uint64 {
+			return f.Uint(), true
+		}
+	}
+	return 0, false
+}
+
+// operation 将 ent 操作映射为审计操作类型
+func operation(op ent.Op) auditlog.Operation {
+	switch {
+	case op.Is(ent.OpCreate):
+		return auditlog.OperationCreate
+	case op.Is(ent.OpDelete | ent.OpDeleteOne):
+		return auditlog.OperationDelete
+	default:
+		return auditlog.OperationUpdate
+	}
+}
+
+// changedSensitive 返回变更中设置或清空的敏感字段
+func changedSensitive(m ent.Mutation) []string {
+	var names []string
+	for _, f := range append(m.Fields(), m.ClearedFields()...) {
+		if _, ok := sensitive[f]; ok {
+			names = append(names, f)
+		}
+	}
+	return names
+}
+
+// markSensitive 在变更前后的字段中以占位符标记发生变更的敏感字段
+func markSensitive(op auditlog.Operation, before, after map[string]any, names []string) (map[string]any, map[string]any) {
+	if len(names) == 0 {
+		return before, after
+	}
+	if before == nil && op != auditlog.OperationCreate {
+		before = make(map[string]any)
+	}
+	if after == nil && op != auditlog.OperationDelete {
+		after = make(map[string]any)
+	}
+	for _, name := range names {
+		if before != nil {
+			before[name] = Redacted
+		}
+		if after != nil {
+			after[name] = Redacted
+		}
+	}
+	return before, after
+}
+
+// fields 将实体按 JSON 形式展开为字段映射，敏感字段在实体的 JSON 编码中已被忽略。
+// 实体不存在时返回 nil。
+func fields(v any) map[string]any {
+	if v == nil {
+		return nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	m := make(map[string]any)
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil
+	}
+	delete(m, "edges")
+	return m
+}
+
+// diff 只保留前后不同的字段，被清空的字段记为 nil。一侧为 nil 时原样返回
+func diff(before, after map[string]any) (map[string]any, map[string]any) {
+	if before == nil || after == nil {
+		return before, after
+	}
+	b, a := make(map[string]any), make(map[string]any)
+	for k, bv := range before {
+		av, ok := after[k]
+		if ok && reflect.DeepEqual(av, bv) {
+			continue
+		}
+		b[k], a[k] = bv, av
+	}
+	for k, av := range after {
+		if _, ok := before[k]; !ok {
+			b[k], a[k] = nil, av
+		}
+	}
+	return b, a
+}
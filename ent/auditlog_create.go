@@ -0,0 +1,695 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-web/ent/auditlog"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AuditLogCreate is the builder for creating a AuditLog entity.
+type AuditLogCreate struct {
+	config
+	mutation *AuditLogMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (alc *AuditLogCreate) SetTenantID(u uint64) *AuditLogCreate {
+	alc.mutation.SetTenantID(u)
+	return alc
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableTenantID(u *uint64) *AuditLogCreate {
+	if u != nil {
+		alc.SetTenantID(*u)
+	}
+	return alc
+}
+
+// SetActorType sets the "actor_type" field.
+func (alc *AuditLogCreate) SetActorType(at auditlog.ActorType) *AuditLogCreate {
+	alc.mutation.SetActorType(at)
+	return alc
+}
+
+// SetActorID sets the "actor_id" field.
+func (alc *AuditLogCreate) SetActorID(u uint64) *AuditLogCreate {
+	alc.mutation.SetActorID(u)
+	return alc
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableActorID(u *uint64) *AuditLogCreate {
+	if u != nil {
+		alc.SetActorID(*u)
+	}
+	return alc
+}
+
+// SetRequestID sets the "request_id" field.
+func (alc *AuditLogCreate) SetRequestID(s string) *AuditLogCreate {
+	alc.mutation.SetRequestID(s)
+	return alc
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableRequestID(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetRequestID(*s)
+	}
+	return alc
+}
+
+// SetEntityType sets the "entity_type" field.
+func (alc *AuditLogCreate) SetEntityType(s string) *AuditLogCreate {
+	alc.mutation.SetEntityType(s)
+	return alc
+}
+
+// SetEntityID sets the "entity_id" field.
+func (alc *AuditLogCreate) SetEntityID(u uint64) *AuditLogCreate {
+	alc.mutation.SetEntityID(u)
+	return alc
+}
+
+// SetOperation sets the "operation" field.
+func (alc *AuditLogCreate) SetOperation(a auditlog.Operation) *AuditLogCreate {
+	alc.mutation.SetOperation(a)
+	return alc
+}
+
+// SetBefore sets the "before" field.
+func (alc *AuditLogCreate) SetBefore(m map[string]interface{}) *AuditLogCreate {
+	alc.mutation.SetBefore(m)
+	return alc
+}
+
+// SetAfter sets the "after" field.
+func (alc *AuditLogCreate) SetAfter(m map[string]interface{}) *AuditLogCreate {
+	alc.mutation.SetAfter(m)
+	return alc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (alc *AuditLogCreate) SetCreatedAt(t time.Time) *AuditLogCreate {
+	alc.mutation.SetCreatedAt(t)
+	return alc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableCreatedAt(t *time.Time) *AuditLogCreate {
+	if t != nil {
+		alc.SetCreatedAt(*t)
+	}
+	return alc
+}
+
+// SetID sets the "id" field.
+func (alc *AuditLogCreate) SetID(u uint64) *AuditLogCreate {
+	alc.mutation.SetID(u)
+	return alc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableID(u *uint64) *AuditLogCreate {
+	if u != nil {
+		alc.SetID(*u)
+	}
+	return alc
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (alc *AuditLogCreate) Mutation() *AuditLogMutation {
+	return alc.mutation
+}
+
+// Save creates the AuditLog in the database.
+func (alc *AuditLogCreate) Save(ctx context.Context) (*AuditLog, error) {
+	if err := alc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, alc.sqlSave, alc.mutation, alc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (alc *AuditLogCreate) SaveX(ctx context.Context) *AuditLog {
+	v, err := alc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (alc *AuditLogCreate) Exec(ctx context.Context) error {
+	_, err := alc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (alc *AuditLogCreate) ExecX(ctx context.Context) {
+	if err := alc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (alc *AuditLogCreate) defaults() error {
+	if _, ok := alc.mutation.CreatedAt(); !ok {
+		if auditlog.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized auditlog.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := auditlog.DefaultCreatedAt()
+		alc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := alc.mutation.ID(); !ok {
+		if auditlog.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized auditlog.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := auditlog.DefaultID()
+		alc.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (alc *AuditLogCreate) check() error {
+	if _, ok := alc.mutation.ActorType(); !ok {
+		return &ValidationError{Name: "actor_type", err: errors.New(`ent: missing required field "AuditLog.actor_type"`)}
+	}
+	if v, ok := alc.mutation.ActorType(); ok {
+		if err := auditlog.ActorTypeValidator(v); err != nil {
+			return &ValidationError{Name: "actor_type", err: fmt.Errorf(`ent: validator failed for field "AuditLog.actor_type": %w`, err)}
+		}
+	}
+	if v, ok := alc.mutation.RequestID(); ok {
+		if err := auditlog.RequestIDValidator(v); err != nil {
+			return &ValidationError{Name: "request_id", err: fmt.Errorf(`ent: validator failed for field "AuditLog.request_id": %w`, err)}
+		}
+	}
+	if _, ok := alc.mutation.EntityType(); !ok {
+		return &ValidationError{Name: "entity_type", err: errors.New(`ent: missing required field "AuditLog.entity_type"`)}
+	}
+	if v, ok := alc.mutation.EntityType(); ok {
+		if err := auditlog.EntityTypeValidator(v); err != nil {
+			return &ValidationError{Name: "entity_type", err: fmt.Errorf(`ent: validator failed for field "AuditLog.entity_type": %w`, err)}
+		}
+	}
+	if _, ok := alc.mutation.EntityID(); !ok {
+		return &ValidationError{Name: "entity_id", err: errors.New(`ent: missing required field "AuditLog.entity_id"`)}
+	}
+	if _, ok := alc.mutation.Operation(); !ok {
+		return &ValidationError{Name: "operation", err: errors.New(`ent: missing required field "AuditLog.operation"`)}
+	}
+	if v, ok := alc.mutation.Operation(); ok {
+		if err := auditlog.OperationValidator(v); err != nil {
+			return &ValidationError{Name: "operation", err: fmt.Errorf(`ent: validator failed for field "AuditLog.operation": %w`, err)}
+		}
+	}
+	if _, ok := alc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AuditLog.created_at"`)}
+	}
+	if v, ok := alc.mutation.ID(); ok {
+		if err := auditlog.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "AuditLog.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (alc *AuditLogCreate) sqlSave(ctx context.Context) (*AuditLog, error) {
+	if err := alc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := alc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, alc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	alc.mutation.id = &_node.ID
+	alc.mutation.done = true
+	return _node, nil
+}
+
+func (alc *AuditLogCreate) createSpec() (*AuditLog, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuditLog{config: alc.config}
+		_spec = sqlgraph.NewCreateSpec(auditlog.Table, sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeUint64))
+	)
+	_spec.OnConflict = alc.conflict
+	if id, ok := alc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := alc.mutation.TenantID(); ok {
+		_spec.SetField(auditlog.FieldTenantID, field.TypeUint64, value)
+		_node.TenantID = &value
+	}
+	if value, ok := alc.mutation.ActorType(); ok {
+		_spec.SetField(auditlog.FieldActorType, field.TypeEnum, value)
+		_node.ActorType = value
+	}
+	if value, ok := alc.mutation.ActorID(); ok {
+		_spec.SetField(auditlog.FieldActorID, field.TypeUint64, value)
+		_node.ActorID = &value
+	}
+	if value, ok := alc.mutation.RequestID(); ok {
+		_spec.SetField(auditlog.FieldRequestID, field.TypeString, value)
+		_node.RequestID = value
+	}
+	if value, ok := alc.mutation.EntityType(); ok {
+		_spec.SetField(auditlog.FieldEntityType, field.TypeString, value)
+		_node.EntityType = value
+	}
+	if value, ok := alc.mutation.EntityID(); ok {
+		_spec.SetField(auditlog.FieldEntityID, field.TypeUint64, value)
+		_node.EntityID = value
+	}
+	if value, ok := alc.mutation.Operation(); ok {
+		_spec.SetField(auditlog.FieldOperation, field.TypeEnum, value)
+		_node.Operation = value
+	}
+	if value, ok := alc.mutation.Before(); ok {
+		_spec.SetField(auditlog.FieldBefore, field.TypeJSON, value)
+		_node.Before = value
+	}
+	if value, ok := alc.mutation.After(); ok {
+		_spec.SetField(auditlog.FieldAfter, field.TypeJSON, value)
+		_node.After = value
+	}
+	if value, ok := alc.mutation.CreatedAt(); ok {
+		_spec.SetField(auditlog.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AuditLog.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AuditLogUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (alc *AuditLogCreate) OnConflict(opts ...sql.ConflictOption) *AuditLogUpsertOne {
+	alc.conflict = opts
+	return &AuditLogUpsertOne{
+		create: alc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AuditLog.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (alc *AuditLogCreate) OnConflictColumns(columns ...string) *AuditLogUpsertOne {
+	alc.conflict = append(alc.conflict, sql.ConflictColumns(columns...))
+	return &AuditLogUpsertOne{
+		create: alc,
+	}
+}
+
+type (
+	// AuditLogUpsertOne is the builder for "upsert"-ing
+	//  one AuditLog node.
+	AuditLogUpsertOne struct {
+		create *AuditLogCreate
+	}
+
+	// AuditLogUpsert is the "OnConflict" setter.
+	AuditLogUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.AuditLog.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(auditlog.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AuditLogUpsertOne) UpdateNewValues() *AuditLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(auditlog.FieldID)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(auditlog.FieldTenantID)
+		}
+		if _, exists := u.create.mutation.ActorType(); exists {
+			s.SetIgnore(auditlog.FieldActorType)
+		}
+		if _, exists := u.create.mutation.ActorID(); exists {
+			s.SetIgnore(auditlog.FieldActorID)
+		}
+		if _, exists := u.create.mutation.RequestID(); exists {
+			s.SetIgnore(auditlog.FieldRequestID)
+		}
+		if _, exists := u.create.mutation.EntityType(); exists {
+			s.SetIgnore(auditlog.FieldEntityType)
+		}
+		if _, exists := u.create.mutation.EntityID(); exists {
+			s.SetIgnore(auditlog.FieldEntityID)
+		}
+		if _, exists := u.create.mutation.Operation(); exists {
+			s.SetIgnore(auditlog.FieldOperation)
+		}
+		if _, exists := u.create.mutation.Before(); exists {
+			s.SetIgnore(auditlog.FieldBefore)
+		}
+		if _, exists := u.create.mutation.After(); exists {
+			s.SetIgnore(auditlog.FieldAfter)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(auditlog.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AuditLog.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AuditLogUpsertOne) Ignore() *AuditLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AuditLogUpsertOne) DoNothing() *AuditLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AuditLogCreate.OnConflict
+// documentation for more info.
+func (u *AuditLogUpsertOne) Update(set func(*AuditLogUpsert)) *AuditLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AuditLogUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *AuditLogUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AuditLogCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AuditLogUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AuditLogUpsertOne) ID(ctx context.Context) (id uint64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AuditLogUpsertOne) IDX(ctx context.Context) uint64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AuditLogCreateBulk is the builder for creating many AuditLog entities in bulk.
+type AuditLogCreateBulk struct {
+	config
+	err      error
+	builders []*AuditLogCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AuditLog entities in the database.
+func (alcb *AuditLogCreateBulk) Save(ctx context.Context) ([]*AuditLog, error) {
+	if alcb.err != nil {
+		return nil, alcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(alcb.builders))
+	nodes := make([]*AuditLog, len(alcb.builders))
+	mutators := make([]Mutator, len(alcb.builders))
+	for i := range alcb.builders {
+		func(i int, root context.Context) {
+			builder := alcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuditLogMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, alcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = alcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, alcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, alcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (alcb *AuditLogCreateBulk) SaveX(ctx context.Context) []*AuditLog {
+	v, err := alcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (alcb *AuditLogCreateBulk) Exec(ctx context.Context) error {
+	_, err := alcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (alcb *AuditLogCreateBulk) ExecX(ctx context.Context) {
+	if err := alcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AuditLog.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AuditLogUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (alcb *AuditLogCreateBulk) OnConflict(opts ...sql.ConflictOption) *AuditLogUpsertBulk {
+	alcb.conflict = opts
+	return &AuditLogUpsertBulk{
+		create: alcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AuditLog.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (alcb *AuditLogCreateBulk) OnConflictColumns(columns ...string) *AuditLogUpsertBulk {
+	alcb.conflict = append(alcb.conflict, sql.ConflictColumns(columns...))
+	return &AuditLogUpsertBulk{
+		create: alcb,
+	}
+}
+
+// AuditLogUpsertBulk is the builder for "upsert"-ing
+// a bulk of AuditLog nodes.
+type AuditLogUpsertBulk struct {
+	create *AuditLogCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AuditLog.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(auditlog.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AuditLogUpsertBulk) UpdateNewValues() *AuditLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(auditlog.FieldID)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(auditlog.FieldTenantID)
+			}
+			if _, exists := b.mutation.ActorType(); exists {
+				s.SetIgnore(auditlog.FieldActorType)
+			}
+			if _, exists := b.mutation.ActorID(); exists {
+				s.SetIgnore(auditlog.FieldActorID)
+			}
+			if _, exists := b.mutation.RequestID(); exists {
+				s.SetIgnore(auditlog.FieldRequestID)
+			}
+			if _, exists := b.mutation.EntityType(); exists {
+				s.SetIgnore(auditlog.FieldEntityType)
+			}
+			if _, exists := b.mutation.EntityID(); exists {
+				s.SetIgnore(auditlog.FieldEntityID)
+			}
+			if _, exists := b.mutation.Operation(); exists {
+				s.SetIgnore(auditlog.FieldOperation)
+			}
+			if _, exists := b.mutation.Before(); exists {
+				s.SetIgnore(auditlog.FieldBefore)
+			}
+			if _, exists := b.mutation.After(); exists {
+				s.SetIgnore(auditlog.FieldAfter)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(auditlog.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AuditLog.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AuditLogUpsertBulk) Ignore() *AuditLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AuditLogUpsertBulk) DoNothing() *AuditLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AuditLogCreateBulk.OnConflict
+// documentation for more info.
+func (u *AuditLogUpsertBulk) Update(set func(*AuditLogUpsert)) *AuditLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AuditLogUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *AuditLogUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AuditLogCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AuditLogCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AuditLogUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
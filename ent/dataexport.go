@@ -0,0 +1,242 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"go-web/ent/dataexport"
+	"go-web/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// DataExport is the model entity for the DataExport schema.
+type DataExport struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// 所属租户
+	TenantID uint64 `json:"tenant_id,omitempty"`
+	// 被导出数据的用户
+	UserID uint64 `json:"user_id,omitempty"`
+	// 发起导出的用户，管理员代为导出时与 user_id 不同
+	RequestedBy *uint64 `json:"requested_by,omitempty"`
+	// 任务状态
+	Status dataexport.Status `json:"status,omitempty"`
+	// 失败原因
+	Error *string `json:"error,omitempty"`
+	// 归档大小（字节）
+	Size *int64 `json:"size,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 完成时间
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	// 归档过期时间，过期后删除且无法下载
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DataExportQuery when eager-loading is set.
+	Edges        DataExportEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// DataExportEdges holds the relations/edges for other nodes in the graph.
+type DataExportEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DataExportEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.User == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DataExport) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case dataexport.FieldID, dataexport.FieldTenantID, dataexport.FieldUserID, dataexport.FieldRequestedBy, dataexport.FieldSize:
+			values[i] = new(sql.NullInt64)
+		case dataexport.FieldStatus, dataexport.FieldError:
+			values[i] = new(sql.NullString)
+		case dataexport.FieldCreatedAt, dataexport.FieldCompletedAt, dataexport.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DataExport fields.
+func (de *DataExport) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case dataexport.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			de.ID = uint64(value.Int64)
+		case dataexport.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				de.TenantID = uint64(value.Int64)
+			}
+		case dataexport.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				de.UserID = uint64(value.Int64)
+			}
+		case dataexport.FieldRequestedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field requested_by", values[i])
+			} else if value.Valid {
+				de.RequestedBy = new(uint64)
+				*de.RequestedBy = uint64(value.Int64)
+			}
+		case dataexport.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				de.Status = dataexport.Status(value.String)
+			}
+		case dataexport.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				de.Error = new(string)
+				*de.Error = value.String
+			}
+		case dataexport.FieldSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[i])
+			} else if value.Valid {
+				de.Size = new(int64)
+				*de.Size = value.Int64
+			}
+		case dataexport.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				de.CreatedAt = value.Time
+			}
+		case dataexport.FieldCompletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
+			} else if value.Valid {
+				de.CompletedAt = new(time.Time)
+				*de.CompletedAt = value.Time
+			}
+		case dataexport.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				de.ExpiresAt = new(time.Time)
+				*de.ExpiresAt = value.Time
+			}
+		default:
+			de.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DataExport.
+// This includes values selected through modifiers, order, etc.
+func (de *DataExport) Value(name string) (ent.Value, error) {
+	return de.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the DataExport entity.
+func (de *DataExport) QueryUser() *UserQuery {
+	return NewDataExportClient(de.config).QueryUser(de)
+}
+
+// Update returns a builder for updating this DataExport.
+// Note that you need to call DataExport.Unwrap() before calling this method if this DataExport
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (de *DataExport) Update() *DataExportUpdateOne {
+	return NewDataExportClient(de.config).UpdateOne(de)
+}
+
+// Unwrap unwraps the DataExport entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (de *DataExport) Unwrap() *DataExport {
+	_tx, ok := de.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DataExport is not a transactional entity")
+	}
+	de.config.driver = _tx.drv
+	return de
+}
+
+// String implements the fmt.Stringer.
+func (de *DataExport) String() string {
+	var builder strings.Builder
+	builder.WriteString("DataExport(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", de.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", de.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", de.UserID))
+	builder.WriteString(", ")
+	if v := de.RequestedBy; v != nil {
+		builder.WriteString("requested_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", de.Status))
+	builder.WriteString(", ")
+	if v := de.Error; v != nil {
+		builder.WriteString("error=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := de.Size; v != nil {
+		builder.WriteString("size=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(de.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := de.CompletedAt; v != nil {
+		builder.WriteString("completed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := de.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DataExports is a parsable slice of DataExport.
+type DataExports []*DataExport
@@ -0,0 +1,502 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-web/ent/dataexport"
+	"go-web/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DataExportUpdate is the builder for updating DataExport entities.
+type DataExportUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *DataExportMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the DataExportUpdate builder.
+func (deu *DataExportUpdate) Where(ps ...predicate.DataExport) *DataExportUpdate {
+	deu.mutation.Where(ps...)
+	return deu
+}
+
+// SetStatus sets the "status" field.
+func (deu *DataExportUpdate) SetStatus(d dataexport.Status) *DataExportUpdate {
+	deu.mutation.SetStatus(d)
+	return deu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (deu *DataExportUpdate) SetNillableStatus(d *dataexport.Status) *DataExportUpdate {
+	if d != nil {
+		deu.SetStatus(*d)
+	}
+	return deu
+}
+
+// SetError sets the "error" field.
+func (deu *DataExportUpdate) SetError(s string) *DataExportUpdate {
+	deu.mutation.SetError(s)
+	return deu
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (deu *DataExportUpdate) SetNillableError(s *string) *DataExportUpdate {
+	if s != nil {
+		deu.SetError(*s)
+	}
+	return deu
+}
+
+// ClearError clears the value of the "error" field.
+func (deu *DataExportUpdate) ClearError() *DataExportUpdate {
+	deu.mutation.ClearError()
+	return deu
+}
+
+// SetSize sets the "size" field.
+func (deu *DataExportUpdate) SetSize(i int64) *DataExportUpdate {
+	deu.mutation.ResetSize()
+	deu.mutation.SetSize(i)
+	return deu
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (deu *DataExportUpdate) SetNillableSize(i *int64) *DataExportUpdate {
+	if i != nil {
+		deu.SetSize(*i)
+	}
+	return deu
+}
+
+// AddSize adds i to the "size" field.
+func (deu *DataExportUpdate) AddSize(i int64) *DataExportUpdate {
+	deu.mutation.AddSize(i)
+	return deu
+}
+
+// ClearSize clears the value of the "size" field.
+func (deu *DataExportUpdate) ClearSize() *DataExportUpdate {
+	deu.mutation.ClearSize()
+	return deu
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (deu *DataExportUpdate) SetCompletedAt(t time.Time) *DataExportUpdate {
+	deu.mutation.SetCompletedAt(t)
+	return deu
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (deu *DataExportUpdate) SetNillableCompletedAt(t *time.Time) *DataExportUpdate {
+	if t != nil {
+		deu.SetCompletedAt(*t)
+	}
+	return deu
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (deu *DataExportUpdate) ClearCompletedAt() *DataExportUpdate {
+	deu.mutation.ClearCompletedAt()
+	return deu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (deu *DataExportUpdate) SetExpiresAt(t time.Time) *DataExportUpdate {
+	deu.mutation.SetExpiresAt(t)
+	return deu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (deu *DataExportUpdate) SetNillableExpiresAt(t *time.Time) *DataExportUpdate {
+	if t != nil {
+		deu.SetExpiresAt(*t)
+	}
+	return deu
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (deu *DataExportUpdate) ClearExpiresAt() *DataExportUpdate {
+	deu.mutation.ClearExpiresAt()
+	return deu
+}
+
+// Mutation returns the DataExportMutation object of the builder.
+func (deu *DataExportUpdate) Mutation() *DataExportMutation {
+	return deu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (deu *DataExportUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, deu.sqlSave, deu.mutation, deu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (deu *DataExportUpdate) SaveX(ctx context.Context) int {
+	affected, err := deu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (deu *DataExportUpdate) Exec(ctx context.Context) error {
+	_, err := deu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (deu *DataExportUpdate) ExecX(ctx context.Context) {
+	if err := deu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (deu *DataExportUpdate) check() error {
+	if v, ok := deu.mutation.Status(); ok {
+		if err := dataexport.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DataExport.status": %w`, err)}
+		}
+	}
+	if v, ok := deu.mutation.Error(); ok {
+		if err := dataexport.ErrorValidator(v); err != nil {
+			return &ValidationError{Name: "error", err: fmt.Errorf(`ent: validator failed for field "DataExport.error": %w`, err)}
+		}
+	}
+	if _, ok := deu.mutation.UserID(); deu.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "DataExport.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (deu *DataExportUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DataExportUpdate {
+	deu.modifiers = append(deu.modifiers, modifiers...)
+	return deu
+}
+
+func (deu *DataExportUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := deu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(dataexport.Table, dataexport.Columns, sqlgraph.NewFieldSpec(dataexport.FieldID, field.TypeUint64))
+	if ps := deu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if deu.mutation.RequestedByCleared() {
+		_spec.ClearField(dataexport.FieldRequestedBy, field.TypeUint64)
+	}
+	if value, ok := deu.mutation.Status(); ok {
+		_spec.SetField(dataexport.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := deu.mutation.Error(); ok {
+		_spec.SetField(dataexport.FieldError, field.TypeString, value)
+	}
+	if deu.mutation.ErrorCleared() {
+		_spec.ClearField(dataexport.FieldError, field.TypeString)
+	}
+	if value, ok := deu.mutation.Size(); ok {
+		_spec.SetField(dataexport.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := deu.mutation.AddedSize(); ok {
+		_spec.AddField(dataexport.FieldSize, field.TypeInt64, value)
+	}
+	if deu.mutation.SizeCleared() {
+		_spec.ClearField(dataexport.FieldSize, field.TypeInt64)
+	}
+	if value, ok := deu.mutation.CompletedAt(); ok {
+		_spec.SetField(dataexport.FieldCompletedAt, field.TypeTime, value)
+	}
+	if deu.mutation.CompletedAtCleared() {
+		_spec.ClearField(dataexport.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := deu.mutation.ExpiresAt(); ok {
+		_spec.SetField(dataexport.FieldExpiresAt, field.TypeTime, value)
+	}
+	if deu.mutation.ExpiresAtCleared() {
+		_spec.ClearField(dataexport.FieldExpiresAt, field.TypeTime)
+	}
+	_spec.AddModifiers(deu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, deu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dataexport.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	deu.mutation.done = true
+	return n, nil
+}
+
+// DataExportUpdateOne is the builder for updating a single DataExport entity.
+type DataExportUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *DataExportMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetStatus sets the "status" field.
+func (deuo *DataExportUpdateOne) SetStatus(d dataexport.Status) *DataExportUpdateOne {
+	deuo.mutation.SetStatus(d)
+	return deuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (deuo *DataExportUpdateOne) SetNillableStatus(d *dataexport.Status) *DataExportUpdateOne {
+	if d != nil {
+		deuo.SetStatus(*d)
+	}
+	return deuo
+}
+
+// SetError sets the "error" field.
+func (deuo *DataExportUpdateOne) SetError(s string) *DataExportUpdateOne {
+	deuo.mutation.SetError(s)
+	return deuo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (deuo *DataExportUpdateOne) SetNillableError(s *string) *DataExportUpdateOne {
+	if s != nil {
+		deuo.SetError(*s)
+	}
+	return deuo
+}
+
+// ClearError clears the value of the "error" field.
+func (deuo *DataExportUpdateOne) ClearError() *DataExportUpdateOne {
+	deuo.mutation.ClearError()
+	return deuo
+}
+
+// SetSize sets the "size" field.
+func (deuo *DataExportUpdateOne) SetSize(i int64) *DataExportUpdateOne {
+	deuo.mutation.ResetSize()
+	deuo.mutation.SetSize(i)
+	return deuo
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (deuo *DataExportUpdateOne) SetNillableSize(i *int64) *DataExportUpdateOne {
+	if i != nil {
+		deuo.SetSize(*i)
+	}
+	return deuo
+}
+
+// AddSize adds i to the "size" field.
+func (deuo *DataExportUpdateOne) AddSize(i int64) *DataExportUpdateOne {
+	deuo.mutation.AddSize(i)
+	return deuo
+}
+
+// ClearSize clears the value of the "size" field.
+func (deuo *DataExportUpdateOne) ClearSize() *DataExportUpdateOne {
+	deuo.mutation.ClearSize()
+	return deuo
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (deuo *DataExportUpdateOne) SetCompletedAt(t time.Time) *DataExportUpdateOne {
+	deuo.mutation.SetCompletedAt(t)
+	return deuo
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (deuo *DataExportUpdateOne) SetNillableCompletedAt(t *time.Time) *DataExportUpdateOne {
+	if t != nil {
+		deuo.SetCompletedAt(*t)
+	}
+	return deuo
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (deuo *DataExportUpdateOne) ClearCompletedAt() *DataExportUpdateOne {
+	deuo.mutation.ClearCompletedAt()
+	return deuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (deuo *DataExportUpdateOne) SetExpiresAt(t time.Time) *DataExportUpdateOne {
+	deuo.mutation.SetExpiresAt(t)
+	return deuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (deuo *DataExportUpdateOne) SetNillableExpiresAt(t *time.Time) *DataExportUpdateOne {
+	if t != nil {
+		deuo.SetExpiresAt(*t)
+	}
+	return deuo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (deuo *DataExportUpdateOne) ClearExpiresAt() *DataExportUpdateOne {
+	deuo.mutation.ClearExpiresAt()
+	return deuo
+}
+
+// Mutation returns the DataExportMutation object of the builder.
+func (deuo *DataExportUpdateOne) Mutation() *DataExportMutation {
+	return deuo.mutation
+}
+
+// Where appends a list predicates to the DataExportUpdate builder.
+func (deuo *DataExportUpdateOne) Where(ps ...predicate.DataExport) *DataExportUpdateOne {
+	deuo.mutation.Where(ps...)
+	return deuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (deuo *DataExportUpdateOne) Select(field string, fields ...string) *DataExportUpdateOne {
+	deuo.fields = append([]string{field}, fields...)
+	return deuo
+}
+
+// Save executes the query and returns the updated DataExport entity.
+func (deuo *DataExportUpdateOne) Save(ctx context.Context) (*DataExport, error) {
+	return withHooks(ctx, deuo.sqlSave, deuo.mutation, deuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (deuo *DataExportUpdateOne) SaveX(ctx context.Context) *DataExport {
+	node, err := deuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (deuo *DataExportUpdateOne) Exec(ctx context.Context) error {
+	_, err := deuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (deuo *DataExportUpdateOne) ExecX(ctx context.Context) {
+	if err := deuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (deuo *DataExportUpdateOne) check() error {
+	if v, ok := deuo.mutation.Status(); ok {
+		if err := dataexport.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DataExport.status": %w`, err)}
+		}
+	}
+	if v, ok := deuo.mutation.Error(); ok {
+		if err := dataexport.ErrorValidator(v); err != nil {
+			return &ValidationError{Name: "error", err: fmt.Errorf(`ent: validator failed for field "DataExport.error": %w`, err)}
+		}
+	}
+	if _, ok := deuo.mutation.UserID(); deuo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "DataExport.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (deuo *DataExportUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DataExportUpdateOne {
+	deuo.modifiers = append(deuo.modifiers, modifiers...)
+	return deuo
+}
+
+func (deuo *DataExportUpdateOne) sqlSave(ctx context.Context) (_node *DataExport, err error) {
+	if err := deuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(dataexport.Table, dataexport.Columns, sqlgraph.NewFieldSpec(dataexport.FieldID, field.TypeUint64))
+	id, ok := deuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DataExport.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := deuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, dataexport.FieldID)
+		for _, f := range fields {
+			if !dataexport.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != dataexport.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := deuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if deuo.mutation.RequestedByCleared() {
+		_spec.ClearField(dataexport.FieldRequestedBy, field.TypeUint64)
+	}
+	if value, ok := deuo.mutation.Status(); ok {
+		_spec.SetField(dataexport.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := deuo.mutation.Error(); ok {
+		_spec.SetField(dataexport.FieldError, field.TypeString, value)
+	}
+	if deuo.mutation.ErrorCleared() {
+		_spec.ClearField(dataexport.FieldError, field.TypeString)
+	}
+	if value, ok := deuo.mutation.Size(); ok {
+		_spec.SetField(dataexport.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := deuo.mutation.AddedSize(); ok {
+		_spec.AddField(dataexport.FieldSize, field.TypeInt64, value)
+	}
+	if deuo.mutation.SizeCleared() {
+		_spec.ClearField(dataexport.FieldSize, field.TypeInt64)
+	}
+	if value, ok := deuo.mutation.CompletedAt(); ok {
+		_spec.SetField(dataexport.FieldCompletedAt, field.TypeTime, value)
+	}
+	if deuo.mutation.CompletedAtCleared() {
+		_spec.ClearField(dataexport.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := deuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(dataexport.FieldExpiresAt, field.TypeTime, value)
+	}
+	if deuo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(dataexport.FieldExpiresAt, field.TypeTime)
+	}
+	_spec.AddModifiers(deuo.modifiers...)
+	_node = &DataExport{config: deuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, deuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dataexport.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	deuo.mutation.done = true
+	return _node, nil
+}
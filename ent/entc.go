@@ -27,7 +27,7 @@ func main() {
 		entc.TemplateDir("./template"),
 	}
 	if err := entc.Generate("./schema", &gen.Config{
-		Features: []gen.Feature{gen.FeatureVersionedMigration, gen.FeatureExecQuery, gen.FeatureLock, gen.FeatureModifier, gen.FeatureUpsert},
+		Features: []gen.Feature{gen.FeatureVersionedMigration, gen.FeatureExecQuery, gen.FeatureLock, gen.FeatureModifier, gen.FeatureUpsert, gen.FeaturePrivacy, gen.FeatureIntercept},
 		IDType:   &field.TypeInfo{Type: field.TypeUint64},
 	}, opts...); err != nil {
 		log.Fatalf("running ent codegen: %v", err)
@@ -4,11 +4,984 @@ package ent
 
 import (
 	"context"
+	"go-web/ent/accounterasure"
+	"go-web/ent/apikey"
+	"go-web/ent/auditlog"
+	"go-web/ent/dataexport"
+	"go-web/ent/file"
+	"go-web/ent/identity"
+	"go-web/ent/permission"
+	"go-web/ent/role"
+	"go-web/ent/tenant"
+	"go-web/ent/user"
 
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent/dialect/sql"
 	"github.com/99designs/gqlgen/graphql"
 )
 
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (ak *APIKeyQuery) CollectFields(ctx context.Context, satisfies ...string) (*APIKeyQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return ak, nil
+	}
+	if err := ak.collectField(ctx, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return ak, nil
+}
+
+func (ak *APIKeyQuery) collectField(ctx context.Context, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(apikey.Columns))
+		selectedFields = []string{apikey.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+		case "owner":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&UserClient{config: ak.config}).Query()
+			)
+			if err := query.collectField(ctx, opCtx, field, path, satisfies...); err != nil {
+				return err
+			}
+			ak.withOwner = query
+			if _, ok := fieldSeen[apikey.FieldOwnerID]; !ok {
+				selectedFields = append(selectedFields, apikey.FieldOwnerID)
+				fieldSeen[apikey.FieldOwnerID] = struct{}{}
+			}
+		case "tenantID":
+			if _, ok := fieldSeen[apikey.FieldTenantID]; !ok {
+				selectedFields = append(selectedFields, apikey.FieldTenantID)
+				fieldSeen[apikey.FieldTenantID] = struct{}{}
+			}
+		case "name":
+			if _, ok := fieldSeen[apikey.FieldName]; !ok {
+				selectedFields = append(selectedFields, apikey.FieldName)
+				fieldSeen[apikey.FieldName] = struct{}{}
+			}
+		case "prefix":
+			if _, ok := fieldSeen[apikey.FieldPrefix]; !ok {
+				selectedFields = append(selectedFields, apikey.FieldPrefix)
+				fieldSeen[apikey.FieldPrefix] = struct{}{}
+			}
+		case "scopes":
+			if _, ok := fieldSeen[apikey.FieldScopes]; !ok {
+				selectedFields = append(selectedFields, apikey.FieldScopes)
+				fieldSeen[apikey.FieldScopes] = struct{}{}
+			}
+		case "ownerID":
+			if _, ok := fieldSeen[apikey.FieldOwnerID]; !ok {
+				selectedFields = append(selectedFields, apikey.FieldOwnerID)
+				fieldSeen[apikey.FieldOwnerID] = struct{}{}
+			}
+		case "expiresAt":
+			if _, ok := fieldSeen[apikey.FieldExpiresAt]; !ok {
+				selectedFields = append(selectedFields, apikey.FieldExpiresAt)
+				fieldSeen[apikey.FieldExpiresAt] = struct{}{}
+			}
+		case "lastUsedAt":
+			if _, ok := fieldSeen[apikey.FieldLastUsedAt]; !ok {
+				selectedFields = append(selectedFields, apikey.FieldLastUsedAt)
+				fieldSeen[apikey.FieldLastUsedAt] = struct{}{}
+			}
+		case "revokedAt":
+			if _, ok := fieldSeen[apikey.FieldRevokedAt]; !ok {
+				selectedFields = append(selectedFields, apikey.FieldRevokedAt)
+				fieldSeen[apikey.FieldRevokedAt] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[apikey.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, apikey.FieldCreatedAt)
+				fieldSeen[apikey.FieldCreatedAt] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		ak.Select(selectedFields...)
+	}
+	return nil
+}
+
+type apikeyPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []APIKeyPaginateOption
+}
+
+func newAPIKeyPaginateArgs(rv map[string]any) *apikeyPaginateArgs {
+	args := &apikeyPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*APIKeyWhereInput); ok {
+		args.opts = append(args.opts, WithAPIKeyFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (ae *AccountErasureQuery) CollectFields(ctx context.Context, satisfies ...string) (*AccountErasureQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return ae, nil
+	}
+	if err := ae.collectField(ctx, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return ae, nil
+}
+
+func (ae *AccountErasureQuery) collectField(ctx context.Context, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(accounterasure.Columns))
+		selectedFields = []string{accounterasure.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+		case "tenantID":
+			if _, ok := fieldSeen[accounterasure.FieldTenantID]; !ok {
+				selectedFields = append(selectedFields, accounterasure.FieldTenantID)
+				fieldSeen[accounterasure.FieldTenantID] = struct{}{}
+			}
+		case "userID":
+			if _, ok := fieldSeen[accounterasure.FieldUserID]; !ok {
+				selectedFields = append(selectedFields, accounterasure.FieldUserID)
+				fieldSeen[accounterasure.FieldUserID] = struct{}{}
+			}
+		case "status":
+			if _, ok := fieldSeen[accounterasure.FieldStatus]; !ok {
+				selectedFields = append(selectedFields, accounterasure.FieldStatus)
+				fieldSeen[accounterasure.FieldStatus] = struct{}{}
+			}
+		case "scheduledFor":
+			if _, ok := fieldSeen[accounterasure.FieldScheduledFor]; !ok {
+				selectedFields = append(selectedFields, accounterasure.FieldScheduledFor)
+				fieldSeen[accounterasure.FieldScheduledFor] = struct{}{}
+			}
+		case "startedAt":
+			if _, ok := fieldSeen[accounterasure.FieldStartedAt]; !ok {
+				selectedFields = append(selectedFields, accounterasure.FieldStartedAt)
+				fieldSeen[accounterasure.FieldStartedAt] = struct{}{}
+			}
+		case "completedAt":
+			if _, ok := fieldSeen[accounterasure.FieldCompletedAt]; !ok {
+				selectedFields = append(selectedFields, accounterasure.FieldCompletedAt)
+				fieldSeen[accounterasure.FieldCompletedAt] = struct{}{}
+			}
+		case "cancelledAt":
+			if _, ok := fieldSeen[accounterasure.FieldCancelledAt]; !ok {
+				selectedFields = append(selectedFields, accounterasure.FieldCancelledAt)
+				fieldSeen[accounterasure.FieldCancelledAt] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[accounterasure.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, accounterasure.FieldCreatedAt)
+				fieldSeen[accounterasure.FieldCreatedAt] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		ae.Select(selectedFields...)
+	}
+	return nil
+}
+
+type accounterasurePaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []AccountErasurePaginateOption
+}
+
+func newAccountErasurePaginateArgs(rv map[string]any) *accounterasurePaginateArgs {
+	args := &accounterasurePaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*AccountErasureWhereInput); ok {
+		args.opts = append(args.opts, WithAccountErasureFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (al *AuditLogQuery) CollectFields(ctx context.Context, satisfies ...string) (*AuditLogQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return al, nil
+	}
+	if err := al.collectField(ctx, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return al, nil
+}
+
+func (al *AuditLogQuery) collectField(ctx context.Context, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(auditlog.Columns))
+		selectedFields = []string{auditlog.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+		case "tenantID":
+			if _, ok := fieldSeen[auditlog.FieldTenantID]; !ok {
+				selectedFields = append(selectedFields, auditlog.FieldTenantID)
+				fieldSeen[auditlog.FieldTenantID] = struct{}{}
+			}
+		case "actorType":
+			if _, ok := fieldSeen[auditlog.FieldActorType]; !ok {
+				selectedFields = append(selectedFields, auditlog.FieldActorType)
+				fieldSeen[auditlog.FieldActorType] = struct{}{}
+			}
+		case "actorID":
+			if _, ok := fieldSeen[auditlog.FieldActorID]; !ok {
+				selectedFields = append(selectedFields, auditlog.FieldActorID)
+				fieldSeen[auditlog.FieldActorID] = struct{}{}
+			}
+		case "requestID":
+			if _, ok := fieldSeen[auditlog.FieldRequestID]; !ok {
+				selectedFields = append(selectedFields, auditlog.FieldRequestID)
+				fieldSeen[auditlog.FieldRequestID] = struct{}{}
+			}
+		case "entityType":
+			if _, ok := fieldSeen[auditlog.FieldEntityType]; !ok {
+				selectedFields = append(selectedFields, auditlog.FieldEntityType)
+				fieldSeen[auditlog.FieldEntityType] = struct{}{}
+			}
+		case "entityID":
+			if _, ok := fieldSeen[auditlog.FieldEntityID]; !ok {
+				selectedFields = append(selectedFields, auditlog.FieldEntityID)
+				fieldSeen[auditlog.FieldEntityID] = struct{}{}
+			}
+		case "operation":
+			if _, ok := fieldSeen[auditlog.FieldOperation]; !ok {
+				selectedFields = append(selectedFields, auditlog.FieldOperation)
+				fieldSeen[auditlog.FieldOperation] = struct{}{}
+			}
+		case "before":
+			if _, ok := fieldSeen[auditlog.FieldBefore]; !ok {
+				selectedFields = append(selectedFields, auditlog.FieldBefore)
+				fieldSeen[auditlog.FieldBefore] = struct{}{}
+			}
+		case "after":
+			if _, ok := fieldSeen[auditlog.FieldAfter]; !ok {
+				selectedFields = append(selectedFields, auditlog.FieldAfter)
+				fieldSeen[auditlog.FieldAfter] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[auditlog.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, auditlog.FieldCreatedAt)
+				fieldSeen[auditlog.FieldCreatedAt] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		al.Select(selectedFields...)
+	}
+	return nil
+}
+
+type auditlogPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []AuditLogPaginateOption
+}
+
+func newAuditLogPaginateArgs(rv map[string]any) *auditlogPaginateArgs {
+	args := &auditlogPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &AuditLogOrder{Field: &AuditLogOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithAuditLogOrder(order))
+			}
+		case *AuditLogOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithAuditLogOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*AuditLogWhereInput); ok {
+		args.opts = append(args.opts, WithAuditLogFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (de *DataExportQuery) CollectFields(ctx context.Context, satisfies ...string) (*DataExportQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return de, nil
+	}
+	if err := de.collectField(ctx, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return de, nil
+}
+
+func (de *DataExportQuery) collectField(ctx context.Context, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(dataexport.Columns))
+		selectedFields = []string{dataexport.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+		case "user":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&UserClient{config: de.config}).Query()
+			)
+			if err := query.collectField(ctx, opCtx, field, path, satisfies...); err != nil {
+				return err
+			}
+			de.withUser = query
+			if _, ok := fieldSeen[dataexport.FieldUserID]; !ok {
+				selectedFields = append(selectedFields, dataexport.FieldUserID)
+				fieldSeen[dataexport.FieldUserID] = struct{}{}
+			}
+		case "tenantID":
+			if _, ok := fieldSeen[dataexport.FieldTenantID]; !ok {
+				selectedFields = append(selectedFields, dataexport.FieldTenantID)
+				fieldSeen[dataexport.FieldTenantID] = struct{}{}
+			}
+		case "userID":
+			if _, ok := fieldSeen[dataexport.FieldUserID]; !ok {
+				selectedFields = append(selectedFields, dataexport.FieldUserID)
+				fieldSeen[dataexport.FieldUserID] = struct{}{}
+			}
+		case "requestedBy":
+			if _, ok := fieldSeen[dataexport.FieldRequestedBy]; !ok {
+				selectedFields = append(selectedFields, dataexport.FieldRequestedBy)
+				fieldSeen[dataexport.FieldRequestedBy] = struct{}{}
+			}
+		case "status":
+			if _, ok := fieldSeen[dataexport.FieldStatus]; !ok {
+				selectedFields = append(selectedFields, dataexport.FieldStatus)
+				fieldSeen[dataexport.FieldStatus] = struct{}{}
+			}
+		case "error":
+			if _, ok := fieldSeen[dataexport.FieldError]; !ok {
+				selectedFields = append(selectedFields, dataexport.FieldError)
+				fieldSeen[dataexport.FieldError] = struct{}{}
+			}
+		case "size":
+			if _, ok := fieldSeen[dataexport.FieldSize]; !ok {
+				selectedFields = append(selectedFields, dataexport.FieldSize)
+				fieldSeen[dataexport.FieldSize] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[dataexport.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, dataexport.FieldCreatedAt)
+				fieldSeen[dataexport.FieldCreatedAt] = struct{}{}
+			}
+		case "completedAt":
+			if _, ok := fieldSeen[dataexport.FieldCompletedAt]; !ok {
+				selectedFields = append(selectedFields, dataexport.FieldCompletedAt)
+				fieldSeen[dataexport.FieldCompletedAt] = struct{}{}
+			}
+		case "expiresAt":
+			if _, ok := fieldSeen[dataexport.FieldExpiresAt]; !ok {
+				selectedFields = append(selectedFields, dataexport.FieldExpiresAt)
+				fieldSeen[dataexport.FieldExpiresAt] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		de.Select(selectedFields...)
+	}
+	return nil
+}
+
+type dataexportPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []DataExportPaginateOption
+}
+
+func newDataExportPaginateArgs(rv map[string]any) *dataexportPaginateArgs {
+	args := &dataexportPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*DataExportWhereInput); ok {
+		args.opts = append(args.opts, WithDataExportFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (f *FileQuery) CollectFields(ctx context.Context, satisfies ...string) (*FileQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return f, nil
+	}
+	if err := f.collectField(ctx, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (f *FileQuery) collectField(ctx context.Context, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(file.Columns))
+		selectedFields = []string{file.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+		case "owner":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&UserClient{config: f.config}).Query()
+			)
+			if err := query.collectField(ctx, opCtx, field, path, satisfies...); err != nil {
+				return err
+			}
+			f.withOwner = query
+			if _, ok := fieldSeen[file.FieldOwnerID]; !ok {
+				selectedFields = append(selectedFields, file.FieldOwnerID)
+				fieldSeen[file.FieldOwnerID] = struct{}{}
+			}
+		case "tenantID":
+			if _, ok := fieldSeen[file.FieldTenantID]; !ok {
+				selectedFields = append(selectedFields, file.FieldTenantID)
+				fieldSeen[file.FieldTenantID] = struct{}{}
+			}
+		case "name":
+			if _, ok := fieldSeen[file.FieldName]; !ok {
+				selectedFields = append(selectedFields, file.FieldName)
+				fieldSeen[file.FieldName] = struct{}{}
+			}
+		case "size":
+			if _, ok := fieldSeen[file.FieldSize]; !ok {
+				selectedFields = append(selectedFields, file.FieldSize)
+				fieldSeen[file.FieldSize] = struct{}{}
+			}
+		case "contentType":
+			if _, ok := fieldSeen[file.FieldContentType]; !ok {
+				selectedFields = append(selectedFields, file.FieldContentType)
+				fieldSeen[file.FieldContentType] = struct{}{}
+			}
+		case "checksum":
+			if _, ok := fieldSeen[file.FieldChecksum]; !ok {
+				selectedFields = append(selectedFields, file.FieldChecksum)
+				fieldSeen[file.FieldChecksum] = struct{}{}
+			}
+		case "ownerID":
+			if _, ok := fieldSeen[file.FieldOwnerID]; !ok {
+				selectedFields = append(selectedFields, file.FieldOwnerID)
+				fieldSeen[file.FieldOwnerID] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[file.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, file.FieldCreatedAt)
+				fieldSeen[file.FieldCreatedAt] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		f.Select(selectedFields...)
+	}
+	return nil
+}
+
+type filePaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []FilePaginateOption
+}
+
+func newFilePaginateArgs(rv map[string]any) *filePaginateArgs {
+	args := &filePaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*FileWhereInput); ok {
+		args.opts = append(args.opts, WithFileFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (i *IdentityQuery) CollectFields(ctx context.Context, satisfies ...string) (*IdentityQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return i, nil
+	}
+	if err := i.collectField(ctx, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return i, nil
+}
+
+func (i *IdentityQuery) collectField(ctx context.Context, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(identity.Columns))
+		selectedFields = []string{identity.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+		case "user":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&UserClient{config: i.config}).Query()
+			)
+			if err := query.collectField(ctx, opCtx, field, path, satisfies...); err != nil {
+				return err
+			}
+			i.withUser = query
+			if _, ok := fieldSeen[identity.FieldUserID]; !ok {
+				selectedFields = append(selectedFields, identity.FieldUserID)
+				fieldSeen[identity.FieldUserID] = struct{}{}
+			}
+		case "tenantID":
+			if _, ok := fieldSeen[identity.FieldTenantID]; !ok {
+				selectedFields = append(selectedFields, identity.FieldTenantID)
+				fieldSeen[identity.FieldTenantID] = struct{}{}
+			}
+		case "provider":
+			if _, ok := fieldSeen[identity.FieldProvider]; !ok {
+				selectedFields = append(selectedFields, identity.FieldProvider)
+				fieldSeen[identity.FieldProvider] = struct{}{}
+			}
+		case "issuer":
+			if _, ok := fieldSeen[identity.FieldIssuer]; !ok {
+				selectedFields = append(selectedFields, identity.FieldIssuer)
+				fieldSeen[identity.FieldIssuer] = struct{}{}
+			}
+		case "subject":
+			if _, ok := fieldSeen[identity.FieldSubject]; !ok {
+				selectedFields = append(selectedFields, identity.FieldSubject)
+				fieldSeen[identity.FieldSubject] = struct{}{}
+			}
+		case "email":
+			if _, ok := fieldSeen[identity.FieldEmail]; !ok {
+				selectedFields = append(selectedFields, identity.FieldEmail)
+				fieldSeen[identity.FieldEmail] = struct{}{}
+			}
+		case "userID":
+			if _, ok := fieldSeen[identity.FieldUserID]; !ok {
+				selectedFields = append(selectedFields, identity.FieldUserID)
+				fieldSeen[identity.FieldUserID] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[identity.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, identity.FieldCreatedAt)
+				fieldSeen[identity.FieldCreatedAt] = struct{}{}
+			}
+		case "lastLoginAt":
+			if _, ok := fieldSeen[identity.FieldLastLoginAt]; !ok {
+				selectedFields = append(selectedFields, identity.FieldLastLoginAt)
+				fieldSeen[identity.FieldLastLoginAt] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		i.Select(selectedFields...)
+	}
+	return nil
+}
+
+type identityPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []IdentityPaginateOption
+}
+
+func newIdentityPaginateArgs(rv map[string]any) *identityPaginateArgs {
+	args := &identityPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*IdentityWhereInput); ok {
+		args.opts = append(args.opts, WithIdentityFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (pe *PermissionQuery) CollectFields(ctx context.Context, satisfies ...string) (*PermissionQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return pe, nil
+	}
+	if err := pe.collectField(ctx, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return pe, nil
+}
+
+func (pe *PermissionQuery) collectField(ctx context.Context, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(permission.Columns))
+		selectedFields = []string{permission.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+		case "roles":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&RoleClient{config: pe.config}).Query()
+			)
+			if err := query.collectField(ctx, opCtx, field, path, satisfies...); err != nil {
+				return err
+			}
+			pe.WithNamedRoles(alias, func(wq *RoleQuery) {
+				*wq = *query
+			})
+		case "name":
+			if _, ok := fieldSeen[permission.FieldName]; !ok {
+				selectedFields = append(selectedFields, permission.FieldName)
+				fieldSeen[permission.FieldName] = struct{}{}
+			}
+		case "description":
+			if _, ok := fieldSeen[permission.FieldDescription]; !ok {
+				selectedFields = append(selectedFields, permission.FieldDescription)
+				fieldSeen[permission.FieldDescription] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		pe.Select(selectedFields...)
+	}
+	return nil
+}
+
+type permissionPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []PermissionPaginateOption
+}
+
+func newPermissionPaginateArgs(rv map[string]any) *permissionPaginateArgs {
+	args := &permissionPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*PermissionWhereInput); ok {
+		args.opts = append(args.opts, WithPermissionFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (r *RoleQuery) CollectFields(ctx context.Context, satisfies ...string) (*RoleQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return r, nil
+	}
+	if err := r.collectField(ctx, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *RoleQuery) collectField(ctx context.Context, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(role.Columns))
+		selectedFields = []string{role.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+		case "users":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&UserClient{config: r.config}).Query()
+			)
+			if err := query.collectField(ctx, opCtx, field, path, satisfies...); err != nil {
+				return err
+			}
+			r.WithNamedUsers(alias, func(wq *UserQuery) {
+				*wq = *query
+			})
+		case "permissions":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&PermissionClient{config: r.config}).Query()
+			)
+			if err := query.collectField(ctx, opCtx, field, path, satisfies...); err != nil {
+				return err
+			}
+			r.WithNamedPermissions(alias, func(wq *PermissionQuery) {
+				*wq = *query
+			})
+		case "name":
+			if _, ok := fieldSeen[role.FieldName]; !ok {
+				selectedFields = append(selectedFields, role.FieldName)
+				fieldSeen[role.FieldName] = struct{}{}
+			}
+		case "description":
+			if _, ok := fieldSeen[role.FieldDescription]; !ok {
+				selectedFields = append(selectedFields, role.FieldDescription)
+				fieldSeen[role.FieldDescription] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		r.Select(selectedFields...)
+	}
+	return nil
+}
+
+type rolePaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []RolePaginateOption
+}
+
+func newRolePaginateArgs(rv map[string]any) *rolePaginateArgs {
+	args := &rolePaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*RoleWhereInput); ok {
+		args.opts = append(args.opts, WithRoleFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (t *TenantQuery) CollectFields(ctx context.Context, satisfies ...string) (*TenantQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return t, nil
+	}
+	if err := t.collectField(ctx, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (t *TenantQuery) collectField(ctx context.Context, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(tenant.Columns))
+		selectedFields = []string{tenant.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+		case "name":
+			if _, ok := fieldSeen[tenant.FieldName]; !ok {
+				selectedFields = append(selectedFields, tenant.FieldName)
+				fieldSeen[tenant.FieldName] = struct{}{}
+			}
+		case "slug":
+			if _, ok := fieldSeen[tenant.FieldSlug]; !ok {
+				selectedFields = append(selectedFields, tenant.FieldSlug)
+				fieldSeen[tenant.FieldSlug] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[tenant.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, tenant.FieldCreatedAt)
+				fieldSeen[tenant.FieldCreatedAt] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		t.Select(selectedFields...)
+	}
+	return nil
+}
+
+type tenantPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []TenantPaginateOption
+}
+
+func newTenantPaginateArgs(rv map[string]any) *tenantPaginateArgs {
+	args := &tenantPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*TenantWhereInput); ok {
+		args.opts = append(args.opts, WithTenantFilter(v.Filter))
+	}
+	return args
+}
+
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
 func (u *UserQuery) CollectFields(ctx context.Context, satisfies ...string) (*UserQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
@@ -21,8 +994,120 @@ func (u *UserQuery) CollectFields(ctx context.Context, satisfies ...string) (*Us
 	return u, nil
 }
 
-func (u *UserQuery) collectField(ctx context.Context, op *graphql.OperationContext, field graphql.CollectedField, path []string, satisfies ...string) error {
+func (u *UserQuery) collectField(ctx context.Context, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
 	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(user.Columns))
+		selectedFields = []string{user.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+		case "roles":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&RoleClient{config: u.config}).Query()
+			)
+			if err := query.collectField(ctx, opCtx, field, path, satisfies...); err != nil {
+				return err
+			}
+			u.WithNamedRoles(alias, func(wq *RoleQuery) {
+				*wq = *query
+			})
+		case "avatar":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&FileClient{config: u.config}).Query()
+			)
+			if err := query.collectField(ctx, opCtx, field, path, satisfies...); err != nil {
+				return err
+			}
+			u.withAvatar = query
+			if _, ok := fieldSeen[user.FieldAvatarID]; !ok {
+				selectedFields = append(selectedFields, user.FieldAvatarID)
+				fieldSeen[user.FieldAvatarID] = struct{}{}
+			}
+		case "tenantID":
+			if _, ok := fieldSeen[user.FieldTenantID]; !ok {
+				selectedFields = append(selectedFields, user.FieldTenantID)
+				fieldSeen[user.FieldTenantID] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[user.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, user.FieldCreatedAt)
+				fieldSeen[user.FieldCreatedAt] = struct{}{}
+			}
+		case "updatedAt":
+			if _, ok := fieldSeen[user.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, user.FieldUpdatedAt)
+				fieldSeen[user.FieldUpdatedAt] = struct{}{}
+			}
+		case "createdBy":
+			if _, ok := fieldSeen[user.FieldCreatedBy]; !ok {
+				selectedFields = append(selectedFields, user.FieldCreatedBy)
+				fieldSeen[user.FieldCreatedBy] = struct{}{}
+			}
+		case "updatedBy":
+			if _, ok := fieldSeen[user.FieldUpdatedBy]; !ok {
+				selectedFields = append(selectedFields, user.FieldUpdatedBy)
+				fieldSeen[user.FieldUpdatedBy] = struct{}{}
+			}
+		case "version":
+			if _, ok := fieldSeen[user.FieldVersion]; !ok {
+				selectedFields = append(selectedFields, user.FieldVersion)
+				fieldSeen[user.FieldVersion] = struct{}{}
+			}
+		case "deletedAt":
+			if _, ok := fieldSeen[user.FieldDeletedAt]; !ok {
+				selectedFields = append(selectedFields, user.FieldDeletedAt)
+				fieldSeen[user.FieldDeletedAt] = struct{}{}
+			}
+		case "name":
+			if _, ok := fieldSeen[user.FieldName]; !ok {
+				selectedFields = append(selectedFields, user.FieldName)
+				fieldSeen[user.FieldName] = struct{}{}
+			}
+		case "sex":
+			if _, ok := fieldSeen[user.FieldSex]; !ok {
+				selectedFields = append(selectedFields, user.FieldSex)
+				fieldSeen[user.FieldSex] = struct{}{}
+			}
+		case "age":
+			if _, ok := fieldSeen[user.FieldAge]; !ok {
+				selectedFields = append(selectedFields, user.FieldAge)
+				fieldSeen[user.FieldAge] = struct{}{}
+			}
+		case "account":
+			if _, ok := fieldSeen[user.FieldAccount]; !ok {
+				selectedFields = append(selectedFields, user.FieldAccount)
+				fieldSeen[user.FieldAccount] = struct{}{}
+			}
+		case "email":
+			if _, ok := fieldSeen[user.FieldEmail]; !ok {
+				selectedFields = append(selectedFields, user.FieldEmail)
+				fieldSeen[user.FieldEmail] = struct{}{}
+			}
+		case "emailVerifiedAt":
+			if _, ok := fieldSeen[user.FieldEmailVerifiedAt]; !ok {
+				selectedFields = append(selectedFields, user.FieldEmailVerifiedAt)
+				fieldSeen[user.FieldEmailVerifiedAt] = struct{}{}
+			}
+		case "totpEnabled":
+			if _, ok := fieldSeen[user.FieldTotpEnabled]; !ok {
+				selectedFields = append(selectedFields, user.FieldTotpEnabled)
+				fieldSeen[user.FieldTotpEnabled] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		u.Select(selectedFields...)
+	}
 	return nil
 }
 
@@ -32,7 +1117,7 @@ type userPaginateArgs struct {
 	opts          []UserPaginateOption
 }
 
-func newUserPaginateArgs(rv map[string]interface{}) *userPaginateArgs {
+func newUserPaginateArgs(rv map[string]any) *userPaginateArgs {
 	args := &userPaginateArgs{}
 	if rv == nil {
 		return args
@@ -49,6 +1134,34 @@ func newUserPaginateArgs(rv map[string]interface{}) *userPaginateArgs {
 	if v := rv[beforeField]; v != nil {
 		args.before = v.(*Cursor)
 	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case []*UserOrder:
+			args.opts = append(args.opts, WithUserOrder(v))
+		case []any:
+			var orders []*UserOrder
+			for i := range v {
+				mv, ok := v[i].(map[string]any)
+				if !ok {
+					continue
+				}
+				var (
+					err1, err2 error
+					order      = &UserOrder{Field: &UserOrderField{}, Direction: entgql.OrderDirectionAsc}
+				)
+				if d, ok := mv[directionField]; ok {
+					err1 = order.Direction.UnmarshalGQL(d)
+				}
+				if f, ok := mv[fieldField]; ok {
+					err2 = order.Field.UnmarshalGQL(f)
+				}
+				if err1 == nil && err2 == nil {
+					orders = append(orders, order)
+				}
+			}
+			args.opts = append(args.opts, WithUserOrder(orders))
+		}
+	}
 	if v, ok := rv[whereField].(*UserWhereInput); ok {
 		args.opts = append(args.opts, WithUserFilter(v.Filter))
 	}
@@ -66,35 +1179,18 @@ const (
 	whereField     = "where"
 )
 
-func fieldArgs(ctx context.Context, whereInput interface{}, path ...string) map[string]interface{} {
-	fc := graphql.GetFieldContext(ctx)
-	if fc == nil {
+func fieldArgs(ctx context.Context, whereInput any, path ...string) map[string]any {
+	field := collectedField(ctx, path...)
+	if field == nil || field.Arguments == nil {
 		return nil
 	}
 	oc := graphql.GetOperationContext(ctx)
-	for _, name := range path {
-		var field *graphql.CollectedField
-		for _, f := range graphql.CollectFields(oc, fc.Field.Selections, nil) {
-			if f.Alias == name {
-				field = &f
-				break
-			}
-		}
-		if field == nil {
-			return nil
-		}
-		cf, err := fc.Child(ctx, *field)
-		if err != nil {
-			args := field.ArgumentMap(oc.Variables)
-			return unmarshalArgs(ctx, whereInput, args)
-		}
-		fc = cf
-	}
-	return fc.Args
+	args := field.ArgumentMap(oc.Variables)
+	return unmarshalArgs(ctx, whereInput, args)
 }
 
 // unmarshalArgs allows extracting the field arguments from their raw representation.
-func unmarshalArgs(ctx context.Context, whereInput interface{}, args map[string]interface{}) map[string]interface{} {
+func unmarshalArgs(ctx context.Context, whereInput any, args map[string]any) map[string]any {
 	for _, k := range []string{firstField, lastField} {
 		v, ok := args[k]
 		if !ok {
@@ -146,3 +1242,17 @@ func limitRows(partitionBy string, limit int, orderBy ...sql.Querier) func(s *sq
 			Prefix(with)
 	}
 }
+
+// mayAddCondition appends another type condition to the satisfies list
+// if condition is enabled (Node/Nodes) and it does not exist in the list.
+func mayAddCondition(satisfies []string, typeCond string) []string {
+	if len(satisfies) == 0 {
+		return satisfies
+	}
+	for _, s := range satisfies {
+		if typeCond == s {
+			return satisfies
+		}
+	}
+	return append(satisfies, typeCond)
+}
@@ -1,3 +1,97 @@
 // Code generated by ent, DO NOT EDIT.
 
 package ent
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+func (ak *APIKey) Owner(ctx context.Context) (*User, error) {
+	result, err := ak.Edges.OwnerOrErr()
+	if IsNotLoaded(err) {
+		result, err = ak.QueryOwner().Only(ctx)
+	}
+	return result, err
+}
+
+func (de *DataExport) User(ctx context.Context) (*User, error) {
+	result, err := de.Edges.UserOrErr()
+	if IsNotLoaded(err) {
+		result, err = de.QueryUser().Only(ctx)
+	}
+	return result, err
+}
+
+func (f *File) Owner(ctx context.Context) (*User, error) {
+	result, err := f.Edges.OwnerOrErr()
+	if IsNotLoaded(err) {
+		result, err = f.QueryOwner().Only(ctx)
+	}
+	return result, err
+}
+
+func (i *Identity) User(ctx context.Context) (*User, error) {
+	result, err := i.Edges.UserOrErr()
+	if IsNotLoaded(err) {
+		result, err = i.QueryUser().Only(ctx)
+	}
+	return result, err
+}
+
+func (pe *Permission) Roles(ctx context.Context) (result []*Role, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = pe.NamedRoles(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = pe.Edges.RolesOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = pe.QueryRoles().All(ctx)
+	}
+	return result, err
+}
+
+func (r *Role) Users(ctx context.Context) (result []*User, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = r.NamedUsers(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = r.Edges.UsersOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = r.QueryUsers().All(ctx)
+	}
+	return result, err
+}
+
+func (r *Role) Permissions(ctx context.Context) (result []*Permission, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = r.NamedPermissions(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = r.Edges.PermissionsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = r.QueryPermissions().All(ctx)
+	}
+	return result, err
+}
+
+func (u *User) Roles(ctx context.Context) (result []*Role, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = u.NamedRoles(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = u.Edges.RolesOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = u.QueryRoles().All(ctx)
+	}
+	return result, err
+}
+
+func (u *User) Avatar(ctx context.Context) (*File, error) {
+	result, err := u.Edges.AvatarOrErr()
+	if IsNotLoaded(err) {
+		result, err = u.QueryAvatar().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
@@ -0,0 +1,75 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+// CreateUserInput represents a mutation input for creating users.
+type CreateUserInput struct {
+	Name     string
+	Sex      bool
+	Age      int
+	Account  string
+	Email    *string
+	Password string
+}
+
+// Mutate applies the CreateUserInput on the UserMutation builder.
+func (i *CreateUserInput) Mutate(m *UserMutation) {
+	m.SetName(i.Name)
+	m.SetSex(i.Sex)
+	m.SetAge(i.Age)
+	m.SetAccount(i.Account)
+	if v := i.Email; v != nil {
+		m.SetEmail(*v)
+	}
+	m.SetPassword(i.Password)
+}
+
+// SetInput applies the change-set in the CreateUserInput on the UserCreate builder.
+func (c *UserCreate) SetInput(i CreateUserInput) *UserCreate {
+	i.Mutate(c.Mutation())
+	return c
+}
+
+// UpdateUserInput represents a mutation input for updating users.
+type UpdateUserInput struct {
+	Name       *string
+	Sex        *bool
+	Age        *int
+	Account    *string
+	ClearEmail bool
+	Email      *string
+}
+
+// Mutate applies the UpdateUserInput on the UserMutation builder.
+func (i *UpdateUserInput) Mutate(m *UserMutation) {
+	if v := i.Name; v != nil {
+		m.SetName(*v)
+	}
+	if v := i.Sex; v != nil {
+		m.SetSex(*v)
+	}
+	if v := i.Age; v != nil {
+		m.SetAge(*v)
+	}
+	if v := i.Account; v != nil {
+		m.SetAccount(*v)
+	}
+	if i.ClearEmail {
+		m.ClearEmail()
+	}
+	if v := i.Email; v != nil {
+		m.SetEmail(*v)
+	}
+}
+
+// SetInput applies the change-set in the UpdateUserInput on the UserUpdate builder.
+func (c *UserUpdate) SetInput(i UpdateUserInput) *UserUpdate {
+	i.Mutate(c.Mutation())
+	return c
+}
+
+// SetInput applies the change-set in the UpdateUserInput on the UserUpdateOne builder.
+func (c *UserUpdateOne) SetInput(i UpdateUserInput) *UserUpdateOne {
+	i.Mutate(c.Mutation())
+	return c
+}
@@ -5,6 +5,15 @@ package ent
 import (
 	"context"
 	"fmt"
+	"go-web/ent/accounterasure"
+	"go-web/ent/apikey"
+	"go-web/ent/auditlog"
+	"go-web/ent/dataexport"
+	"go-web/ent/file"
+	"go-web/ent/identity"
+	"go-web/ent/permission"
+	"go-web/ent/role"
+	"go-web/ent/tenant"
 	"go-web/ent/user"
 	"sync"
 	"sync/atomic"
@@ -23,6 +32,33 @@ type Noder interface {
 	IsNode()
 }
 
+// IsNode implements the Node interface check for GQLGen.
+func (n *APIKey) IsNode() {}
+
+// IsNode implements the Node interface check for GQLGen.
+func (n *AccountErasure) IsNode() {}
+
+// IsNode implements the Node interface check for GQLGen.
+func (n *AuditLog) IsNode() {}
+
+// IsNode implements the Node interface check for GQLGen.
+func (n *DataExport) IsNode() {}
+
+// IsNode implements the Node interface check for GQLGen.
+func (n *File) IsNode() {}
+
+// IsNode implements the Node interface check for GQLGen.
+func (n *Identity) IsNode() {}
+
+// IsNode implements the Node interface check for GQLGen.
+func (n *Permission) IsNode() {}
+
+// IsNode implements the Node interface check for GQLGen.
+func (n *Role) IsNode() {}
+
+// IsNode implements the Node interface check for GQLGen.
+func (n *Tenant) IsNode() {}
+
 // IsNode implements the Node interface check for GQLGen.
 func (n *User) IsNode() {}
 
@@ -67,9 +103,8 @@ func (c *Client) newNodeOpts(opts []NodeOption) *nodeOptions {
 // Noder returns a Node by its id. If the NodeType was not provided, it will
 // be derived from the id value according to the universal-id configuration.
 //
-//		c.Noder(ctx, id)
-//		c.Noder(ctx, id, ent.WithNodeType(typeResolver))
-//
+//	c.Noder(ctx, id)
+//	c.Noder(ctx, id, ent.WithNodeType(typeResolver))
 func (c *Client) Noder(ctx context.Context, id uint64, opts ...NodeOption) (_ Noder, err error) {
 	defer func() {
 		if IsNotFound(err) {
@@ -85,6 +120,114 @@ func (c *Client) Noder(ctx context.Context, id uint64, opts ...NodeOption) (_ No
 
 func (c *Client) noder(ctx context.Context, table string, id uint64) (Noder, error) {
 	switch table {
+	case apikey.Table:
+		query := c.APIKey.Query().
+			Where(apikey.ID(id))
+		query, err := query.CollectFields(ctx, "APIKey")
+		if err != nil {
+			return nil, err
+		}
+		n, err := query.Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return n, nil
+	case accounterasure.Table:
+		query := c.AccountErasure.Query().
+			Where(accounterasure.ID(id))
+		query, err := query.CollectFields(ctx, "AccountErasure")
+		if err != nil {
+			return nil, err
+		}
+		n, err := query.Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return n, nil
+	case auditlog.Table:
+		query := c.AuditLog.Query().
+			Where(auditlog.ID(id))
+		query, err := query.CollectFields(ctx, "AuditLog")
+		if err != nil {
+			return nil, err
+		}
+		n, err := query.Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return n, nil
+	case dataexport.Table:
+		query := c.DataExport.Query().
+			Where(dataexport.ID(id))
+		query, err := query.CollectFields(ctx, "DataExport")
+		if err != nil {
+			return nil, err
+		}
+		n, err := query.Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return n, nil
+	case file.Table:
+		query := c.File.Query().
+			Where(file.ID(id))
+		query, err := query.CollectFields(ctx, "File")
+		if err != nil {
+			return nil, err
+		}
+		n, err := query.Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return n, nil
+	case identity.Table:
+		query := c.Identity.Query().
+			Where(identity.ID(id))
+		query, err := query.CollectFields(ctx, "Identity")
+		if err != nil {
+			return nil, err
+		}
+		n, err := query.Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return n, nil
+	case permission.Table:
+		query := c.Permission.Query().
+			Where(permission.ID(id))
+		query, err := query.CollectFields(ctx, "Permission")
+		if err != nil {
+			return nil, err
+		}
+		n, err := query.Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return n, nil
+	case role.Table:
+		query := c.Role.Query().
+			Where(role.ID(id))
+		query, err := query.CollectFields(ctx, "Role")
+		if err != nil {
+			return nil, err
+		}
+		n, err := query.Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return n, nil
+	case tenant.Table:
+		query := c.Tenant.Query().
+			Where(tenant.ID(id))
+		query, err := query.CollectFields(ctx, "Tenant")
+		if err != nil {
+			return nil, err
+		}
+		n, err := query.Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return n, nil
 	case user.Table:
 		query := c.User.Query().
 			Where(user.ID(id))
@@ -170,6 +313,150 @@ func (c *Client) noders(ctx context.Context, table string, ids []uint64) ([]Node
 		idmap[id] = append(idmap[id], &noders[i])
 	}
 	switch table {
+	case apikey.Table:
+		query := c.APIKey.Query().
+			Where(apikey.IDIn(ids...))
+		query, err := query.CollectFields(ctx, "APIKey")
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case accounterasure.Table:
+		query := c.AccountErasure.Query().
+			Where(accounterasure.IDIn(ids...))
+		query, err := query.CollectFields(ctx, "AccountErasure")
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case auditlog.Table:
+		query := c.AuditLog.Query().
+			Where(auditlog.IDIn(ids...))
+		query, err := query.CollectFields(ctx, "AuditLog")
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case dataexport.Table:
+		query := c.DataExport.Query().
+			Where(dataexport.IDIn(ids...))
+		query, err := query.CollectFields(ctx, "DataExport")
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case file.Table:
+		query := c.File.Query().
+			Where(file.IDIn(ids...))
+		query, err := query.CollectFields(ctx, "File")
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case identity.Table:
+		query := c.Identity.Query().
+			Where(identity.IDIn(ids...))
+		query, err := query.CollectFields(ctx, "Identity")
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case permission.Table:
+		query := c.Permission.Query().
+			Where(permission.IDIn(ids...))
+		query, err := query.CollectFields(ctx, "Permission")
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case role.Table:
+		query := c.Role.Query().
+			Where(role.IDIn(ids...))
+		query, err := query.CollectFields(ctx, "Role")
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case tenant.Table:
+		query := c.Tenant.Query().
+			Where(tenant.IDIn(ids...))
+		query, err := query.CollectFields(ctx, "Tenant")
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	case user.Table:
 		query := c.User.Query().
 			Where(user.IDIn(ids...))
@@ -0,0 +1,822 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-web/ent/identity"
+	"go-web/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// IdentityCreate is the builder for creating a Identity entity.
+type IdentityCreate struct {
+	config
+	mutation *IdentityMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (ic *IdentityCreate) SetTenantID(u uint64) *IdentityCreate {
+	ic.mutation.SetTenantID(u)
+	return ic
+}
+
+// SetProvider sets the "provider" field.
+func (ic *IdentityCreate) SetProvider(s string) *IdentityCreate {
+	ic.mutation.SetProvider(s)
+	return ic
+}
+
+// SetIssuer sets the "issuer" field.
+func (ic *IdentityCreate) SetIssuer(s string) *IdentityCreate {
+	ic.mutation.SetIssuer(s)
+	return ic
+}
+
+// SetSubject sets the "subject" field.
+func (ic *IdentityCreate) SetSubject(s string) *IdentityCreate {
+	ic.mutation.SetSubject(s)
+	return ic
+}
+
+// SetEmail sets the "email" field.
+func (ic *IdentityCreate) SetEmail(s string) *IdentityCreate {
+	ic.mutation.SetEmail(s)
+	return ic
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (ic *IdentityCreate) SetNillableEmail(s *string) *IdentityCreate {
+	if s != nil {
+		ic.SetEmail(*s)
+	}
+	return ic
+}
+
+// SetUserID sets the "user_id" field.
+func (ic *IdentityCreate) SetUserID(u uint64) *IdentityCreate {
+	ic.mutation.SetUserID(u)
+	return ic
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ic *IdentityCreate) SetCreatedAt(t time.Time) *IdentityCreate {
+	ic.mutation.SetCreatedAt(t)
+	return ic
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ic *IdentityCreate) SetNillableCreatedAt(t *time.Time) *IdentityCreate {
+	if t != nil {
+		ic.SetCreatedAt(*t)
+	}
+	return ic
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (ic *IdentityCreate) SetLastLoginAt(t time.Time) *IdentityCreate {
+	ic.mutation.SetLastLoginAt(t)
+	return ic
+}
+
+// SetNillableLastLoginAt sets the "last_login_at" field if the given value is not nil.
+func (ic *IdentityCreate) SetNillableLastLoginAt(t *time.Time) *IdentityCreate {
+	if t != nil {
+		ic.SetLastLoginAt(*t)
+	}
+	return ic
+}
+
+// SetID sets the "id" field.
+func (ic *IdentityCreate) SetID(u uint64) *IdentityCreate {
+	ic.mutation.SetID(u)
+	return ic
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (ic *IdentityCreate) SetNillableID(u *uint64) *IdentityCreate {
+	if u != nil {
+		ic.SetID(*u)
+	}
+	return ic
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ic *IdentityCreate) SetUser(u *User) *IdentityCreate {
+	return ic.SetUserID(u.ID)
+}
+
+// Mutation returns the IdentityMutation object of the builder.
+func (ic *IdentityCreate) Mutation() *IdentityMutation {
+	return ic.mutation
+}
+
+// Save creates the Identity in the database.
+func (ic *IdentityCreate) Save(ctx context.Context) (*Identity, error) {
+	if err := ic.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, ic.sqlSave, ic.mutation, ic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ic *IdentityCreate) SaveX(ctx context.Context) *Identity {
+	v, err := ic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ic *IdentityCreate) Exec(ctx context.Context) error {
+	_, err := ic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ic *IdentityCreate) ExecX(ctx context.Context) {
+	if err := ic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ic *IdentityCreate) defaults() error {
+	if _, ok := ic.mutation.CreatedAt(); !ok {
+		if identity.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized identity.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := identity.DefaultCreatedAt()
+		ic.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ic.mutation.ID(); !ok {
+		if identity.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized identity.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := identity.DefaultID()
+		ic.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ic *IdentityCreate) check() error {
+	if _, ok := ic.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "Identity.tenant_id"`)}
+	}
+	if _, ok := ic.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required field "Identity.provider"`)}
+	}
+	if v, ok := ic.mutation.Provider(); ok {
+		if err := identity.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "Identity.provider": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.Issuer(); !ok {
+		return &ValidationError{Name: "issuer", err: errors.New(`ent: missing required field "Identity.issuer"`)}
+	}
+	if v, ok := ic.mutation.Issuer(); ok {
+		if err := identity.IssuerValidator(v); err != nil {
+			return &ValidationError{Name: "issuer", err: fmt.Errorf(`ent: validator failed for field "Identity.issuer": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.Subject(); !ok {
+		return &ValidationError{Name: "subject", err: errors.New(`ent: missing required field "Identity.subject"`)}
+	}
+	if v, ok := ic.mutation.Subject(); ok {
+		if err := identity.SubjectValidator(v); err != nil {
+			return &ValidationError{Name: "subject", err: fmt.Errorf(`ent: validator failed for field "Identity.subject": %w`, err)}
+		}
+	}
+	if v, ok := ic.mutation.Email(); ok {
+		if err := identity.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "Identity.email": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Identity.user_id"`)}
+	}
+	if _, ok := ic.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Identity.created_at"`)}
+	}
+	if v, ok := ic.mutation.ID(); ok {
+		if err := identity.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Identity.id": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Identity.user"`)}
+	}
+	return nil
+}
+
+func (ic *IdentityCreate) sqlSave(ctx context.Context) (*Identity, error) {
+	if err := ic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	ic.mutation.id = &_node.ID
+	ic.mutation.done = true
+	return _node, nil
+}
+
+func (ic *IdentityCreate) createSpec() (*Identity, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Identity{config: ic.config}
+		_spec = sqlgraph.NewCreateSpec(identity.Table, sqlgraph.NewFieldSpec(identity.FieldID, field.TypeUint64))
+	)
+	_spec.OnConflict = ic.conflict
+	if id, ok := ic.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := ic.mutation.TenantID(); ok {
+		_spec.SetField(identity.FieldTenantID, field.TypeUint64, value)
+		_node.TenantID = value
+	}
+	if value, ok := ic.mutation.Provider(); ok {
+		_spec.SetField(identity.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := ic.mutation.Issuer(); ok {
+		_spec.SetField(identity.FieldIssuer, field.TypeString, value)
+		_node.Issuer = value
+	}
+	if value, ok := ic.mutation.Subject(); ok {
+		_spec.SetField(identity.FieldSubject, field.TypeString, value)
+		_node.Subject = value
+	}
+	if value, ok := ic.mutation.Email(); ok {
+		_spec.SetField(identity.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := ic.mutation.CreatedAt(); ok {
+		_spec.SetField(identity.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ic.mutation.LastLoginAt(); ok {
+		_spec.SetField(identity.FieldLastLoginAt, field.TypeTime, value)
+		_node.LastLoginAt = &value
+	}
+	if nodes := ic.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   identity.UserTable,
+			Columns: []string{identity.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Identity.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.IdentityUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (ic *IdentityCreate) OnConflict(opts ...sql.ConflictOption) *IdentityUpsertOne {
+	ic.conflict = opts
+	return &IdentityUpsertOne{
+		create: ic,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Identity.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ic *IdentityCreate) OnConflictColumns(columns ...string) *IdentityUpsertOne {
+	ic.conflict = append(ic.conflict, sql.ConflictColumns(columns...))
+	return &IdentityUpsertOne{
+		create: ic,
+	}
+}
+
+type (
+	// IdentityUpsertOne is the builder for "upsert"-ing
+	//  one Identity node.
+	IdentityUpsertOne struct {
+		create *IdentityCreate
+	}
+
+	// IdentityUpsert is the "OnConflict" setter.
+	IdentityUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetProvider sets the "provider" field.
+func (u *IdentityUpsert) SetProvider(v string) *IdentityUpsert {
+	u.Set(identity.FieldProvider, v)
+	return u
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *IdentityUpsert) UpdateProvider() *IdentityUpsert {
+	u.SetExcluded(identity.FieldProvider)
+	return u
+}
+
+// SetEmail sets the "email" field.
+func (u *IdentityUpsert) SetEmail(v string) *IdentityUpsert {
+	u.Set(identity.FieldEmail, v)
+	return u
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *IdentityUpsert) UpdateEmail() *IdentityUpsert {
+	u.SetExcluded(identity.FieldEmail)
+	return u
+}
+
+// ClearEmail clears the value of the "email" field.
+func (u *IdentityUpsert) ClearEmail() *IdentityUpsert {
+	u.SetNull(identity.FieldEmail)
+	return u
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (u *IdentityUpsert) SetLastLoginAt(v time.Time) *IdentityUpsert {
+	u.Set(identity.FieldLastLoginAt, v)
+	return u
+}
+
+// UpdateLastLoginAt sets the "last_login_at" field to the value that was provided on create.
+func (u *IdentityUpsert) UpdateLastLoginAt() *IdentityUpsert {
+	u.SetExcluded(identity.FieldLastLoginAt)
+	return u
+}
+
+// ClearLastLoginAt clears the value of the "last_login_at" field.
+func (u *IdentityUpsert) ClearLastLoginAt() *IdentityUpsert {
+	u.SetNull(identity.FieldLastLoginAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Identity.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(identity.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *IdentityUpsertOne) UpdateNewValues() *IdentityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(identity.FieldID)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(identity.FieldTenantID)
+		}
+		if _, exists := u.create.mutation.Issuer(); exists {
+			s.SetIgnore(identity.FieldIssuer)
+		}
+		if _, exists := u.create.mutation.Subject(); exists {
+			s.SetIgnore(identity.FieldSubject)
+		}
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(identity.FieldUserID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(identity.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Identity.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *IdentityUpsertOne) Ignore() *IdentityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *IdentityUpsertOne) DoNothing() *IdentityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the IdentityCreate.OnConflict
+// documentation for more info.
+func (u *IdentityUpsertOne) Update(set func(*IdentityUpsert)) *IdentityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&IdentityUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProvider sets the "provider" field.
+func (u *IdentityUpsertOne) SetProvider(v string) *IdentityUpsertOne {
+	return u.Update(func(s *IdentityUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *IdentityUpsertOne) UpdateProvider() *IdentityUpsertOne {
+	return u.Update(func(s *IdentityUpsert) {
+		s.UpdateProvider()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *IdentityUpsertOne) SetEmail(v string) *IdentityUpsertOne {
+	return u.Update(func(s *IdentityUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *IdentityUpsertOne) UpdateEmail() *IdentityUpsertOne {
+	return u.Update(func(s *IdentityUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// ClearEmail clears the value of the "email" field.
+func (u *IdentityUpsertOne) ClearEmail() *IdentityUpsertOne {
+	return u.Update(func(s *IdentityUpsert) {
+		s.ClearEmail()
+	})
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (u *IdentityUpsertOne) SetLastLoginAt(v time.Time) *IdentityUpsertOne {
+	return u.Update(func(s *IdentityUpsert) {
+		s.SetLastLoginAt(v)
+	})
+}
+
+// UpdateLastLoginAt sets the "last_login_at" field to the value that was provided on create.
+func (u *IdentityUpsertOne) UpdateLastLoginAt() *IdentityUpsertOne {
+	return u.Update(func(s *IdentityUpsert) {
+		s.UpdateLastLoginAt()
+	})
+}
+
+// ClearLastLoginAt clears the value of the "last_login_at" field.
+func (u *IdentityUpsertOne) ClearLastLoginAt() *IdentityUpsertOne {
+	return u.Update(func(s *IdentityUpsert) {
+		s.ClearLastLoginAt()
+	})
+}
+
+// Exec executes the query.
+func (u *IdentityUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for IdentityCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *IdentityUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *IdentityUpsertOne) ID(ctx context.Context) (id uint64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *IdentityUpsertOne) IDX(ctx context.Context) uint64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// IdentityCreateBulk is the builder for creating many Identity entities in bulk.
+type IdentityCreateBulk struct {
+	config
+	err      error
+	builders []*IdentityCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Identity entities in the database.
+func (icb *IdentityCreateBulk) Save(ctx context.Context) ([]*Identity, error) {
+	if icb.err != nil {
+		return nil, icb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(icb.builders))
+	nodes := make([]*Identity, len(icb.builders))
+	mutators := make([]Mutator, len(icb.builders))
+	for i := range icb.builders {
+		func(i int, root context.Context) {
+			builder := icb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*IdentityMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, icb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = icb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, icb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, icb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (icb *IdentityCreateBulk) SaveX(ctx context.Context) []*Identity {
+	v, err := icb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (icb *IdentityCreateBulk) Exec(ctx context.Context) error {
+	_, err := icb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icb *IdentityCreateBulk) ExecX(ctx context.Context) {
+	if err := icb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Identity.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.IdentityUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (icb *IdentityCreateBulk) OnConflict(opts ...sql.ConflictOption) *IdentityUpsertBulk {
+	icb.conflict = opts
+	return &IdentityUpsertBulk{
+		create: icb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Identity.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (icb *IdentityCreateBulk) OnConflictColumns(columns ...string) *IdentityUpsertBulk {
+	icb.conflict = append(icb.conflict, sql.ConflictColumns(columns...))
+	return &IdentityUpsertBulk{
+		create: icb,
+	}
+}
+
+// IdentityUpsertBulk is the builder for "upsert"-ing
+// a bulk of Identity nodes.
+type IdentityUpsertBulk struct {
+	create *IdentityCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Identity.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(identity.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *IdentityUpsertBulk) UpdateNewValues() *IdentityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(identity.FieldID)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(identity.FieldTenantID)
+			}
+			if _, exists := b.mutation.Issuer(); exists {
+				s.SetIgnore(identity.FieldIssuer)
+			}
+			if _, exists := b.mutation.Subject(); exists {
+				s.SetIgnore(identity.FieldSubject)
+			}
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(identity.FieldUserID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(identity.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Identity.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *IdentityUpsertBulk) Ignore() *IdentityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *IdentityUpsertBulk) DoNothing() *IdentityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the IdentityCreateBulk.OnConflict
+// documentation for more info.
+func (u *IdentityUpsertBulk) Update(set func(*IdentityUpsert)) *IdentityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&IdentityUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProvider sets the "provider" field.
+func (u *IdentityUpsertBulk) SetProvider(v string) *IdentityUpsertBulk {
+	return u.Update(func(s *IdentityUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *IdentityUpsertBulk) UpdateProvider() *IdentityUpsertBulk {
+	return u.Update(func(s *IdentityUpsert) {
+		s.UpdateProvider()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *IdentityUpsertBulk) SetEmail(v string) *IdentityUpsertBulk {
+	return u.Update(func(s *IdentityUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *IdentityUpsertBulk) UpdateEmail() *IdentityUpsertBulk {
+	return u.Update(func(s *IdentityUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// ClearEmail clears the value of the "email" field.
+func (u *IdentityUpsertBulk) ClearEmail() *IdentityUpsertBulk {
+	return u.Update(func(s *IdentityUpsert) {
+		s.ClearEmail()
+	})
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (u *IdentityUpsertBulk) SetLastLoginAt(v time.Time) *IdentityUpsertBulk {
+	return u.Update(func(s *IdentityUpsert) {
+		s.SetLastLoginAt(v)
+	})
+}
+
+// UpdateLastLoginAt sets the "last_login_at" field to the value that was provided on create.
+func (u *IdentityUpsertBulk) UpdateLastLoginAt() *IdentityUpsertBulk {
+	return u.Update(func(s *IdentityUpsert) {
+		s.UpdateLastLoginAt()
+	})
+}
+
+// ClearLastLoginAt clears the value of the "last_login_at" field.
+func (u *IdentityUpsertBulk) ClearLastLoginAt() *IdentityUpsertBulk {
+	return u.Update(func(s *IdentityUpsert) {
+		s.ClearLastLoginAt()
+	})
+}
+
+// Exec executes the query.
+func (u *IdentityUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the IdentityCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for IdentityCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *IdentityUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
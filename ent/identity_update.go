@@ -0,0 +1,372 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-web/ent/identity"
+	"go-web/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// IdentityUpdate is the builder for updating Identity entities.
+type IdentityUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *IdentityMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the IdentityUpdate builder.
+func (iu *IdentityUpdate) Where(ps ...predicate.Identity) *IdentityUpdate {
+	iu.mutation.Where(ps...)
+	return iu
+}
+
+// SetProvider sets the "provider" field.
+func (iu *IdentityUpdate) SetProvider(s string) *IdentityUpdate {
+	iu.mutation.SetProvider(s)
+	return iu
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (iu *IdentityUpdate) SetNillableProvider(s *string) *IdentityUpdate {
+	if s != nil {
+		iu.SetProvider(*s)
+	}
+	return iu
+}
+
+// SetEmail sets the "email" field.
+func (iu *IdentityUpdate) SetEmail(s string) *IdentityUpdate {
+	iu.mutation.SetEmail(s)
+	return iu
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (iu *IdentityUpdate) SetNillableEmail(s *string) *IdentityUpdate {
+	if s != nil {
+		iu.SetEmail(*s)
+	}
+	return iu
+}
+
+// ClearEmail clears the value of the "email" field.
+func (iu *IdentityUpdate) ClearEmail() *IdentityUpdate {
+	iu.mutation.ClearEmail()
+	return iu
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (iu *IdentityUpdate) SetLastLoginAt(t time.Time) *IdentityUpdate {
+	iu.mutation.SetLastLoginAt(t)
+	return iu
+}
+
+// SetNillableLastLoginAt sets the "last_login_at" field if the given value is not nil.
+func (iu *IdentityUpdate) SetNillableLastLoginAt(t *time.Time) *IdentityUpdate {
+	if t != nil {
+		iu.SetLastLoginAt(*t)
+	}
+	return iu
+}
+
+// ClearLastLoginAt clears the value of the "last_login_at" field.
+func (iu *IdentityUpdate) ClearLastLoginAt() *IdentityUpdate {
+	iu.mutation.ClearLastLoginAt()
+	return iu
+}
+
+// Mutation returns the IdentityMutation object of the builder.
+func (iu *IdentityUpdate) Mutation() *IdentityMutation {
+	return iu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iu *IdentityUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, iu.sqlSave, iu.mutation, iu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iu *IdentityUpdate) SaveX(ctx context.Context) int {
+	affected, err := iu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iu *IdentityUpdate) Exec(ctx context.Context) error {
+	_, err := iu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iu *IdentityUpdate) ExecX(ctx context.Context) {
+	if err := iu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iu *IdentityUpdate) check() error {
+	if v, ok := iu.mutation.Provider(); ok {
+		if err := identity.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "Identity.provider": %w`, err)}
+		}
+	}
+	if v, ok := iu.mutation.Email(); ok {
+		if err := identity.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "Identity.email": %w`, err)}
+		}
+	}
+	if _, ok := iu.mutation.UserID(); iu.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Identity.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (iu *IdentityUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *IdentityUpdate {
+	iu.modifiers = append(iu.modifiers, modifiers...)
+	return iu
+}
+
+func (iu *IdentityUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := iu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(identity.Table, identity.Columns, sqlgraph.NewFieldSpec(identity.FieldID, field.TypeUint64))
+	if ps := iu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iu.mutation.Provider(); ok {
+		_spec.SetField(identity.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := iu.mutation.Email(); ok {
+		_spec.SetField(identity.FieldEmail, field.TypeString, value)
+	}
+	if iu.mutation.EmailCleared() {
+		_spec.ClearField(identity.FieldEmail, field.TypeString)
+	}
+	if value, ok := iu.mutation.LastLoginAt(); ok {
+		_spec.SetField(identity.FieldLastLoginAt, field.TypeTime, value)
+	}
+	if iu.mutation.LastLoginAtCleared() {
+		_spec.ClearField(identity.FieldLastLoginAt, field.TypeTime)
+	}
+	_spec.AddModifiers(iu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, iu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{identity.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	iu.mutation.done = true
+	return n, nil
+}
+
+// IdentityUpdateOne is the builder for updating a single Identity entity.
+type IdentityUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *IdentityMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetProvider sets the "provider" field.
+func (iuo *IdentityUpdateOne) SetProvider(s string) *IdentityUpdateOne {
+	iuo.mutation.SetProvider(s)
+	return iuo
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (iuo *IdentityUpdateOne) SetNillableProvider(s *string) *IdentityUpdateOne {
+	if s != nil {
+		iuo.SetProvider(*s)
+	}
+	return iuo
+}
+
+// SetEmail sets the "email" field.
+func (iuo *IdentityUpdateOne) SetEmail(s string) *IdentityUpdateOne {
+	iuo.mutation.SetEmail(s)
+	return iuo
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (iuo *IdentityUpdateOne) SetNillableEmail(s *string) *IdentityUpdateOne {
+	if s != nil {
+		iuo.SetEmail(*s)
+	}
+	return iuo
+}
+
+// ClearEmail clears the value of the "email" field.
+func (iuo *IdentityUpdateOne) ClearEmail() *IdentityUpdateOne {
+	iuo.mutation.ClearEmail()
+	return iuo
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (iuo *IdentityUpdateOne) SetLastLoginAt(t time.Time) *IdentityUpdateOne {
+	iuo.mutation.SetLastLoginAt(t)
+	return iuo
+}
+
+// SetNillableLastLoginAt sets the "last_login_at" field if the given value is not nil.
+func (iuo *IdentityUpdateOne) SetNillableLastLoginAt(t *time.Time) *IdentityUpdateOne {
+	if t != nil {
+		iuo.SetLastLoginAt(*t)
+	}
+	return iuo
+}
+
+// ClearLastLoginAt clears the value of the "last_login_at" field.
+func (iuo *IdentityUpdateOne) ClearLastLoginAt() *IdentityUpdateOne {
+	iuo.mutation.ClearLastLoginAt()
+	return iuo
+}
+
+// Mutation returns the IdentityMutation object of the builder.
+func (iuo *IdentityUpdateOne) Mutation() *IdentityMutation {
+	return iuo.mutation
+}
+
+// Where appends a list predicates to the IdentityUpdate builder.
+func (iuo *IdentityUpdateOne) Where(ps ...predicate.Identity) *IdentityUpdateOne {
+	iuo.mutation.Where(ps...)
+	return iuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (iuo *IdentityUpdateOne) Select(field string, fields ...string) *IdentityUpdateOne {
+	iuo.fields = append([]string{field}, fields...)
+	return iuo
+}
+
+// Save executes the query and returns the updated Identity entity.
+func (iuo *IdentityUpdateOne) Save(ctx context.Context) (*Identity, error) {
+	return withHooks(ctx, iuo.sqlSave, iuo.mutation, iuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iuo *IdentityUpdateOne) SaveX(ctx context.Context) *Identity {
+	node, err := iuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (iuo *IdentityUpdateOne) Exec(ctx context.Context) error {
+	_, err := iuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iuo *IdentityUpdateOne) ExecX(ctx context.Context) {
+	if err := iuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iuo *IdentityUpdateOne) check() error {
+	if v, ok := iuo.mutation.Provider(); ok {
+		if err := identity.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "Identity.provider": %w`, err)}
+		}
+	}
+	if v, ok := iuo.mutation.Email(); ok {
+		if err := identity.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "Identity.email": %w`, err)}
+		}
+	}
+	if _, ok := iuo.mutation.UserID(); iuo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Identity.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (iuo *IdentityUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *IdentityUpdateOne {
+	iuo.modifiers = append(iuo.modifiers, modifiers...)
+	return iuo
+}
+
+func (iuo *IdentityUpdateOne) sqlSave(ctx context.Context) (_node *Identity, err error) {
+	if err := iuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(identity.Table, identity.Columns, sqlgraph.NewFieldSpec(identity.FieldID, field.TypeUint64))
+	id, ok := iuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Identity.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := iuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, identity.FieldID)
+		for _, f := range fields {
+			if !identity.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != identity.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := iuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iuo.mutation.Provider(); ok {
+		_spec.SetField(identity.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := iuo.mutation.Email(); ok {
+		_spec.SetField(identity.FieldEmail, field.TypeString, value)
+	}
+	if iuo.mutation.EmailCleared() {
+		_spec.ClearField(identity.FieldEmail, field.TypeString)
+	}
+	if value, ok := iuo.mutation.LastLoginAt(); ok {
+		_spec.SetField(identity.FieldLastLoginAt, field.TypeTime, value)
+	}
+	if iuo.mutation.LastLoginAtCleared() {
+		_spec.ClearField(identity.FieldLastLoginAt, field.TypeTime)
+	}
+	_spec.AddModifiers(iuo.modifiers...)
+	_node = &Identity{config: iuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, iuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{identity.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	iuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,184 @@
+// Package rule 提供 ent 隐私策略复用的规则，所有规则都基于 pkg/viewer 中的访问者信息。
+package rule
+
+import (
+	"context"
+
+	"go-web/ent"
+	"go-web/ent/accounterasure"
+	"go-web/ent/apikey"
+	"go-web/ent/dataexport"
+	"go-web/ent/file"
+	"go-web/ent/identity"
+	"go-web/ent/privacy"
+	"go-web/ent/tenant"
+	"go-web/ent/user"
+	"go-web/pkg/tenancy"
+	"go-web/pkg/viewer"
+
+	entgo "entgo.io/ent"
+)
+
+// AllowIfSystem 系统身份访问时放行
+func AllowIfSystem() privacy.QueryMutationRule {
+	return privacy.ContextQueryMutationRule(func(ctx context.Context) error {
+		if viewer.IsSystem(ctx) {
+			return privacy.Allow
+		}
+		return privacy.Skip
+	})
+}
+
+// DenyIfNoViewer 匿名访问时拒绝
+func DenyIfNoViewer() privacy.QueryMutationRule {
+	return privacy.ContextQueryMutationRule(func(ctx context.Context) error {
+		if viewer.FromContext(ctx) == nil {
+			return privacy.Denyf("viewer is missing")
+		}
+		return privacy.Skip
+	})
+}
+
+// AllowIfAdmin 访问者为管理员时放行
+func AllowIfAdmin() privacy.QueryMutationRule {
+	return privacy.ContextQueryMutationRule(func(ctx context.Context) error {
+		if viewer.FromContext(ctx).HasRole(viewer.RoleAdmin) {
+			return privacy.Allow
+		}
+		return privacy.Skip
+	})
+}
+
+// AllowIfPermission 访问者拥有指定权限时放行
+func AllowIfPermission(permission string) privacy.QueryMutationRule {
+	return privacy.ContextQueryMutationRule(func(ctx context.Context) error {
+		if viewer.FromContext(ctx).HasPermission(permission) {
+			return privacy.Allow
+		}
+		return privacy.Skip
+	})
+}
+
+// FilterUserToViewer 将用户查询限制为访问者本人
+func FilterUserToViewer() privacy.QueryRule {
+	return privacy.UserQueryRuleFunc(func(ctx context.Context, q *ent.UserQuery) error {
+		v := viewer.FromContext(ctx)
+		if v == nil {
+			return privacy.Denyf("viewer is missing")
+		}
+		q.Where(user.ID(v.ID))
+		return privacy.Skip
+	})
+}
+
+// AllowUserUpdateSelf 访问者更新本人时放行，批量更新被限制为访问者本人
+func AllowUserUpdateSelf() privacy.MutationRule {
+	return privacy.UserMutationRuleFunc(func(ctx context.Context, m *ent.UserMutation) error {
+		v := viewer.FromContext(ctx)
+		if v == nil {
+			return privacy.Skip
+		}
+		switch {
+		case m.Op().Is(entgo.OpUpdateOne):
+			if id, ok := m.ID(); ok && id == v.ID {
+				return privacy.Allow
+			}
+		case m.Op().Is(entgo.OpUpdate):
+			m.Where(user.ID(v.ID))
+			return privacy.Allow
+		}
+		return privacy.Skip
+	})
+}
+
+// FilterAPIKeyToOwner 将 API 密钥查询限制为访问者本人的密钥
+func FilterAPIKeyToOwner() privacy.QueryRule {
+	return privacy.APIKeyQueryRuleFunc(func(ctx context.Context, q *ent.APIKeyQuery) error {
+		v := viewer.FromContext(ctx)
+		if v == nil {
+			return privacy.Denyf("viewer is missing")
+		}
+		q.Where(apikey.OwnerID(v.ID))
+		return privacy.Skip
+	})
+}
+
+// AllowAPIKeyOwner 访问者为本人创建或修改密钥时放行，更新被限制为访问者本人的密钥
+func AllowAPIKeyOwner() privacy.MutationRule {
+	return privacy.APIKeyMutationRuleFunc(func(ctx context.Context, m *ent.APIKeyMutation) error {
+		v := viewer.FromContext(ctx)
+		if v == nil {
+			return privacy.Skip
+		}
+		switch {
+		case m.Op().Is(entgo.OpCreate):
+			if id, ok := m.OwnerID(); ok && id == v.ID {
+				return privacy.Allow
+			}
+		case m.Op().Is(entgo.OpUpdateOne | entgo.OpUpdate):
+			m.Where(apikey.OwnerID(v.ID))
+			return privacy.Allow
+		}
+		return privacy.Skip
+	})
+}
+
+// FilterIdentityToOwner 将外部身份查询限制为访问者本人的外部身份
+func FilterIdentityToOwner() privacy.QueryRule {
+	return privacy.IdentityQueryRuleFunc(func(ctx context.Context, q *ent.IdentityQuery) error {
+		v := viewer.FromContext(ctx)
+		if v == nil {
+			return privacy.Denyf("viewer is missing")
+		}
+		q.Where(identity.UserID(v.ID))
+		return privacy.Skip
+	})
+}
+
+// FilterTenantToCurrent 将租户查询限制为当前租户
+func FilterTenantToCurrent() privacy.QueryRule {
+	return privacy.TenantQueryRuleFunc(func(ctx context.Context, q *ent.TenantQuery) error {
+		id, ok := tenancy.FromContext(ctx)
+		if !ok {
+			return privacy.Denyf("tenant is missing")
+		}
+		q.Where(tenant.ID(id))
+		return privacy.Skip
+	})
+}
+
+// FilterDataExportToOwner 将数据导出查询限制为访问者本人的导出
+func FilterDataExportToOwner() privacy.QueryRule {
+	return privacy.DataExportQueryRuleFunc(func(ctx context.Context, q *ent.DataExportQuery) error {
+		v := viewer.FromContext(ctx)
+		if v == nil {
+			return privacy.Denyf("viewer is missing")
+		}
+		q.Where(dataexport.UserID(v.ID))
+		return privacy.Skip
+	})
+}
+
+// FilterAccountErasureToOwner 将注销记录查询限制为访问者本人的记录
+func FilterAccountErasureToOwner() privacy.QueryRule {
+	return privacy.AccountErasureQueryRuleFunc(func(ctx context.Context, q *ent.AccountErasureQuery) error {
+		v := viewer.FromContext(ctx)
+		if v == nil {
+			return privacy.Denyf("viewer is missing")
+		}
+		q.Where(accounterasure.UserID(v.ID))
+		return privacy.Skip
+	})
+}
+
+// FilterFileToOwner 将文件查询限制为访问者本人上传的文件
+func FilterFileToOwner() privacy.QueryRule {
+	return privacy.FileQueryRuleFunc(func(ctx context.Context, q *ent.FileQuery) error {
+		v := viewer.FromContext(ctx)
+		if v == nil {
+			return privacy.Denyf("viewer is missing")
+		}
+		q.Where(file.OwnerID(v.ID))
+		return privacy.Skip
+	})
+}
@@ -2,27 +2,4 @@
 
 package ent
 
-import (
-	"go-web/ent/schema"
-	"go-web/ent/user"
-)
-
-// The init function reads all schema descriptors with runtime code
-// (default values, validators, hooks and policies) and stitches it
-// to their package variables.
-func init() {
-	userFields := schema.User{}.Fields()
-	_ = userFields
-	// userDescName is the schema descriptor for name field.
-	userDescName := userFields[0].Descriptor()
-	// user.NameValidator is a validator for the "name" field. It is called by the builders before save.
-	user.NameValidator = userDescName.Validators[0].(func(string) error)
-	// userDescAccount is the schema descriptor for account field.
-	userDescAccount := userFields[3].Descriptor()
-	// user.AccountValidator is a validator for the "account" field. It is called by the builders before save.
-	user.AccountValidator = userDescAccount.Validators[0].(func(string) error)
-	// userDescPassword is the schema descriptor for password field.
-	userDescPassword := userFields[4].Descriptor()
-	// user.PasswordValidator is a validator for the "password" field. It is called by the builders before save.
-	user.PasswordValidator = userDescPassword.Validators[0].(func(string) error)
-}
+// The schema-stitching logic is generated in go-web/ent/runtime/runtime.go
@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"time"
+
+	"go-web/ent/privacy"
+	"go-web/ent/rule"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AccountErasure holds the schema definition for the AccountErasure entity.
+// 用户申请注销账号的记录。冷静期结束后由 pkg/erasure 匿名化用户并删除其凭证与个人数据，
+// 记录本身保留，作为注销已完成的凭据，因此不与用户建立级联删除的关联。
+type AccountErasure struct {
+	ent.Schema
+}
+
+// Mixin of the AccountErasure.
+func (AccountErasure) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Mixin{},
+		TenantMixin{},
+	}
+}
+
+// Fields of the AccountErasure.
+func (AccountErasure) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("user_id").Immutable().Comment("注销的用户").
+			Annotations(entgql.Type("ID")),
+		field.Enum("status").Values("scheduled", "running", "completed", "cancelled").Default("scheduled").Comment("注销状态").
+			Annotations(entgql.Type("AccountErasureStatus")),
+		field.Time("scheduled_for").Immutable().Comment("执行时间，此前可以撤销"),
+		field.Time("started_at").Optional().Nillable().Comment("最近一次开始执行的时间"),
+		field.Time("completed_at").Optional().Nillable().Comment("完成时间"),
+		field.Time("cancelled_at").Optional().Nillable().Comment("撤销时间"),
+		field.JSON("results", map[string]int{}).Optional().Comment("各类数据删除或匿名化的记录数").
+			Annotations(entgql.Skip(entgql.SkipAll)),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("申请时间"),
+	}
+}
+
+// Indexes of the AccountErasure.
+func (AccountErasure) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+		index.Fields("status", "scheduled_for"),
+	}
+}
+
+// Policy of the AccountErasure.
+// 注销记录只由系统身份创建和更新；用户只能读取本人的记录，管理员可以读取全部记录。
+func (AccountErasure) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			rule.AllowIfSystem(),
+			rule.DenyIfNoViewer(),
+			rule.AllowIfAdmin(),
+			rule.FilterAccountErasureToOwner(),
+			privacy.AlwaysAllowRule(),
+		},
+		Mutation: privacy.MutationPolicy{
+			rule.AllowIfSystem(),
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
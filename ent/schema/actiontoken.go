@@ -0,0 +1,76 @@
+package schema
+
+import (
+	"time"
+
+	"go-web/ent/privacy"
+	"go-web/ent/rule"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ActionToken holds the schema definition for the ActionToken entity.
+// 通过通知下发给用户的一次性令牌，例如密码重置和邮箱验证。只保存令牌哈希。
+type ActionToken struct {
+	ent.Schema
+}
+
+// Annotations of the ActionToken.
+func (ActionToken) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(entgql.SkipAll),
+	}
+}
+
+// Mixin of the ActionToken.
+func (ActionToken) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Mixin{},
+	}
+}
+
+// Fields of the ActionToken.
+func (ActionToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("kind").Values("password_reset", "email_verification").Immutable().Comment("令牌用途"),
+		field.String("token_hash").MaxLen(64).Unique().Sensitive().Immutable().Comment("令牌 SHA-256 哈希"),
+		field.Uint64("user_id").Immutable().Comment("所属用户"),
+		field.Time("expires_at").Immutable().Comment("过期时间"),
+		field.Time("used_at").Optional().Nillable().Comment("使用或作废时间"),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("创建时间"),
+	}
+}
+
+// Edges of the ActionToken.
+func (ActionToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("action_tokens").Field("user_id").Unique().Required().Immutable(),
+	}
+}
+
+// Indexes of the ActionToken.
+func (ActionToken) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "kind"),
+	}
+}
+
+// Policy of the ActionToken.
+// 令牌只在服务端以系统身份创建和校验。
+func (ActionToken) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			rule.AllowIfSystem(),
+			privacy.AlwaysDenyRule(),
+		},
+		Mutation: privacy.MutationPolicy{
+			rule.AllowIfSystem(),
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
@@ -0,0 +1,78 @@
+package schema
+
+import (
+	"time"
+
+	"go-web/ent/privacy"
+	"go-web/ent/rule"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// APIKey holds the schema definition for the APIKey entity.
+type APIKey struct {
+	ent.Schema
+}
+
+// Mixin of the APIKey.
+func (APIKey) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Mixin{},
+		TenantMixin{},
+	}
+}
+
+// Fields of the APIKey.
+func (APIKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").MaxLen(100).Comment("名称"),
+		field.String("prefix").MaxLen(16).Unique().Immutable().Comment("密钥前缀，用于查找"),
+		field.String("secret_hash").MaxLen(64).Sensitive().Immutable().Comment("密钥 SHA-256 哈希").
+			Annotations(entgql.Skip(entgql.SkipAll)),
+		field.Strings("scopes").Default([]string{}).Comment("授权范围"),
+		field.Uint64("owner_id").Immutable().Comment("所属用户"),
+		field.Time("expires_at").Optional().Nillable().Comment("过期时间"),
+		field.Time("last_used_at").Optional().Nillable().Comment("最后使用时间"),
+		field.Time("revoked_at").Optional().Nillable().Comment("吊销时间"),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("创建时间"),
+	}
+}
+
+// Edges of the APIKey.
+func (APIKey) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).Ref("api_keys").Field("owner_id").Unique().Required().Immutable(),
+	}
+}
+
+// Indexes of the APIKey.
+func (APIKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("owner_id"),
+	}
+}
+
+// Policy of the APIKey.
+// 用户只能读取和管理本人的密钥，管理员可以访问全部密钥。
+func (APIKey) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			rule.AllowIfSystem(),
+			rule.DenyIfNoViewer(),
+			rule.AllowIfAdmin(),
+			rule.FilterAPIKeyToOwner(),
+			privacy.AlwaysAllowRule(),
+		},
+		Mutation: privacy.MutationPolicy{
+			rule.AllowIfSystem(),
+			rule.DenyIfNoViewer(),
+			rule.AllowIfAdmin(),
+			rule.AllowAPIKeyOwner(),
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
@@ -0,0 +1,82 @@
+package schema
+
+import (
+	"time"
+
+	"go-web/ent/privacy"
+	"go-web/ent/rule"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AuditLog holds the schema definition for the AuditLog entity.
+// 实体变更的审计记录，由 ent/audit 的全局 hook 写入，写入后不可修改。
+type AuditLog struct {
+	ent.Schema
+}
+
+// Annotations of the AuditLog.
+func (AuditLog) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.RelayConnection(),
+	}
+}
+
+// Mixin of the AuditLog.
+func (AuditLog) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Mixin{},
+		TenantMixin{Optional: true},
+	}
+}
+
+// Fields of the AuditLog.
+func (AuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("actor_type").Values("user", "system", "anonymous").Immutable().Comment("操作者类型").
+			Annotations(entgql.Type("AuditLogActorType")),
+		field.Uint64("actor_id").Optional().Nillable().Immutable().Comment("操作用户 ID，系统或匿名操作时为空").
+			Annotations(entgql.Type("ID")),
+		field.String("request_id").MaxLen(64).Optional().Immutable().Comment("请求 ID"),
+		field.String("entity_type").MaxLen(64).Immutable().Comment("实体类型"),
+		field.Uint64("entity_id").Immutable().Comment("实体 ID").
+			Annotations(entgql.Type("ID")),
+		field.Enum("operation").Values("create", "update", "delete").Immutable().Comment("操作类型").
+			Annotations(entgql.Type("AuditLogOperation")),
+		field.JSON("before", map[string]any{}).Optional().Immutable().Comment("变更前的字段值，只包含变更的字段，敏感字段已脱敏").
+			Annotations(entgql.Type("Map")),
+		field.JSON("after", map[string]any{}).Optional().Immutable().Comment("变更后的字段值，只包含变更的字段，敏感字段已脱敏").
+			Annotations(entgql.Type("Map")),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("记录时间").
+			Annotations(entgql.OrderField("CREATED_AT")),
+	}
+}
+
+// Indexes of the AuditLog.
+func (AuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("entity_type", "entity_id"),
+		index.Fields("actor_id"),
+		index.Fields("created_at"),
+	}
+}
+
+// Policy of the AuditLog.
+// 审计记录只能由系统身份写入，管理员可以查询。
+func (AuditLog) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			rule.AllowIfSystem(),
+			rule.AllowIfAdmin(),
+			privacy.AlwaysDenyRule(),
+		},
+		Mutation: privacy.MutationPolicy{
+			rule.AllowIfSystem(),
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
@@ -0,0 +1,77 @@
+package schema
+
+import (
+	"time"
+
+	"go-web/ent/privacy"
+	"go-web/ent/rule"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// DataExport holds the schema definition for the DataExport entity.
+// 用户个人数据导出任务，由 pkg/export 异步生成 JSON 归档，完成后通过签名链接下载。
+type DataExport struct {
+	ent.Schema
+}
+
+// Mixin of the DataExport.
+func (DataExport) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Mixin{},
+		TenantMixin{},
+	}
+}
+
+// Fields of the DataExport.
+func (DataExport) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("user_id").Immutable().Comment("被导出数据的用户"),
+		field.Uint64("requested_by").Optional().Nillable().Immutable().Comment("发起导出的用户，管理员代为导出时与 user_id 不同").
+			Annotations(entgql.Type("ID")),
+		field.Enum("status").Values("pending", "running", "completed", "failed").Default("pending").Comment("任务状态").
+			Annotations(entgql.Type("DataExportStatus")),
+		field.String("error").MaxLen(255).Optional().Nillable().Comment("失败原因"),
+		field.Int64("size").Optional().Nillable().Comment("归档大小（字节）"),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("创建时间"),
+		field.Time("completed_at").Optional().Nillable().Comment("完成时间"),
+		field.Time("expires_at").Optional().Nillable().Comment("归档过期时间，过期后删除且无法下载"),
+	}
+}
+
+// Edges of the DataExport.
+func (DataExport) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("data_exports").Field("user_id").Unique().Required().Immutable(),
+	}
+}
+
+// Indexes of the DataExport.
+func (DataExport) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "status"),
+		index.Fields("expires_at"),
+	}
+}
+
+// Policy of the DataExport.
+// 任务只由系统身份创建和更新；用户只能读取本人的导出，管理员可以读取全部导出。
+func (DataExport) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			rule.AllowIfSystem(),
+			rule.DenyIfNoViewer(),
+			rule.AllowIfAdmin(),
+			rule.FilterDataExportToOwner(),
+			privacy.AlwaysAllowRule(),
+		},
+		Mutation: privacy.MutationPolicy{
+			rule.AllowIfSystem(),
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
@@ -0,0 +1,74 @@
+package schema
+
+import (
+	"time"
+
+	"go-web/ent/privacy"
+	"go-web/ent/rule"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// File holds the schema definition for the File entity.
+// 上传的文件，内容由 pkg/storage 保存，这里只记录元数据。文件不可修改，替换时上传新文件。
+type File struct {
+	ent.Schema
+}
+
+// Mixin of the File.
+func (File) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Mixin{},
+		TenantMixin{},
+	}
+}
+
+// Fields of the File.
+func (File) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").MaxLen(255).Immutable().Comment("上传时的文件名"),
+		field.Int64("size").NonNegative().Immutable().Comment("大小（字节）"),
+		field.String("content_type").MaxLen(100).Immutable().Comment("文件类型，按文件内容识别"),
+		field.String("checksum").MaxLen(64).Immutable().Comment("内容的 SHA-256（十六进制）"),
+		field.Uint64("owner_id").Immutable().Comment("上传的用户").
+			Annotations(entgql.Type("ID")),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("上传时间"),
+	}
+}
+
+// Edges of the File.
+func (File) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).Ref("files").Field("owner_id").Unique().Required().Immutable(),
+	}
+}
+
+// Indexes of the File.
+func (File) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("owner_id"),
+	}
+}
+
+// Policy of the File.
+// 文件只由系统身份创建和删除；用户可以读取本人上传的文件，拥有 user:read 权限的访问者可以读取全部文件，
+// 与能否读取上传者一致。
+func (File) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			rule.AllowIfSystem(),
+			rule.DenyIfNoViewer(),
+			rule.AllowIfPermission("user:read"),
+			rule.FilterFileToOwner(),
+			privacy.AlwaysAllowRule(),
+		},
+		Mutation: privacy.MutationPolicy{
+			rule.AllowIfSystem(),
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
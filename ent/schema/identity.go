@@ -0,0 +1,74 @@
+package schema
+
+import (
+	"time"
+
+	"go-web/ent/privacy"
+	"go-web/ent/rule"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Identity holds the schema definition for the Identity entity.
+// 关联到用户的外部身份，在租户内由 OIDC 签发者与 subject 唯一确定。
+type Identity struct {
+	ent.Schema
+}
+
+// Mixin of the Identity.
+func (Identity) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Mixin{},
+		TenantMixin{},
+	}
+}
+
+// Fields of the Identity.
+func (Identity) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("provider").MaxLen(50).Comment("身份提供方名称"),
+		field.String("issuer").MaxLen(255).Immutable().Comment("签发者"),
+		field.String("subject").MaxLen(255).Immutable().Comment("签发者内的用户标识"),
+		field.String("email").MaxLen(255).Optional().Comment("外部身份的邮箱"),
+		field.Uint64("user_id").Immutable().Comment("关联用户"),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("关联时间"),
+		field.Time("last_login_at").Optional().Nillable().Comment("最后登录时间"),
+	}
+}
+
+// Edges of the Identity.
+func (Identity) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("identities").Field("user_id").Unique().Required().Immutable(),
+	}
+}
+
+// Indexes of the Identity.
+func (Identity) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "issuer", "subject").Unique(),
+		index.Fields("user_id"),
+	}
+}
+
+// Policy of the Identity.
+// 外部身份只在登录流程中以系统身份创建，用户可以读取本人的外部身份。
+func (Identity) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			rule.AllowIfSystem(),
+			rule.DenyIfNoViewer(),
+			rule.AllowIfAdmin(),
+			rule.FilterIdentityToOwner(),
+			privacy.AlwaysAllowRule(),
+		},
+		Mutation: privacy.MutationPolicy{
+			rule.AllowIfSystem(),
+			rule.AllowIfAdmin(),
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
@@ -1,10 +1,20 @@
 package schema
 
 import (
+	"context"
+	"fmt"
+	"time"
+
+	gen "go-web/ent"
+	"go-web/ent/hook"
+	goWebErrors "go-web/pkg/errors"
 	"go-web/pkg/uid"
 	"go-web/pkg/util"
+	"go-web/pkg/viewer"
 
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/mixin"
 )
@@ -18,7 +28,8 @@ type Mixin struct {
 func (Mixin) Fields() []ent.Field {
 	return []ent.Field{
 		field.Uint64("id").
-			DefaultFunc(uid.NewUniqueIDGenerator().NewID).
+			// 延迟到生成 ID 时再初始化 sonyflake，代码生成阶段无需机器 ID
+			DefaultFunc(func() uint64 { return uid.NewUniqueIDGenerator().NewID() }).
 			Validate(util.ValidateID),
 	}
 }
@@ -27,3 +38,127 @@ func (Mixin) Fields() []ent.Field {
 func (Mixin) Edges() []ent.Edge {
 	return nil
 }
+
+// TimeMixin 创建时间与更新时间
+type TimeMixin struct {
+	mixin.Schema
+}
+
+// Fields of the TimeMixin.
+func (TimeMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("created_at").Default(time.Now).Immutable().Comment("创建时间").
+			Annotations(entgql.OrderField("CREATED_AT"), entgql.Skip(entgql.SkipMutationCreateInput)),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now).Comment("更新时间").
+			Annotations(entgql.OrderField("UPDATED_AT"), entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput)),
+	}
+}
+
+// ActorMixin 创建者与最后更新者，取自访问者。系统或匿名操作时为空
+type ActorMixin struct {
+	mixin.Schema
+}
+
+// Fields of the ActorMixin.
+func (ActorMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("created_by").Optional().Nillable().Immutable().Comment("创建者用户 ID").
+			Annotations(entgql.Type("ID"), entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput)),
+		field.Uint64("updated_by").Optional().Nillable().Comment("最后更新者用户 ID").
+			Annotations(entgql.Type("ID"), entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput)),
+	}
+}
+
+// Hooks of the ActorMixin.
+func (ActorMixin) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(setActor, ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne),
+	}
+}
+
+// setActor 根据访问者设置创建者与更新者
+func setActor(next ent.Mutator) ent.Mutator {
+	return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+		mx, ok := m.(interface {
+			SetCreatedBy(uint64)
+			SetUpdatedBy(uint64)
+			ClearUpdatedBy()
+		})
+		if !ok {
+			return nil, fmt.Errorf("unexpected mutation type %T", m)
+		}
+
+		v := viewer.FromContext(ctx)
+		switch {
+		case v != nil && m.Op().Is(ent.OpCreate):
+			mx.SetCreatedBy(v.ID)
+			mx.SetUpdatedBy(v.ID)
+		case v != nil:
+			mx.SetUpdatedBy(v.ID)
+		case !m.Op().Is(ent.OpCreate):
+			mx.ClearUpdatedBy()
+		}
+		return next.Mutate(ctx, m)
+	})
+}
+
+// VersionMixin 乐观锁版本号，每次更新自动加一。
+// 更新时通过 SetVersion 传入客户端读取到的版本号，版本号不一致时不更新并返回版本冲突错误。
+type VersionMixin struct {
+	mixin.Schema
+}
+
+// Fields of the VersionMixin.
+func (VersionMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("version").Default(1).NonNegative().Comment("乐观锁版本号").
+			Annotations(entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput)),
+	}
+}
+
+// Hooks of the VersionMixin.
+func (VersionMixin) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(checkVersion, ent.OpUpdate|ent.OpUpdateOne),
+	}
+}
+
+// checkVersion 未指定版本号时版本号加一；指定时只更新版本号一致的记录并写入下一个版本号。
+// 单条更新因版本号不一致未更新时返回 ErrInvalidState，记录不存在时保留原错误。
+func checkVersion(next ent.Mutator) ent.Mutator {
+	return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+		mx, ok := m.(interface {
+			Version() (int, bool)
+			SetVersion(int)
+			AddVersion(int)
+			ID() (uint64, bool)
+			Client() *gen.Client
+			WhereP(...func(*sql.Selector))
+		})
+		if !ok {
+			return nil, fmt.Errorf("unexpected mutation type %T", m)
+		}
+
+		expected, ok := mx.Version()
+		if !ok {
+			mx.AddVersion(1)
+			return next.Mutate(ctx, m)
+		}
+		mx.WhereP(sql.FieldEQ("version", expected))
+		mx.SetVersion(expected + 1)
+
+		v, err := next.Mutate(ctx, m)
+		if err == nil || !gen.IsNotFound(err) || !m.Op().Is(ent.OpUpdateOne) {
+			return v, err
+		}
+		id, _ := mx.ID()
+		found, qerr := mx.Client().Snapshot(viewer.NewSystemContext(ctx), m.Type(), id)
+		if qerr != nil {
+			return nil, qerr
+		}
+		if len(found) == 0 {
+			return nil, err
+		}
+		return nil, goWebErrors.New(goWebErrors.ErrInvalidState, "version_conflict", fmt.Sprintf("%s %d was modified, expected version %d", m.Type(), id, expected))
+	})
+}
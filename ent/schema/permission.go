@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"go-web/ent/privacy"
+	"go-web/ent/rule"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Permission holds the schema definition for the Permission entity.
+type Permission struct {
+	ent.Schema
+}
+
+// Mixin of the Permission.
+func (Permission) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Mixin{},
+	}
+}
+
+// Fields of the Permission.
+func (Permission) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").MaxLen(100).Unique().Comment("权限名，如 user:read"),
+		field.String("description").MaxLen(255).Default("").Comment("描述"),
+	}
+}
+
+// Edges of the Permission.
+func (Permission) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("roles", Role.Type).Ref("permissions"),
+	}
+}
+
+// Policy of the Permission.
+// 登录用户可以读取，只有管理员可以修改。
+func (Permission) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			rule.AllowIfSystem(),
+			rule.DenyIfNoViewer(),
+			privacy.AlwaysAllowRule(),
+		},
+		Mutation: privacy.MutationPolicy{
+			rule.AllowIfSystem(),
+			rule.AllowIfAdmin(),
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
@@ -0,0 +1,93 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	gen "go-web/ent"
+	"go-web/ent/hook"
+	"go-web/ent/intercept"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+// SoftDeleteMixin 软删除：删除操作改为写入 deleted_at，查询默认不返回已删除的记录
+type SoftDeleteMixin struct {
+	mixin.Schema
+}
+
+// Fields of the SoftDeleteMixin.
+func (SoftDeleteMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("deleted_at").Optional().Nillable().Comment("删除时间，为空表示未删除").
+			Annotations(entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput)),
+	}
+}
+
+type (
+	includeDeletedKey struct{}
+	hardDeleteKey     struct{}
+)
+
+// IncludeDeleted 返回查询时包含已软删除记录的 context，恢复记录或管理工具中使用
+func IncludeDeleted(parent context.Context) context.Context {
+	return context.WithValue(parent, includeDeletedKey{}, true)
+}
+
+// HardDelete 返回删除时直接删除记录而不是软删除的 context
+func HardDelete(parent context.Context) context.Context {
+	return context.WithValue(parent, hardDeleteKey{}, true)
+}
+
+// Interceptors of the SoftDeleteMixin.
+func (d SoftDeleteMixin) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		intercept.TraverseFunc(func(ctx context.Context, q intercept.Query) error {
+			if include, _ := ctx.Value(includeDeletedKey{}).(bool); include {
+				return nil
+			}
+			d.P(q)
+			return nil
+		}),
+	}
+}
+
+// Hooks of the SoftDeleteMixin.
+func (d SoftDeleteMixin) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(
+			func(next ent.Mutator) ent.Mutator {
+				return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+					if hard, _ := ctx.Value(hardDeleteKey{}).(bool); hard {
+						return next.Mutate(ctx, m)
+					}
+					mx, ok := m.(interface {
+						SetOp(ent.Op)
+						Client() *gen.Client
+						SetDeletedAt(time.Time)
+						WhereP(...func(*sql.Selector))
+					})
+					if !ok {
+						return nil, fmt.Errorf("unexpected mutation type %T", m)
+					}
+					// 已删除的记录不再重复删除，删除改为更新 deleted_at
+					d.P(mx)
+					mx.SetOp(ent.OpUpdate)
+					mx.SetDeletedAt(time.Now())
+					return mx.Client().Mutate(ctx, m)
+				})
+			},
+			ent.OpDeleteOne|ent.OpDelete,
+		),
+	}
+}
+
+// P 添加未删除的过滤条件
+func (d SoftDeleteMixin) P(w interface{ WhereP(...func(*sql.Selector)) }) {
+	w.WhereP(sql.FieldIsNull(d.Fields()[0].Descriptor().Name))
+}
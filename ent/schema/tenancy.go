@@ -0,0 +1,107 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"go-web/ent/hook"
+	"go-web/ent/intercept"
+	goWebErrors "go-web/pkg/errors"
+	"go-web/pkg/tenancy"
+	"go-web/pkg/viewer"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// TenantMixin 租户隔离：实体归属于创建时 context 中的租户，查询、更新、删除只作用于当前租户。
+// context 中没有租户时只有系统身份可以访问（迁移、管理工具），其他访问一律拒绝。
+type TenantMixin struct {
+	mixin.Schema
+	// Optional 允许不属于任何租户的记录，例如租户解析前由系统写入的审计记录
+	Optional bool
+}
+
+// Fields of the TenantMixin.
+func (t TenantMixin) Fields() []ent.Field {
+	f := field.Uint64("tenant_id").Immutable().Comment("所属租户").
+		Annotations(entgql.Type("ID"), entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput))
+	if t.Optional {
+		f = f.Optional().Nillable()
+	}
+	return []ent.Field{f}
+}
+
+// Indexes of the TenantMixin.
+func (TenantMixin) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id"),
+	}
+}
+
+// Interceptors of the TenantMixin.
+func (t TenantMixin) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		intercept.TraverseFunc(func(ctx context.Context, q intercept.Query) error {
+			id, ok := tenancy.FromContext(ctx)
+			if !ok {
+				if viewer.IsSystem(ctx) {
+					return nil
+				}
+				return errTenantMissing
+			}
+			t.P(q, id)
+			return nil
+		}),
+	}
+}
+
+// Hooks of the TenantMixin.
+func (t TenantMixin) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(t.scope, ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne|ent.OpDelete|ent.OpDeleteOne),
+	}
+}
+
+// scope 创建时写入当前租户，显式指定其他租户时拒绝；更新与删除限定为当前租户的记录
+func (t TenantMixin) scope(next ent.Mutator) ent.Mutator {
+	return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+		mx, ok := m.(interface {
+			TenantID() (uint64, bool)
+			SetTenantID(uint64)
+			WhereP(...func(*sql.Selector))
+		})
+		if !ok {
+			return nil, fmt.Errorf("unexpected mutation type %T", m)
+		}
+
+		id, ok := tenancy.FromContext(ctx)
+		if !ok && !viewer.IsSystem(ctx) {
+			return nil, errTenantMissing
+		}
+		switch {
+		case !ok:
+			// 系统身份在租户之外操作，由调用方显式指定租户
+		case m.Op().Is(ent.OpCreate):
+			if set, exists := mx.TenantID(); exists && set != id {
+				return nil, goWebErrors.New(goWebErrors.ErrForbidden, "forbidden", fmt.Sprintf("%s belongs to another tenant", m.Type()))
+			}
+			mx.SetTenantID(id)
+		default:
+			t.P(mx, id)
+		}
+		return next.Mutate(ctx, m)
+	})
+}
+
+// P 添加属于指定租户的过滤条件
+func (t TenantMixin) P(w interface{ WhereP(...func(*sql.Selector)) }, tenantID uint64) {
+	w.WhereP(sql.FieldEQ(t.Fields()[0].Descriptor().Name, tenantID))
+}
+
+// errTenantMissing 非系统身份在租户之外访问租户内实体
+var errTenantMissing = goWebErrors.New(goWebErrors.ErrForbidden, "forbidden", "tenant is missing")
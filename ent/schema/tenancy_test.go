@@ -0,0 +1,203 @@
+package schema_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go-web/ent"
+	"go-web/ent/schema"
+	"go-web/ent/user"
+	goWebErrors "go-web/pkg/errors"
+	"go-web/pkg/tenancy"
+	"go-web/pkg/testutil"
+	"go-web/pkg/viewer"
+)
+
+// tenantFixture 两个租户，各有一个用户
+type tenantFixture struct {
+	client *ent.Client
+	// a、b 为两个租户内具有用户读写权限的管理员访问者
+	a, b         context.Context
+	tenantA      *ent.Tenant
+	tenantB      *ent.Tenant
+	userA, userB *ent.User
+}
+
+func newTenantFixture(t *testing.T) *tenantFixture {
+	t.Helper()
+	client := testutil.NewClient(t)
+	f := &tenantFixture{client: client}
+	f.tenantA, _ = testutil.NewTenant(t, client, "tenant-a")
+	f.tenantB, _ = testutil.NewTenant(t, client, "tenant-b")
+	f.a = adminContext(f.tenantA.ID)
+	f.b = adminContext(f.tenantB.ID)
+	f.userA = createUser(t, f.a, client, "alice")
+	f.userB = createUser(t, f.b, client, "bob")
+	return f
+}
+
+// adminContext 返回租户内管理员访问者的 context
+func adminContext(tenantID uint64) context.Context {
+	ctx := viewer.NewContext(context.Background(), &viewer.Viewer{
+		ID:          1,
+		Roles:       []string{"admin"},
+		Permissions: []string{"user:read", "user:write"},
+	})
+	return tenancy.NewContext(ctx, tenantID)
+}
+
+func createUser(t *testing.T, ctx context.Context, client *ent.Client, account string) *ent.User {
+	t.Helper()
+	u, err := client.User.Create().
+		SetName(account).
+		SetSex(false).
+		SetAge(30).
+		SetAccount(account).
+		SetPassword("password123").
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("failed to create user %s: %v", account, err)
+	}
+	return u
+}
+
+// isForbidden 是否为 ErrForbidden 业务错误
+func isForbidden(err error) bool {
+	var e *goWebErrors.Error
+	return errors.As(err, &e) && e.Code == goWebErrors.ErrForbidden
+}
+
+func TestTenantCannotReadOtherTenant(t *testing.T) {
+	f := newTenantFixture(t)
+
+	ids, err := f.client.User.Query().IDs(f.a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 1 || ids[0] != f.userA.ID {
+		t.Fatalf("tenant A sees users %v, want only %d", ids, f.userA.ID)
+	}
+	if _, err := f.client.User.Get(f.a, f.userB.ID); !ent.IsNotFound(err) {
+		t.Fatalf("tenant A reading a user of tenant B: err = %v, want not found", err)
+	}
+	if n, err := f.client.User.Query().Where(user.Account("bob")).Count(f.a); err != nil || n != 0 {
+		t.Fatalf("tenant A counting users of tenant B = (%d, %v), want 0", n, err)
+	}
+}
+
+func TestTenantCannotUpdateOtherTenant(t *testing.T) {
+	f := newTenantFixture(t)
+
+	if err := f.client.User.UpdateOneID(f.userB.ID).SetName("mallory").Exec(f.a); !ent.IsNotFound(err) {
+		t.Fatalf("tenant A updating a user of tenant B: err = %v, want not found", err)
+	}
+	n, err := f.client.User.Update().SetAge(99).Save(f.a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 1 {
+		t.Fatalf("bulk update in tenant A affected %d users, want 1", n)
+	}
+
+	b, err := f.client.User.Get(f.b, f.userB.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.Name != "bob" || b.Age != 30 {
+		t.Fatalf("user of tenant B was modified: %+v", b)
+	}
+}
+
+func TestTenantCannotDeleteOtherTenant(t *testing.T) {
+	f := newTenantFixture(t)
+
+	if err := f.client.User.DeleteOneID(f.userB.ID).Exec(f.a); !ent.IsNotFound(err) {
+		t.Fatalf("tenant A deleting a user of tenant B: err = %v, want not found", err)
+	}
+	hard := schema.HardDelete(f.a)
+	if err := f.client.User.DeleteOneID(f.userB.ID).Exec(hard); !ent.IsNotFound(err) {
+		t.Fatalf("tenant A hard deleting a user of tenant B: err = %v, want not found", err)
+	}
+	n, err := f.client.User.Delete().Exec(hard)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 1 {
+		t.Fatalf("bulk delete in tenant A removed %d users, want 1", n)
+	}
+
+	if _, err := f.client.User.Get(f.b, f.userB.ID); err != nil {
+		t.Fatalf("user of tenant B was deleted: %v", err)
+	}
+}
+
+func TestTenantCannotCreateInOtherTenant(t *testing.T) {
+	f := newTenantFixture(t)
+
+	_, err := f.client.User.Create().
+		SetTenantID(f.tenantB.ID).
+		SetName("mallory").
+		SetSex(false).
+		SetAge(30).
+		SetAccount("mallory").
+		SetPassword("password123").
+		Save(f.a)
+	if !isForbidden(err) {
+		t.Fatalf("tenant A creating a user in tenant B: err = %v, want forbidden", err)
+	}
+}
+
+func TestTenantRequiredOutsideSystemContext(t *testing.T) {
+	f := newTenantFixture(t)
+
+	ctx := viewer.NewContext(context.Background(), viewer.FromContext(f.a))
+	if _, err := f.client.User.Query().All(ctx); !isForbidden(err) {
+		t.Fatalf("query without tenant: err = %v, want forbidden", err)
+	}
+	if _, err := f.client.User.Update().SetAge(1).Save(ctx); !isForbidden(err) {
+		t.Fatalf("update without tenant: err = %v, want forbidden", err)
+	}
+	if _, err := f.client.User.Delete().Exec(ctx); !isForbidden(err) {
+		t.Fatalf("delete without tenant: err = %v, want forbidden", err)
+	}
+}
+
+func TestSystemContextEscapesTenant(t *testing.T) {
+	f := newTenantFixture(t)
+
+	// 没有租户的系统身份访问全部租户
+	sys := viewer.NewSystemContext(context.Background())
+	n, err := f.client.User.Query().Count(sys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 2 {
+		t.Fatalf("system context without tenant sees %d users, want 2", n)
+	}
+
+	// 系统身份指定租户时仍限定在该租户内
+	ids, err := f.client.User.Query().IDs(tenancy.NewContext(sys, f.tenantA.ID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 1 || ids[0] != f.userA.ID {
+		t.Fatalf("system context in tenant A sees users %v, want only %d", ids, f.userA.ID)
+	}
+
+	// 系统身份在租户之外创建记录时需要显式指定租户
+	u, err := f.client.User.Create().
+		SetTenantID(f.tenantB.ID).
+		SetName("carol").
+		SetSex(false).
+		SetAge(30).
+		SetAccount("carol").
+		SetPassword("password123").
+		Save(sys)
+	if err != nil {
+		t.Fatalf("system create with explicit tenant: %v", err)
+	}
+	if u.TenantID != f.tenantB.ID {
+		t.Fatalf("created user in tenant %d, want %d", u.TenantID, f.tenantB.ID)
+	}
+}
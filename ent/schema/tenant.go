@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"regexp"
+	"time"
+
+	"go-web/ent/privacy"
+	"go-web/ent/rule"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// slugPattern 租户标识只能包含小写字母、数字与连字符，可以直接用作子域名
+var slugPattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+
+// Tenant holds the schema definition for the Tenant entity.
+// 租户，租户内的实体通过 TenantMixin 关联到租户并相互隔离。
+type Tenant struct {
+	ent.Schema
+}
+
+// Mixin of the Tenant.
+func (Tenant) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Mixin{},
+	}
+}
+
+// Fields of the Tenant.
+func (Tenant) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").MaxLen(100).Comment("名称"),
+		field.String("slug").MaxLen(63).Unique().Immutable().Match(slugPattern).Comment("标识，用于请求头与子域名"),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("创建时间"),
+	}
+}
+
+// Policy of the Tenant.
+// 租户只能由系统身份管理，其他访问者只能读取当前租户。
+func (Tenant) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			rule.AllowIfSystem(),
+			rule.FilterTenantToCurrent(),
+			privacy.AlwaysAllowRule(),
+		},
+		Mutation: privacy.MutationPolicy{
+			rule.AllowIfSystem(),
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
@@ -1,8 +1,27 @@
 package schema
 
 import (
+	"context"
+	"fmt"
+	"time"
+
+	gen "go-web/ent"
+	"go-web/ent/actiontoken"
+	"go-web/ent/hook"
+	"go-web/ent/privacy"
+	"go-web/ent/rule"
+	"go-web/ent/user"
+	"go-web/pkg/password"
+	"go-web/pkg/util"
+	"go-web/pkg/viewer"
+
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // User holds the schema definition for the User entity.
@@ -10,18 +29,271 @@ type User struct {
 	ent.Schema
 }
 
+// Annotations of the User.
+func (User) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "user"},
+		entgql.Mutations(entgql.MutationCreate(), entgql.MutationUpdate()),
+		// 支持按多个字段排序，最后总是按 ID 排序保证顺序稳定
+		entgql.MultiOrder(),
+	}
+}
+
+// Mixin of the User.
+func (User) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Mixin{},
+		TenantMixin{},
+		TimeMixin{},
+		ActorMixin{},
+		VersionMixin{},
+		SoftDeleteMixin{},
+	}
+}
+
 // Fields of the User.
 func (User) Fields() []ent.Field {
 	return []ent.Field{
-		field.String("name").MaxLen(50).Comment("姓名"),
+		field.String("name").MaxLen(50).Comment("姓名").
+			Annotations(entgql.OrderField("NAME")),
 		field.Bool("sex").Comment("性别"),
-		field.Int("age").Comment("年龄"),
-		field.String("account").MaxLen(20).Comment("账号"),
-		field.String("password").MaxLen(20).Comment("密码"),
+		field.Int("age").Comment("年龄").
+			Annotations(entgql.OrderField("AGE")),
+		field.String("account").MaxLen(20).Comment("账号").
+			Annotations(entgql.OrderField("ACCOUNT")),
+		field.String("email").MaxLen(255).Optional().Nillable().Validate(util.ValidateEmail).Comment("邮箱，写入前转为小写"),
+		field.Time("email_verified_at").Optional().Nillable().Comment("邮箱验证时间，邮箱变更后清空").
+			Annotations(entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput)),
+		// 创建用户时通过输入设置初始密码，由 hashPassword 转为哈希；之后只能通过修改或重置密码变更
+		field.String("password").MaxLen(255).Sensitive().Comment("密码，写入前转为哈希").
+			Annotations(entgql.Skip(entgql.SkipType, entgql.SkipEnumField, entgql.SkipOrderField, entgql.SkipWhereInput, entgql.SkipMutationUpdateInput)),
+		field.String("totp_secret").MaxLen(64).Optional().Sensitive().Comment("TOTP 密钥，启用前为待确认的密钥").
+			Annotations(entgql.Skip(entgql.SkipAll)),
+		field.Bool("totp_enabled").Default(false).Comment("是否启用两步验证").
+			Annotations(entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput)),
+		field.Strings("recovery_codes").Optional().Sensitive().Comment("恢复码哈希").
+			Annotations(entgql.Skip(entgql.SkipAll)),
+		// 头像通过专门的上传接口设置
+		field.Uint64("avatar_id").Optional().Nillable().Comment("头像文件").
+			Annotations(entgql.Skip(entgql.SkipAll)),
 	}
 }
 
 // Edges of the User.
 func (User) Edges() []ent.Edge {
-	return []ent.Edge{}
+	return []ent.Edge{
+		// 角色通过专门的授权接口管理，不允许在用户输入中修改
+		edge.To("roles", Role.Type).
+			Annotations(entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput)),
+		edge.To("api_keys", APIKey.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade), entgql.Skip(entgql.SkipAll)),
+		edge.To("identities", Identity.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade), entgql.Skip(entgql.SkipAll)),
+		edge.To("action_tokens", ActionToken.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade), entgql.Skip(entgql.SkipAll)),
+		edge.To("data_exports", DataExport.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade), entgql.Skip(entgql.SkipAll)),
+		edge.To("files", File.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade), entgql.Skip(entgql.SkipAll)),
+		edge.To("avatar", File.Type).Field("avatar_id").Unique().
+			Annotations(entsql.OnDelete(entsql.SetNull), entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput)),
+	}
+}
+
+// Indexes of the User.
+// 账号与邮箱在租户内唯一。
+func (User) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "account").Unique(),
+		index.Fields("tenant_id", "email").Unique(),
+	}
+}
+
+// Hooks of the User.
+func (User) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(rehashPasswordOnly, ent.OpUpdate|ent.OpUpdateOne),
+		hook.On(hashPassword, ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne),
+		hook.On(normalizeEmail, ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne),
+		hook.On(revokePasswordResetTokens, ent.OpUpdate|ent.OpUpdateOne),
+		hook.On(revokeEmailVerificationTokens, ent.OpUpdate|ent.OpUpdateOne),
+	}
+}
+
+// Policy of the User.
+// 用户只能读取和修改本人，拥有 user:read / user:write 权限的访问者可以访问全部用户。
+func (User) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			rule.AllowIfSystem(),
+			rule.DenyIfNoViewer(),
+			rule.AllowIfPermission("user:read"),
+			rule.FilterUserToViewer(),
+			privacy.AlwaysAllowRule(),
+		},
+		Mutation: privacy.MutationPolicy{
+			rule.AllowIfSystem(),
+			rule.DenyIfNoViewer(),
+			rule.AllowIfPermission("user:write"),
+			rule.AllowUserUpdateSelf(),
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
+
+// passwordHashedKey 标记写入的密码已是哈希
+type passwordHashedKey struct{}
+
+// PasswordHashed 返回写入的密码已是哈希的 context，hashPassword 不再计算哈希。
+// 只用于预先批量计算哈希的场景（导入用户），其他写入中形似哈希的输入仍作为明文处理。
+func PasswordHashed(parent context.Context) context.Context {
+	return context.WithValue(parent, passwordHashedKey{}, true)
+}
+
+// passwordRehashKey 标记写入是对密码哈希的升级
+type passwordRehashKey struct{}
+
+// PasswordRehash 返回升级密码哈希的 context，写入的值必须是已计算的哈希。
+// 升级只改变 password 列的编码而不是密码本身：不作废密码重置令牌，也不更新版本号、更新时间和更新者。
+func PasswordRehash(parent context.Context) context.Context {
+	return context.WithValue(PasswordHashed(parent), passwordRehashKey{}, true)
+}
+
+// isPasswordRehash 写入是否为密码哈希的升级
+func isPasswordRehash(ctx context.Context) bool {
+	rehash, _ := ctx.Value(passwordRehashKey{}).(bool)
+	return rehash
+}
+
+// rehashPasswordOnly 升级密码哈希时撤销混入设置的更新时间、更新者与版本号，
+// 并拒绝修改 password 以外的字段或边
+func rehashPasswordOnly(next ent.Mutator) ent.Mutator {
+	return hook.UserFunc(func(ctx context.Context, m *gen.UserMutation) (ent.Value, error) {
+		if !isPasswordRehash(ctx) {
+			return next.Mutate(ctx, m)
+		}
+
+		m.ResetUpdatedAt()
+		m.ResetUpdatedBy()
+		m.ResetVersion()
+		fields := m.Fields()
+		if len(fields) != 1 || fields[0] != user.FieldPassword ||
+			len(m.AddedFields())+len(m.ClearedFields())+len(m.AddedEdges())+len(m.RemovedEdges())+len(m.ClearedEdges()) > 0 {
+			return nil, fmt.Errorf("password rehash can only update the password")
+		}
+		return next.Mutate(ctx, m)
+	})
+}
+
+// hashPassword 在写入前将明文密码替换为编码后的哈希。
+// 通过 PasswordHashed 标记为哈希的值保持不变，但必须是已注册算法生成的哈希。
+func hashPassword(next ent.Mutator) ent.Mutator {
+	return hook.UserFunc(func(ctx context.Context, m *gen.UserMutation) (ent.Value, error) {
+		plain, ok := m.Password()
+		if !ok {
+			return next.Mutate(ctx, m)
+		}
+
+		passwords := password.Default()
+		if hashed, _ := ctx.Value(passwordHashedKey{}).(bool); hashed {
+			if !passwords.IsHashed(plain) {
+				return nil, fmt.Errorf("password marked as hashed is not a recognized hash")
+			}
+			return next.Mutate(ctx, m)
+		}
+
+		hash, err := passwords.Hash(plain)
+		if err != nil {
+			return nil, fmt.Errorf("failed to hash password: %w", err)
+		}
+		m.SetPassword(hash)
+
+		return next.Mutate(ctx, m)
+	})
+}
+
+// revokePasswordResetTokens 密码变更后作废用户尚未使用的密码重置令牌，升级哈希不是密码变更
+func revokePasswordResetTokens(next ent.Mutator) ent.Mutator {
+	return hook.UserFunc(func(ctx context.Context, m *gen.UserMutation) (ent.Value, error) {
+		if _, ok := m.Password(); !ok || isPasswordRehash(ctx) {
+			return next.Mutate(ctx, m)
+		}
+
+		ids, err := m.IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+		if len(ids) == 0 {
+			return v, nil
+		}
+
+		err = m.Client().ActionToken.Update().
+			Where(
+				actiontoken.UserIDIn(ids...),
+				actiontoken.KindEQ(actiontoken.KindPasswordReset),
+				actiontoken.UsedAtIsNil(),
+			).
+			SetUsedAt(time.Now()).
+			Exec(viewer.NewSystemContext(ctx))
+		if err != nil {
+			return nil, fmt.Errorf("failed to revoke password reset tokens: %w", err)
+		}
+		return v, nil
+	})
+}
+
+// normalizeEmail 写入前去除邮箱首尾空白并转为小写。
+// 更新邮箱且未同时设置验证时间时，清空验证时间，新邮箱需要重新验证。
+func normalizeEmail(next ent.Mutator) ent.Mutator {
+	return hook.UserFunc(func(ctx context.Context, m *gen.UserMutation) (ent.Value, error) {
+		email, ok := m.Email()
+		if !ok {
+			return next.Mutate(ctx, m)
+		}
+
+		m.SetEmail(util.NormalizeEmail(email))
+		if _, ok := m.EmailVerifiedAt(); !ok && !m.Op().Is(ent.OpCreate) {
+			m.ClearEmailVerifiedAt()
+		}
+		return next.Mutate(ctx, m)
+	})
+}
+
+// revokeEmailVerificationTokens 邮箱变更或清空后作废用户尚未使用的邮箱验证令牌，
+// 发往旧邮箱的验证链接不能用于验证新邮箱
+func revokeEmailVerificationTokens(next ent.Mutator) ent.Mutator {
+	return hook.UserFunc(func(ctx context.Context, m *gen.UserMutation) (ent.Value, error) {
+		if _, ok := m.Email(); !ok && !m.EmailCleared() {
+			return next.Mutate(ctx, m)
+		}
+
+		ids, err := m.IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+		if len(ids) == 0 {
+			return v, nil
+		}
+
+		err = m.Client().ActionToken.Update().
+			Where(
+				actiontoken.UserIDIn(ids...),
+				actiontoken.KindEQ(actiontoken.KindEmailVerification),
+				actiontoken.UsedAtIsNil(),
+			).
+			SetUsedAt(time.Now()).
+			Exec(viewer.NewSystemContext(ctx))
+		if err != nil {
+			return nil, fmt.Errorf("failed to revoke email verification tokens: %w", err)
+		}
+		return v, nil
+	})
 }
@@ -0,0 +1,106 @@
+package schema_test
+
+import (
+	"testing"
+
+	"go-web/ent/schema"
+	"go-web/pkg/password"
+	"go-web/pkg/testutil"
+)
+
+func TestHashPasswordHashesHashShapedInput(t *testing.T) {
+	client := testutil.NewClient(t)
+	_, ctx := testutil.NewTenant(t, client, "acme")
+
+	// 形似 bcrypt 哈希的输入仍是用户选择的明文密码
+	const plain = "$2a$04$abcdefghijklmnopqrstuuABCDEFGHIJKLMNOPQRSTUVWXYZ01234"
+	u, err := client.User.Create().
+		SetName("alice").
+		SetSex(false).
+		SetAge(30).
+		SetAccount("alice").
+		SetPassword(plain).
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Password == plain {
+		t.Fatal("hash-shaped password was stored without hashing")
+	}
+	if ok, _, err := password.Default().Verify(plain, u.Password); err != nil || !ok {
+		t.Fatalf("stored hash does not verify the input: ok=%v err=%v", ok, err)
+	}
+}
+
+func TestPasswordHashedStoresHash(t *testing.T) {
+	client := testutil.NewClient(t)
+	_, ctx := testutil.NewTenant(t, client, "acme")
+
+	hash, err := password.Default().Hash("correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, err := client.User.Create().
+		SetName("alice").
+		SetSex(false).
+		SetAge(30).
+		SetAccount("alice").
+		SetPassword(hash).
+		Save(schema.PasswordHashed(ctx))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Password != hash {
+		t.Fatal("password marked as hashed was hashed again")
+	}
+
+	// 标记为哈希的值必须是可识别的哈希，不能借此写入明文
+	_, err = client.User.Create().
+		SetName("bob").
+		SetSex(false).
+		SetAge(30).
+		SetAccount("bob").
+		SetPassword("correct horse").
+		Save(schema.PasswordHashed(ctx))
+	if err == nil {
+		t.Fatal("plain password marked as hashed was stored")
+	}
+}
+
+func TestPasswordRehashOnlyUpdatesPassword(t *testing.T) {
+	client := testutil.NewClient(t)
+	_, ctx := testutil.NewTenant(t, client, "acme")
+
+	u, err := client.User.Create().
+		SetName("alice").
+		SetSex(false).
+		SetAge(30).
+		SetAccount("alice").
+		SetPassword("correct horse").
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash, err := password.Default().Hash("correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 升级哈希的写入不能夹带其他字段
+	if err := client.User.UpdateOne(u).SetPassword(hash).SetName("mallory").Exec(schema.PasswordRehash(ctx)); err == nil {
+		t.Fatal("password rehash updated another field")
+	}
+	// 升级哈希同样要求写入的是哈希
+	if err := client.User.UpdateOne(u).SetPassword("new password").Exec(schema.PasswordRehash(ctx)); err == nil {
+		t.Fatal("password rehash stored a plain password")
+	}
+
+	got, err := client.User.UpdateOne(u).SetPassword(hash).Save(schema.PasswordRehash(ctx))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Password != hash || got.Version != u.Version || !got.UpdatedAt.Equal(u.UpdatedAt) {
+		t.Fatalf("rehash wrote password=%v version=%d updated_at=%v, want only the password changed",
+			got.Password == hash, got.Version, got.UpdatedAt)
+	}
+}
@@ -0,0 +1,92 @@
+package schema_test
+
+import (
+	"errors"
+	"testing"
+
+	"go-web/ent"
+	"go-web/ent/user"
+	goWebErrors "go-web/pkg/errors"
+)
+
+// isVersionConflict 是否为版本冲突错误
+func isVersionConflict(err error) bool {
+	var e *goWebErrors.Error
+	return errors.As(err, &e) && e.Code == goWebErrors.ErrInvalidState && e.Message == "version_conflict"
+}
+
+func TestVersionIncrementsOnUpdate(t *testing.T) {
+	f := newTenantFixture(t)
+
+	if f.userA.Version != 1 {
+		t.Fatalf("created version = %d, want 1", f.userA.Version)
+	}
+	u, err := f.client.User.UpdateOne(f.userA).SetName("alice 2").Save(f.a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Version != 2 {
+		t.Fatalf("version after update = %d, want 2", u.Version)
+	}
+	if _, err := f.client.User.Update().Where(user.ID(f.userA.ID)).SetAge(31).Save(f.a); err != nil {
+		t.Fatal(err)
+	}
+	if got := f.client.User.GetX(f.a, f.userA.ID).Version; got != 3 {
+		t.Fatalf("version after bulk update = %d, want 3", got)
+	}
+}
+
+func TestVersionExpectedMatches(t *testing.T) {
+	f := newTenantFixture(t)
+
+	u, err := f.client.User.UpdateOneID(f.userA.ID).SetVersion(1).SetName("alice 2").Save(f.a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Version != 2 || u.Name != "alice 2" {
+		t.Fatalf("updated user = version %d name %q, want version 2", u.Version, u.Name)
+	}
+}
+
+func TestVersionConflict(t *testing.T) {
+	f := newTenantFixture(t)
+
+	// 两个客户端读取到版本 1，先提交的成功，后提交的冲突
+	if _, err := f.client.User.UpdateOneID(f.userA.ID).SetVersion(1).SetName("first").Save(f.a); err != nil {
+		t.Fatal(err)
+	}
+	_, err := f.client.User.UpdateOneID(f.userA.ID).SetVersion(1).SetName("second").Save(f.a)
+	if !isVersionConflict(err) {
+		t.Fatalf("stale update: err = %v, want version_conflict", err)
+	}
+
+	u := f.client.User.GetX(f.a, f.userA.ID)
+	if u.Name != "first" || u.Version != 2 {
+		t.Fatalf("stored user = name %q version %d, want first at version 2", u.Name, u.Version)
+	}
+
+	// 批量更新按版本号过滤，不匹配时不更新也不报错
+	n, err := f.client.User.Update().Where(user.ID(f.userA.ID)).SetVersion(1).SetName("bulk").Save(f.a)
+	if err != nil || n != 0 {
+		t.Fatalf("stale bulk update = (%d, %v), want (0, nil)", n, err)
+	}
+}
+
+func TestVersionMissingRowIsNotFound(t *testing.T) {
+	f := newTenantFixture(t)
+
+	if _, err := f.client.User.UpdateOneID(f.userA.ID + 1000).SetVersion(1).SetName("x").Save(f.a); !ent.IsNotFound(err) {
+		t.Fatalf("update of a missing user: err = %v, want not found", err)
+	}
+	// 其他租户的记录对当前租户不存在，不能通过版本冲突探测
+	if _, err := f.client.User.UpdateOneID(f.userB.ID).SetVersion(5).SetName("x").Save(f.a); !ent.IsNotFound(err) || isVersionConflict(err) {
+		t.Fatalf("update of another tenant's user: err = %v, want not found", err)
+	}
+	// 软删除的记录同样视为不存在
+	if err := f.client.User.DeleteOneID(f.userA.ID).Exec(f.a); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.client.User.UpdateOneID(f.userA.ID).SetVersion(5).SetName("x").Save(f.a); !ent.IsNotFound(err) || isVersionConflict(err) {
+		t.Fatalf("update of a deleted user: err = %v, want not found", err)
+	}
+}
@@ -0,0 +1,123 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"go-web/ent/accounterasure"
+	"go-web/ent/actiontoken"
+	"go-web/ent/apikey"
+	"go-web/ent/auditlog"
+	"go-web/ent/dataexport"
+	"go-web/ent/file"
+	"go-web/ent/identity"
+	"go-web/ent/permission"
+	"go-web/ent/role"
+	"go-web/ent/tenant"
+	"go-web/ent/user"
+)
+
+// Snapshot loads the entities of the given type (as returned by Mutation.Type)
+// keyed by ID. IDs that do not exist are absent from the result. The audit hook
+// uses it to capture entity state before and after a mutation.
+func (c *Client) Snapshot(ctx context.Context, typ string, ids ...uint64) (map[uint64]any, error) {
+	result := make(map[uint64]any, len(ids))
+	if len(ids) == 0 {
+		return result, nil
+	}
+	switch typ {
+	case TypeAPIKey:
+		nodes, err := c.APIKey.Query().Where(apikey.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range nodes {
+			result[n.ID] = n
+		}
+	case TypeAccountErasure:
+		nodes, err := c.AccountErasure.Query().Where(accounterasure.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range nodes {
+			result[n.ID] = n
+		}
+	case TypeActionToken:
+		nodes, err := c.ActionToken.Query().Where(actiontoken.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range nodes {
+			result[n.ID] = n
+		}
+	case TypeAuditLog:
+		nodes, err := c.AuditLog.Query().Where(auditlog.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range nodes {
+			result[n.ID] = n
+		}
+	case TypeDataExport:
+		nodes, err := c.DataExport.Query().Where(dataexport.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range nodes {
+			result[n.ID] = n
+		}
+	case TypeFile:
+		nodes, err := c.File.Query().Where(file.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range nodes {
+			result[n.ID] = n
+		}
+	case TypeIdentity:
+		nodes, err := c.Identity.Query().Where(identity.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range nodes {
+			result[n.ID] = n
+		}
+	case TypePermission:
+		nodes, err := c.Permission.Query().Where(permission.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range nodes {
+			result[n.ID] = n
+		}
+	case TypeRole:
+		nodes, err := c.Role.Query().Where(role.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range nodes {
+			result[n.ID] = n
+		}
+	case TypeTenant:
+		nodes, err := c.Tenant.Query().Where(tenant.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range nodes {
+			result[n.ID] = n
+		}
+	case TypeUser:
+		nodes, err := c.User.Query().Where(user.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range nodes {
+			result[n.ID] = n
+		}
+	default:
+		return nil, fmt.Errorf("ent: unknown entity type %q", typ)
+	}
+	return result, nil
+}
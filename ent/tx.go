@@ -14,6 +14,26 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// APIKey is the client for interacting with the APIKey builders.
+	APIKey *APIKeyClient
+	// AccountErasure is the client for interacting with the AccountErasure builders.
+	AccountErasure *AccountErasureClient
+	// ActionToken is the client for interacting with the ActionToken builders.
+	ActionToken *ActionTokenClient
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
+	// DataExport is the client for interacting with the DataExport builders.
+	DataExport *DataExportClient
+	// File is the client for interacting with the File builders.
+	File *FileClient
+	// Identity is the client for interacting with the Identity builders.
+	Identity *IdentityClient
+	// Permission is the client for interacting with the Permission builders.
+	Permission *PermissionClient
+	// Role is the client for interacting with the Role builders.
+	Role *RoleClient
+	// Tenant is the client for interacting with the Tenant builders.
+	Tenant *TenantClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 
@@ -147,6 +167,16 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.APIKey = NewAPIKeyClient(tx.config)
+	tx.AccountErasure = NewAccountErasureClient(tx.config)
+	tx.ActionToken = NewActionTokenClient(tx.config)
+	tx.AuditLog = NewAuditLogClient(tx.config)
+	tx.DataExport = NewDataExportClient(tx.config)
+	tx.File = NewFileClient(tx.config)
+	tx.Identity = NewIdentityClient(tx.config)
+	tx.Permission = NewPermissionClient(tx.config)
+	tx.Role = NewRoleClient(tx.config)
+	tx.Tenant = NewTenantClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
 
@@ -157,7 +187,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: User.QueryXXX(), the query will be executed
+// applies a query, for example: APIKey.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
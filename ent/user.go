@@ -3,10 +3,14 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
+	"go-web/ent/file"
 	"go-web/ent/user"
 	"strings"
+	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 )
 
@@ -15,6 +19,20 @@ type User struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uint64 `json:"id,omitempty"`
+	// 所属租户
+	TenantID uint64 `json:"tenant_id,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 创建者用户 ID
+	CreatedBy *uint64 `json:"created_by,omitempty"`
+	// 最后更新者用户 ID
+	UpdatedBy *uint64 `json:"updated_by,omitempty"`
+	// 乐观锁版本号
+	Version int `json:"version,omitempty"`
+	// 删除时间，为空表示未删除
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// 姓名
 	Name string `json:"name,omitempty"`
 	// 性别
@@ -23,8 +41,121 @@ type User struct {
 	Age int `json:"age,omitempty"`
 	// 账号
 	Account string `json:"account,omitempty"`
-	// 密码
-	Password string `json:"password,omitempty"`
+	// 邮箱，写入前转为小写
+	Email *string `json:"email,omitempty"`
+	// 邮箱验证时间，邮箱变更后清空
+	EmailVerifiedAt *time.Time `json:"email_verified_at,omitempty"`
+	// 密码，写入前转为哈希
+	Password string `json:"-"`
+	// TOTP 密钥，启用前为待确认的密钥
+	TotpSecret string `json:"-"`
+	// 是否启用两步验证
+	TotpEnabled bool `json:"totp_enabled,omitempty"`
+	// 恢复码哈希
+	RecoveryCodes []string `json:"-"`
+	// 头像文件
+	AvatarID *uint64 `json:"avatar_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the UserQuery when eager-loading is set.
+	Edges        UserEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// UserEdges holds the relations/edges for other nodes in the graph.
+type UserEdges struct {
+	// Roles holds the value of the roles edge.
+	Roles []*Role `json:"roles,omitempty"`
+	// APIKeys holds the value of the api_keys edge.
+	APIKeys []*APIKey `json:"api_keys,omitempty"`
+	// Identities holds the value of the identities edge.
+	Identities []*Identity `json:"identities,omitempty"`
+	// ActionTokens holds the value of the action_tokens edge.
+	ActionTokens []*ActionToken `json:"action_tokens,omitempty"`
+	// DataExports holds the value of the data_exports edge.
+	DataExports []*DataExport `json:"data_exports,omitempty"`
+	// Files holds the value of the files edge.
+	Files []*File `json:"files,omitempty"`
+	// Avatar holds the value of the avatar edge.
+	Avatar *File `json:"avatar,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [7]bool
+	// totalCount holds the count of the edges above.
+	totalCount [2]map[string]int
+
+	namedRoles        map[string][]*Role
+	namedAPIKeys      map[string][]*APIKey
+	namedIdentities   map[string][]*Identity
+	namedActionTokens map[string][]*ActionToken
+	namedDataExports  map[string][]*DataExport
+	namedFiles        map[string][]*File
+}
+
+// RolesOrErr returns the Roles value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) RolesOrErr() ([]*Role, error) {
+	if e.loadedTypes[0] {
+		return e.Roles, nil
+	}
+	return nil, &NotLoadedError{edge: "roles"}
+}
+
+// APIKeysOrErr returns the APIKeys value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) APIKeysOrErr() ([]*APIKey, error) {
+	if e.loadedTypes[1] {
+		return e.APIKeys, nil
+	}
+	return nil, &NotLoadedError{edge: "api_keys"}
+}
+
+// IdentitiesOrErr returns the Identities value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) IdentitiesOrErr() ([]*Identity, error) {
+	if e.loadedTypes[2] {
+		return e.Identities, nil
+	}
+	return nil, &NotLoadedError{edge: "identities"}
+}
+
+// ActionTokensOrErr returns the ActionTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ActionTokensOrErr() ([]*ActionToken, error) {
+	if e.loadedTypes[3] {
+		return e.ActionTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "action_tokens"}
+}
+
+// DataExportsOrErr returns the DataExports value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) DataExportsOrErr() ([]*DataExport, error) {
+	if e.loadedTypes[4] {
+		return e.DataExports, nil
+	}
+	return nil, &NotLoadedError{edge: "data_exports"}
+}
+
+// FilesOrErr returns the Files value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) FilesOrErr() ([]*File, error) {
+	if e.loadedTypes[5] {
+		return e.Files, nil
+	}
+	return nil, &NotLoadedError{edge: "files"}
+}
+
+// AvatarOrErr returns the Avatar value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserEdges) AvatarOrErr() (*File, error) {
+	if e.loadedTypes[6] {
+		if e.Avatar == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: file.Label}
+		}
+		return e.Avatar, nil
+	}
+	return nil, &NotLoadedError{edge: "avatar"}
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -32,14 +163,18 @@ func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldSex:
+		case user.FieldRecoveryCodes:
+			values[i] = new([]byte)
+		case user.FieldSex, user.FieldTotpEnabled:
 			values[i] = new(sql.NullBool)
-		case user.FieldID, user.FieldAge:
+		case user.FieldID, user.FieldTenantID, user.FieldCreatedBy, user.FieldUpdatedBy, user.FieldVersion, user.FieldAge, user.FieldAvatarID:
 			values[i] = new(sql.NullInt64)
-		case user.FieldName, user.FieldAccount, user.FieldPassword:
+		case user.FieldName, user.FieldAccount, user.FieldEmail, user.FieldPassword, user.FieldTotpSecret:
 			values[i] = new(sql.NullString)
+		case user.FieldCreatedAt, user.FieldUpdatedAt, user.FieldDeletedAt, user.FieldEmailVerifiedAt:
+			values[i] = new(sql.NullTime)
 		default:
-			return nil, fmt.Errorf("unexpected column %q for type User", columns[i])
+			values[i] = new(sql.UnknownType)
 		}
 	}
 	return values, nil
@@ -59,6 +194,51 @@ func (u *User) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			u.ID = uint64(value.Int64)
+		case user.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				u.TenantID = uint64(value.Int64)
+			}
+		case user.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				u.CreatedAt = value.Time
+			}
+		case user.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				u.UpdatedAt = value.Time
+			}
+		case user.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				u.CreatedBy = new(uint64)
+				*u.CreatedBy = uint64(value.Int64)
+			}
+		case user.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				u.UpdatedBy = new(uint64)
+				*u.UpdatedBy = uint64(value.Int64)
+			}
+		case user.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				u.Version = int(value.Int64)
+			}
+		case user.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				u.DeletedAt = new(time.Time)
+				*u.DeletedAt = value.Time
+			}
 		case user.FieldName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field name", values[i])
@@ -83,17 +263,101 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.Account = value.String
 			}
+		case user.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				u.Email = new(string)
+				*u.Email = value.String
+			}
+		case user.FieldEmailVerifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field email_verified_at", values[i])
+			} else if value.Valid {
+				u.EmailVerifiedAt = new(time.Time)
+				*u.EmailVerifiedAt = value.Time
+			}
 		case user.FieldPassword:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field password", values[i])
 			} else if value.Valid {
 				u.Password = value.String
 			}
+		case user.FieldTotpSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_secret", values[i])
+			} else if value.Valid {
+				u.TotpSecret = value.String
+			}
+		case user.FieldTotpEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_enabled", values[i])
+			} else if value.Valid {
+				u.TotpEnabled = value.Bool
+			}
+		case user.FieldRecoveryCodes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field recovery_codes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &u.RecoveryCodes); err != nil {
+					return fmt.Errorf("unmarshal field recovery_codes: %w", err)
+				}
+			}
+		case user.FieldAvatarID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field avatar_id", values[i])
+			} else if value.Valid {
+				u.AvatarID = new(uint64)
+				*u.AvatarID = uint64(value.Int64)
+			}
+		default:
+			u.selectValues.Set(columns[i], values[i])
 		}
 	}
 	return nil
 }
 
+// Value returns the ent.Value that was dynamically selected and assigned to the User.
+// This includes values selected through modifiers, order, etc.
+func (u *User) Value(name string) (ent.Value, error) {
+	return u.selectValues.Get(name)
+}
+
+// QueryRoles queries the "roles" edge of the User entity.
+func (u *User) QueryRoles() *RoleQuery {
+	return NewUserClient(u.config).QueryRoles(u)
+}
+
+// QueryAPIKeys queries the "api_keys" edge of the User entity.
+func (u *User) QueryAPIKeys() *APIKeyQuery {
+	return NewUserClient(u.config).QueryAPIKeys(u)
+}
+
+// QueryIdentities queries the "identities" edge of the User entity.
+func (u *User) QueryIdentities() *IdentityQuery {
+	return NewUserClient(u.config).QueryIdentities(u)
+}
+
+// QueryActionTokens queries the "action_tokens" edge of the User entity.
+func (u *User) QueryActionTokens() *ActionTokenQuery {
+	return NewUserClient(u.config).QueryActionTokens(u)
+}
+
+// QueryDataExports queries the "data_exports" edge of the User entity.
+func (u *User) QueryDataExports() *DataExportQuery {
+	return NewUserClient(u.config).QueryDataExports(u)
+}
+
+// QueryFiles queries the "files" edge of the User entity.
+func (u *User) QueryFiles() *FileQuery {
+	return NewUserClient(u.config).QueryFiles(u)
+}
+
+// QueryAvatar queries the "avatar" edge of the User entity.
+func (u *User) QueryAvatar() *FileQuery {
+	return NewUserClient(u.config).QueryAvatar(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -117,6 +381,33 @@ func (u *User) String() string {
 	var builder strings.Builder
 	builder.WriteString("User(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", u.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", u.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(u.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(u.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := u.CreatedBy; v != nil {
+		builder.WriteString("created_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := u.UpdatedBy; v != nil {
+		builder.WriteString("updated_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", u.Version))
+	builder.WriteString(", ")
+	if v := u.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(u.Name)
 	builder.WriteString(", ")
@@ -129,11 +420,176 @@ func (u *User) String() string {
 	builder.WriteString("account=")
 	builder.WriteString(u.Account)
 	builder.WriteString(", ")
-	builder.WriteString("password=")
-	builder.WriteString(u.Password)
+	if v := u.Email; v != nil {
+		builder.WriteString("email=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := u.EmailVerifiedAt; v != nil {
+		builder.WriteString("email_verified_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("password=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("totp_secret=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("totp_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", u.TotpEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("recovery_codes=<sensitive>")
+	builder.WriteString(", ")
+	if v := u.AvatarID; v != nil {
+		builder.WriteString("avatar_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
 
+// NamedRoles returns the Roles named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedRoles(name string) ([]*Role, error) {
+	if u.Edges.namedRoles == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedRoles[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedRoles(name string, edges ...*Role) {
+	if u.Edges.namedRoles == nil {
+		u.Edges.namedRoles = make(map[string][]*Role)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedRoles[name] = []*Role{}
+	} else {
+		u.Edges.namedRoles[name] = append(u.Edges.namedRoles[name], edges...)
+	}
+}
+
+// NamedAPIKeys returns the APIKeys named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedAPIKeys(name string) ([]*APIKey, error) {
+	if u.Edges.namedAPIKeys == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedAPIKeys[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedAPIKeys(name string, edges ...*APIKey) {
+	if u.Edges.namedAPIKeys == nil {
+		u.Edges.namedAPIKeys = make(map[string][]*APIKey)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedAPIKeys[name] = []*APIKey{}
+	} else {
+		u.Edges.namedAPIKeys[name] = append(u.Edges.namedAPIKeys[name], edges...)
+	}
+}
+
+// NamedIdentities returns the Identities named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedIdentities(name string) ([]*Identity, error) {
+	if u.Edges.namedIdentities == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedIdentities[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedIdentities(name string, edges ...*Identity) {
+	if u.Edges.namedIdentities == nil {
+		u.Edges.namedIdentities = make(map[string][]*Identity)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedIdentities[name] = []*Identity{}
+	} else {
+		u.Edges.namedIdentities[name] = append(u.Edges.namedIdentities[name], edges...)
+	}
+}
+
+// NamedActionTokens returns the ActionTokens named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedActionTokens(name string) ([]*ActionToken, error) {
+	if u.Edges.namedActionTokens == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedActionTokens[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedActionTokens(name string, edges ...*ActionToken) {
+	if u.Edges.namedActionTokens == nil {
+		u.Edges.namedActionTokens = make(map[string][]*ActionToken)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedActionTokens[name] = []*ActionToken{}
+	} else {
+		u.Edges.namedActionTokens[name] = append(u.Edges.namedActionTokens[name], edges...)
+	}
+}
+
+// NamedDataExports returns the DataExports named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedDataExports(name string) ([]*DataExport, error) {
+	if u.Edges.namedDataExports == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedDataExports[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedDataExports(name string, edges ...*DataExport) {
+	if u.Edges.namedDataExports == nil {
+		u.Edges.namedDataExports = make(map[string][]*DataExport)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedDataExports[name] = []*DataExport{}
+	} else {
+		u.Edges.namedDataExports[name] = append(u.Edges.namedDataExports[name], edges...)
+	}
+}
+
+// NamedFiles returns the Files named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedFiles(name string) ([]*File, error) {
+	if u.Edges.namedFiles == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedFiles[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedFiles(name string, edges ...*File) {
+	if u.Edges.namedFiles == nil {
+		u.Edges.namedFiles = make(map[string][]*File)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedFiles[name] = []*File{}
+	} else {
+		u.Edges.namedFiles[name] = append(u.Edges.namedFiles[name], edges...)
+	}
+}
+
 // Users is a parsable slice of User.
 type Users []*User
@@ -6,7 +6,11 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"go-web/ent"
+	"sync/atomic"
+	"time"
 
+	"entgo.io/contrib/entgql"
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/introspection"
 	gqlparser "github.com/vektah/gqlparser/v2"
@@ -16,6 +20,7 @@ import (
 // NewExecutableSchema creates an ExecutableSchema from the ResolverRoot interface.
 func NewExecutableSchema(cfg Config) graphql.ExecutableSchema {
 	return &executableSchema{
+		schema:     cfg.Schema,
 		resolvers:  cfg.Resolvers,
 		directives: cfg.Directives,
 		complexity: cfg.Complexity,
@@ -23,64 +28,1011 @@ func NewExecutableSchema(cfg Config) graphql.ExecutableSchema {
 }
 
 type Config struct {
+	Schema     *ast.Schema
 	Resolvers  ResolverRoot
 	Directives DirectiveRoot
 	Complexity ComplexityRoot
 }
 
 type ResolverRoot interface {
+	DataExport() DataExportResolver
+	File() FileResolver
 	Mutation() MutationResolver
 	Query() QueryResolver
 }
 
 type DirectiveRoot struct {
+	HasPermission func(ctx context.Context, obj interface{}, next graphql.Resolver, permission string) (res interface{}, err error)
+	HasRole       func(ctx context.Context, obj interface{}, next graphql.Resolver, role string) (res interface{}, err error)
 }
 
 type ComplexityRoot struct {
+	APIKey struct {
+		CreatedAt  func(childComplexity int) int
+		ExpiresAt  func(childComplexity int) int
+		ID         func(childComplexity int) int
+		LastUsedAt func(childComplexity int) int
+		Name       func(childComplexity int) int
+		OwnerID    func(childComplexity int) int
+		Prefix     func(childComplexity int) int
+		RevokedAt  func(childComplexity int) int
+		Scopes     func(childComplexity int) int
+	}
+
+	AccountErasure struct {
+		CancelledAt  func(childComplexity int) int
+		CompletedAt  func(childComplexity int) int
+		CreatedAt    func(childComplexity int) int
+		ID           func(childComplexity int) int
+		ScheduledFor func(childComplexity int) int
+		StartedAt    func(childComplexity int) int
+		Status       func(childComplexity int) int
+		UserID       func(childComplexity int) int
+	}
+
+	AuditLog struct {
+		ActorID    func(childComplexity int) int
+		ActorType  func(childComplexity int) int
+		After      func(childComplexity int) int
+		Before     func(childComplexity int) int
+		CreatedAt  func(childComplexity int) int
+		EntityID   func(childComplexity int) int
+		EntityType func(childComplexity int) int
+		ID         func(childComplexity int) int
+		Operation  func(childComplexity int) int
+		RequestID  func(childComplexity int) int
+	}
+
+	AuditLogConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	AuditLogEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
+	AuthPayload struct {
+		AccessToken          func(childComplexity int) int
+		ChallengeToken       func(childComplexity int) int
+		ExpiresAt            func(childComplexity int) int
+		RefreshToken         func(childComplexity int) int
+		SecondFactorRequired func(childComplexity int) int
+		TokenType            func(childComplexity int) int
+	}
+
+	CreateAPIKeyPayload struct {
+		APIKey func(childComplexity int) int
+		Key    func(childComplexity int) int
+	}
+
+	DataExport struct {
+		CompletedAt func(childComplexity int) int
+		CreatedAt   func(childComplexity int) int
+		DownloadURL func(childComplexity int) int
+		Error       func(childComplexity int) int
+		ExpiresAt   func(childComplexity int) int
+		ID          func(childComplexity int) int
+		RequestedBy func(childComplexity int) int
+		Size        func(childComplexity int) int
+		Status      func(childComplexity int) int
+		UserID      func(childComplexity int) int
+	}
+
+	File struct {
+		Checksum    func(childComplexity int) int
+		ContentType func(childComplexity int) int
+		CreatedAt   func(childComplexity int) int
+		ID          func(childComplexity int) int
+		Name        func(childComplexity int) int
+		OwnerID     func(childComplexity int) int
+		Size        func(childComplexity int) int
+		URL         func(childComplexity int) int
+	}
+
 	Mutation struct {
-		UpdatePasswordByAccount func(childComplexity int, account string, password string) int
+		CancelAccountDeletion func(childComplexity int) int
+		ChangePassword        func(childComplexity int, currentPassword string, newPassword string) int
+		ConfirmTotp           func(childComplexity int, code string) int
+		CreateAPIKey          func(childComplexity int, name string, scopes []string, expiresAt *time.Time) int
+		CreateUser            func(childComplexity int, input ent.CreateUserInput) int
+		DeleteMyAccount       func(childComplexity int, password string) int
+		DeleteUser            func(childComplexity int, id uint64) int
+		DisableTotp           func(childComplexity int, code string) int
+		EnrollTotp            func(childComplexity int) int
+		ExportMyData          func(childComplexity int) int
+		ExportUserData        func(childComplexity int, userID uint64) int
+		Login                 func(childComplexity int, account string, password string) int
+		Logout                func(childComplexity int) int
+		LogoutAllSessions     func(childComplexity int) int
+		RefreshToken          func(childComplexity int, refreshToken string) int
+		RemoveAvatar          func(childComplexity int) int
+		RequestPasswordReset  func(childComplexity int, account string) int
+		ResetPassword         func(childComplexity int, token string, newPassword string) int
+		RestoreUser           func(childComplexity int, id uint64) int
+		RevokeAPIKey          func(childComplexity int, id uint64) int
+		SendVerificationEmail func(childComplexity int) int
+		UnlockAccount         func(childComplexity int, account string) int
+		UpdateEmail           func(childComplexity int, email string) int
+		UpdateUser            func(childComplexity int, id uint64, expectedVersion int, input ent.UpdateUserInput) int
+		UploadAvatar          func(childComplexity int, file graphql.Upload) int
+		VerifyEmail           func(childComplexity int, token string) int
+		VerifyTwoFactor       func(childComplexity int, challengeToken string, code string) int
+	}
+
+	PageInfo struct {
+		EndCursor       func(childComplexity int) int
+		HasNextPage     func(childComplexity int) int
+		HasPreviousPage func(childComplexity int) int
+		StartCursor     func(childComplexity int) int
 	}
 
 	Query struct {
-		UserByAccount func(childComplexity int, account string) int
+		APIKeys        func(childComplexity int) int
+		AccountErasure func(childComplexity int) int
+		AuditLogs      func(childComplexity int, after *entgql.Cursor[uint64], first *int, before *entgql.Cursor[uint64], last *int, orderBy *ent.AuditLogOrder, where *ent.AuditLogWhereInput) int
+		CurrentTenant  func(childComplexity int) int
+		DataExport     func(childComplexity int, id uint64) int
+		Node           func(childComplexity int, id uint64) int
+		Nodes          func(childComplexity int, ids []uint64) int
+		UserByAccount  func(childComplexity int, account string) int
+		Users          func(childComplexity int, after *entgql.Cursor[uint64], first *int, before *entgql.Cursor[uint64], last *int, orderBy []*ent.UserOrder, where *ent.UserWhereInput) int
+	}
+
+	Tenant struct {
+		CreatedAt func(childComplexity int) int
+		ID        func(childComplexity int) int
+		Name      func(childComplexity int) int
+		Slug      func(childComplexity int) int
+	}
+
+	TotpEnrollment struct {
+		Secret func(childComplexity int) int
+		URI    func(childComplexity int) int
 	}
 
 	User struct {
-		Account  func(childComplexity int) int
-		Age      func(childComplexity int) int
-		ID       func(childComplexity int) int
-		Name     func(childComplexity int) int
-		Password func(childComplexity int) int
-		Sex      func(childComplexity int) int
+		Account         func(childComplexity int) int
+		Age             func(childComplexity int) int
+		Avatar          func(childComplexity int) int
+		CreatedAt       func(childComplexity int) int
+		CreatedBy       func(childComplexity int) int
+		DeletedAt       func(childComplexity int) int
+		Email           func(childComplexity int) int
+		EmailVerifiedAt func(childComplexity int) int
+		ID              func(childComplexity int) int
+		Name            func(childComplexity int) int
+		Sex             func(childComplexity int) int
+		TotpEnabled     func(childComplexity int) int
+		UpdatedAt       func(childComplexity int) int
+		UpdatedBy       func(childComplexity int) int
+		Version         func(childComplexity int) int
+	}
+
+	UserConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	UserEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+}
+
+type executableSchema struct {
+	schema     *ast.Schema
+	resolvers  ResolverRoot
+	directives DirectiveRoot
+	complexity ComplexityRoot
+}
+
+func (e *executableSchema) Schema() *ast.Schema {
+	if e.schema != nil {
+		return e.schema
 	}
+	return parsedSchema
 }
 
-type executableSchema struct {
-	resolvers  ResolverRoot
-	directives DirectiveRoot
-	complexity ComplexityRoot
-}
+func (e *executableSchema) Complexity(typeName, field string, childComplexity int, rawArgs map[string]interface{}) (int, bool) {
+	ec := executionContext{nil, e, 0, 0, nil}
+	_ = ec
+	switch typeName + "." + field {
+
+	case "APIKey.createdAt":
+		if e.complexity.APIKey.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.APIKey.CreatedAt(childComplexity), true
+
+	case "APIKey.expiresAt":
+		if e.complexity.APIKey.ExpiresAt == nil {
+			break
+		}
+
+		return e.complexity.APIKey.ExpiresAt(childComplexity), true
+
+	case "APIKey.id":
+		if e.complexity.APIKey.ID == nil {
+			break
+		}
+
+		return e.complexity.APIKey.ID(childComplexity), true
+
+	case "APIKey.lastUsedAt":
+		if e.complexity.APIKey.LastUsedAt == nil {
+			break
+		}
+
+		return e.complexity.APIKey.LastUsedAt(childComplexity), true
+
+	case "APIKey.name":
+		if e.complexity.APIKey.Name == nil {
+			break
+		}
+
+		return e.complexity.APIKey.Name(childComplexity), true
+
+	case "APIKey.ownerID":
+		if e.complexity.APIKey.OwnerID == nil {
+			break
+		}
+
+		return e.complexity.APIKey.OwnerID(childComplexity), true
+
+	case "APIKey.prefix":
+		if e.complexity.APIKey.Prefix == nil {
+			break
+		}
+
+		return e.complexity.APIKey.Prefix(childComplexity), true
+
+	case "APIKey.revokedAt":
+		if e.complexity.APIKey.RevokedAt == nil {
+			break
+		}
+
+		return e.complexity.APIKey.RevokedAt(childComplexity), true
+
+	case "APIKey.scopes":
+		if e.complexity.APIKey.Scopes == nil {
+			break
+		}
+
+		return e.complexity.APIKey.Scopes(childComplexity), true
+
+	case "AccountErasure.cancelledAt":
+		if e.complexity.AccountErasure.CancelledAt == nil {
+			break
+		}
+
+		return e.complexity.AccountErasure.CancelledAt(childComplexity), true
+
+	case "AccountErasure.completedAt":
+		if e.complexity.AccountErasure.CompletedAt == nil {
+			break
+		}
+
+		return e.complexity.AccountErasure.CompletedAt(childComplexity), true
+
+	case "AccountErasure.createdAt":
+		if e.complexity.AccountErasure.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.AccountErasure.CreatedAt(childComplexity), true
+
+	case "AccountErasure.id":
+		if e.complexity.AccountErasure.ID == nil {
+			break
+		}
+
+		return e.complexity.AccountErasure.ID(childComplexity), true
+
+	case "AccountErasure.scheduledFor":
+		if e.complexity.AccountErasure.ScheduledFor == nil {
+			break
+		}
+
+		return e.complexity.AccountErasure.ScheduledFor(childComplexity), true
+
+	case "AccountErasure.startedAt":
+		if e.complexity.AccountErasure.StartedAt == nil {
+			break
+		}
+
+		return e.complexity.AccountErasure.StartedAt(childComplexity), true
+
+	case "AccountErasure.status":
+		if e.complexity.AccountErasure.Status == nil {
+			break
+		}
+
+		return e.complexity.AccountErasure.Status(childComplexity), true
+
+	case "AccountErasure.userID":
+		if e.complexity.AccountErasure.UserID == nil {
+			break
+		}
+
+		return e.complexity.AccountErasure.UserID(childComplexity), true
+
+	case "AuditLog.actorID":
+		if e.complexity.AuditLog.ActorID == nil {
+			break
+		}
+
+		return e.complexity.AuditLog.ActorID(childComplexity), true
+
+	case "AuditLog.actorType":
+		if e.complexity.AuditLog.ActorType == nil {
+			break
+		}
+
+		return e.complexity.AuditLog.ActorType(childComplexity), true
+
+	case "AuditLog.after":
+		if e.complexity.AuditLog.After == nil {
+			break
+		}
+
+		return e.complexity.AuditLog.After(childComplexity), true
+
+	case "AuditLog.before":
+		if e.complexity.AuditLog.Before == nil {
+			break
+		}
+
+		return e.complexity.AuditLog.Before(childComplexity), true
+
+	case "AuditLog.createdAt":
+		if e.complexity.AuditLog.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.AuditLog.CreatedAt(childComplexity), true
+
+	case "AuditLog.entityID":
+		if e.complexity.AuditLog.EntityID == nil {
+			break
+		}
+
+		return e.complexity.AuditLog.EntityID(childComplexity), true
+
+	case "AuditLog.entityType":
+		if e.complexity.AuditLog.EntityType == nil {
+			break
+		}
+
+		return e.complexity.AuditLog.EntityType(childComplexity), true
+
+	case "AuditLog.id":
+		if e.complexity.AuditLog.ID == nil {
+			break
+		}
+
+		return e.complexity.AuditLog.ID(childComplexity), true
+
+	case "AuditLog.operation":
+		if e.complexity.AuditLog.Operation == nil {
+			break
+		}
+
+		return e.complexity.AuditLog.Operation(childComplexity), true
+
+	case "AuditLog.requestID":
+		if e.complexity.AuditLog.RequestID == nil {
+			break
+		}
+
+		return e.complexity.AuditLog.RequestID(childComplexity), true
+
+	case "AuditLogConnection.edges":
+		if e.complexity.AuditLogConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.AuditLogConnection.Edges(childComplexity), true
+
+	case "AuditLogConnection.pageInfo":
+		if e.complexity.AuditLogConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.AuditLogConnection.PageInfo(childComplexity), true
+
+	case "AuditLogConnection.totalCount":
+		if e.complexity.AuditLogConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.AuditLogConnection.TotalCount(childComplexity), true
+
+	case "AuditLogEdge.cursor":
+		if e.complexity.AuditLogEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.AuditLogEdge.Cursor(childComplexity), true
+
+	case "AuditLogEdge.node":
+		if e.complexity.AuditLogEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.AuditLogEdge.Node(childComplexity), true
+
+	case "AuthPayload.accessToken":
+		if e.complexity.AuthPayload.AccessToken == nil {
+			break
+		}
+
+		return e.complexity.AuthPayload.AccessToken(childComplexity), true
+
+	case "AuthPayload.challengeToken":
+		if e.complexity.AuthPayload.ChallengeToken == nil {
+			break
+		}
+
+		return e.complexity.AuthPayload.ChallengeToken(childComplexity), true
+
+	case "AuthPayload.expiresAt":
+		if e.complexity.AuthPayload.ExpiresAt == nil {
+			break
+		}
+
+		return e.complexity.AuthPayload.ExpiresAt(childComplexity), true
+
+	case "AuthPayload.refreshToken":
+		if e.complexity.AuthPayload.RefreshToken == nil {
+			break
+		}
+
+		return e.complexity.AuthPayload.RefreshToken(childComplexity), true
+
+	case "AuthPayload.secondFactorRequired":
+		if e.complexity.AuthPayload.SecondFactorRequired == nil {
+			break
+		}
+
+		return e.complexity.AuthPayload.SecondFactorRequired(childComplexity), true
+
+	case "AuthPayload.tokenType":
+		if e.complexity.AuthPayload.TokenType == nil {
+			break
+		}
+
+		return e.complexity.AuthPayload.TokenType(childComplexity), true
+
+	case "CreateAPIKeyPayload.apiKey":
+		if e.complexity.CreateAPIKeyPayload.APIKey == nil {
+			break
+		}
+
+		return e.complexity.CreateAPIKeyPayload.APIKey(childComplexity), true
+
+	case "CreateAPIKeyPayload.key":
+		if e.complexity.CreateAPIKeyPayload.Key == nil {
+			break
+		}
+
+		return e.complexity.CreateAPIKeyPayload.Key(childComplexity), true
+
+	case "DataExport.completedAt":
+		if e.complexity.DataExport.CompletedAt == nil {
+			break
+		}
+
+		return e.complexity.DataExport.CompletedAt(childComplexity), true
+
+	case "DataExport.createdAt":
+		if e.complexity.DataExport.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.DataExport.CreatedAt(childComplexity), true
+
+	case "DataExport.downloadURL":
+		if e.complexity.DataExport.DownloadURL == nil {
+			break
+		}
+
+		return e.complexity.DataExport.DownloadURL(childComplexity), true
+
+	case "DataExport.error":
+		if e.complexity.DataExport.Error == nil {
+			break
+		}
+
+		return e.complexity.DataExport.Error(childComplexity), true
+
+	case "DataExport.expiresAt":
+		if e.complexity.DataExport.ExpiresAt == nil {
+			break
+		}
+
+		return e.complexity.DataExport.ExpiresAt(childComplexity), true
+
+	case "DataExport.id":
+		if e.complexity.DataExport.ID == nil {
+			break
+		}
+
+		return e.complexity.DataExport.ID(childComplexity), true
+
+	case "DataExport.requestedBy":
+		if e.complexity.DataExport.RequestedBy == nil {
+			break
+		}
+
+		return e.complexity.DataExport.RequestedBy(childComplexity), true
+
+	case "DataExport.size":
+		if e.complexity.DataExport.Size == nil {
+			break
+		}
+
+		return e.complexity.DataExport.Size(childComplexity), true
+
+	case "DataExport.status":
+		if e.complexity.DataExport.Status == nil {
+			break
+		}
+
+		return e.complexity.DataExport.Status(childComplexity), true
+
+	case "DataExport.userID":
+		if e.complexity.DataExport.UserID == nil {
+			break
+		}
+
+		return e.complexity.DataExport.UserID(childComplexity), true
+
+	case "File.checksum":
+		if e.complexity.File.Checksum == nil {
+			break
+		}
+
+		return e.complexity.File.Checksum(childComplexity), true
+
+	case "File.contentType":
+		if e.complexity.File.ContentType == nil {
+			break
+		}
+
+		return e.complexity.File.ContentType(childComplexity), true
+
+	case "File.createdAt":
+		if e.complexity.File.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.File.CreatedAt(childComplexity), true
+
+	case "File.id":
+		if e.complexity.File.ID == nil {
+			break
+		}
+
+		return e.complexity.File.ID(childComplexity), true
+
+	case "File.name":
+		if e.complexity.File.Name == nil {
+			break
+		}
+
+		return e.complexity.File.Name(childComplexity), true
+
+	case "File.ownerID":
+		if e.complexity.File.OwnerID == nil {
+			break
+		}
+
+		return e.complexity.File.OwnerID(childComplexity), true
+
+	case "File.size":
+		if e.complexity.File.Size == nil {
+			break
+		}
+
+		return e.complexity.File.Size(childComplexity), true
+
+	case "File.url":
+		if e.complexity.File.URL == nil {
+			break
+		}
+
+		return e.complexity.File.URL(childComplexity), true
+
+	case "Mutation.cancelAccountDeletion":
+		if e.complexity.Mutation.CancelAccountDeletion == nil {
+			break
+		}
+
+		return e.complexity.Mutation.CancelAccountDeletion(childComplexity), true
+
+	case "Mutation.changePassword":
+		if e.complexity.Mutation.ChangePassword == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_changePassword_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ChangePassword(childComplexity, args["currentPassword"].(string), args["newPassword"].(string)), true
+
+	case "Mutation.confirmTotp":
+		if e.complexity.Mutation.ConfirmTotp == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_confirmTotp_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ConfirmTotp(childComplexity, args["code"].(string)), true
+
+	case "Mutation.createAPIKey":
+		if e.complexity.Mutation.CreateAPIKey == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createAPIKey_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateAPIKey(childComplexity, args["name"].(string), args["scopes"].([]string), args["expiresAt"].(*time.Time)), true
+
+	case "Mutation.createUser":
+		if e.complexity.Mutation.CreateUser == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createUser_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateUser(childComplexity, args["input"].(ent.CreateUserInput)), true
+
+	case "Mutation.deleteMyAccount":
+		if e.complexity.Mutation.DeleteMyAccount == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteMyAccount_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteMyAccount(childComplexity, args["password"].(string)), true
+
+	case "Mutation.deleteUser":
+		if e.complexity.Mutation.DeleteUser == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteUser_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteUser(childComplexity, args["id"].(uint64)), true
+
+	case "Mutation.disableTotp":
+		if e.complexity.Mutation.DisableTotp == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_disableTotp_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DisableTotp(childComplexity, args["code"].(string)), true
+
+	case "Mutation.enrollTotp":
+		if e.complexity.Mutation.EnrollTotp == nil {
+			break
+		}
+
+		return e.complexity.Mutation.EnrollTotp(childComplexity), true
+
+	case "Mutation.exportMyData":
+		if e.complexity.Mutation.ExportMyData == nil {
+			break
+		}
+
+		return e.complexity.Mutation.ExportMyData(childComplexity), true
+
+	case "Mutation.exportUserData":
+		if e.complexity.Mutation.ExportUserData == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_exportUserData_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ExportUserData(childComplexity, args["userID"].(uint64)), true
+
+	case "Mutation.login":
+		if e.complexity.Mutation.Login == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_login_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.Login(childComplexity, args["account"].(string), args["password"].(string)), true
+
+	case "Mutation.logout":
+		if e.complexity.Mutation.Logout == nil {
+			break
+		}
+
+		return e.complexity.Mutation.Logout(childComplexity), true
+
+	case "Mutation.logoutAllSessions":
+		if e.complexity.Mutation.LogoutAllSessions == nil {
+			break
+		}
+
+		return e.complexity.Mutation.LogoutAllSessions(childComplexity), true
+
+	case "Mutation.refreshToken":
+		if e.complexity.Mutation.RefreshToken == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_refreshToken_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RefreshToken(childComplexity, args["refreshToken"].(string)), true
+
+	case "Mutation.removeAvatar":
+		if e.complexity.Mutation.RemoveAvatar == nil {
+			break
+		}
+
+		return e.complexity.Mutation.RemoveAvatar(childComplexity), true
+
+	case "Mutation.requestPasswordReset":
+		if e.complexity.Mutation.RequestPasswordReset == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_requestPasswordReset_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RequestPasswordReset(childComplexity, args["account"].(string)), true
+
+	case "Mutation.resetPassword":
+		if e.complexity.Mutation.ResetPassword == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_resetPassword_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ResetPassword(childComplexity, args["token"].(string), args["newPassword"].(string)), true
+
+	case "Mutation.restoreUser":
+		if e.complexity.Mutation.RestoreUser == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_restoreUser_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RestoreUser(childComplexity, args["id"].(uint64)), true
+
+	case "Mutation.revokeAPIKey":
+		if e.complexity.Mutation.RevokeAPIKey == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_revokeAPIKey_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RevokeAPIKey(childComplexity, args["id"].(uint64)), true
+
+	case "Mutation.sendVerificationEmail":
+		if e.complexity.Mutation.SendVerificationEmail == nil {
+			break
+		}
+
+		return e.complexity.Mutation.SendVerificationEmail(childComplexity), true
+
+	case "Mutation.unlockAccount":
+		if e.complexity.Mutation.UnlockAccount == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_unlockAccount_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UnlockAccount(childComplexity, args["account"].(string)), true
+
+	case "Mutation.updateEmail":
+		if e.complexity.Mutation.UpdateEmail == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateEmail_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateEmail(childComplexity, args["email"].(string)), true
+
+	case "Mutation.updateUser":
+		if e.complexity.Mutation.UpdateUser == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateUser_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateUser(childComplexity, args["id"].(uint64), args["expectedVersion"].(int), args["input"].(ent.UpdateUserInput)), true
+
+	case "Mutation.uploadAvatar":
+		if e.complexity.Mutation.UploadAvatar == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_uploadAvatar_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UploadAvatar(childComplexity, args["file"].(graphql.Upload)), true
+
+	case "Mutation.verifyEmail":
+		if e.complexity.Mutation.VerifyEmail == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_verifyEmail_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.VerifyEmail(childComplexity, args["token"].(string)), true
+
+	case "Mutation.verifyTwoFactor":
+		if e.complexity.Mutation.VerifyTwoFactor == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_verifyTwoFactor_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.VerifyTwoFactor(childComplexity, args["challengeToken"].(string), args["code"].(string)), true
+
+	case "PageInfo.endCursor":
+		if e.complexity.PageInfo.EndCursor == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.EndCursor(childComplexity), true
+
+	case "PageInfo.hasNextPage":
+		if e.complexity.PageInfo.HasNextPage == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.HasNextPage(childComplexity), true
+
+	case "PageInfo.hasPreviousPage":
+		if e.complexity.PageInfo.HasPreviousPage == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.HasPreviousPage(childComplexity), true
+
+	case "PageInfo.startCursor":
+		if e.complexity.PageInfo.StartCursor == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.StartCursor(childComplexity), true
+
+	case "Query.apiKeys":
+		if e.complexity.Query.APIKeys == nil {
+			break
+		}
+
+		return e.complexity.Query.APIKeys(childComplexity), true
+
+	case "Query.accountErasure":
+		if e.complexity.Query.AccountErasure == nil {
+			break
+		}
+
+		return e.complexity.Query.AccountErasure(childComplexity), true
+
+	case "Query.auditLogs":
+		if e.complexity.Query.AuditLogs == nil {
+			break
+		}
+
+		args, err := ec.field_Query_auditLogs_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.AuditLogs(childComplexity, args["after"].(*entgql.Cursor[uint64]), args["first"].(*int), args["before"].(*entgql.Cursor[uint64]), args["last"].(*int), args["orderBy"].(*ent.AuditLogOrder), args["where"].(*ent.AuditLogWhereInput)), true
+
+	case "Query.currentTenant":
+		if e.complexity.Query.CurrentTenant == nil {
+			break
+		}
+
+		return e.complexity.Query.CurrentTenant(childComplexity), true
+
+	case "Query.dataExport":
+		if e.complexity.Query.DataExport == nil {
+			break
+		}
+
+		args, err := ec.field_Query_dataExport_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.DataExport(childComplexity, args["id"].(uint64)), true
 
-func (e *executableSchema) Schema() *ast.Schema {
-	return parsedSchema
-}
+	case "Query.node":
+		if e.complexity.Query.Node == nil {
+			break
+		}
 
-func (e *executableSchema) Complexity(typeName, field string, childComplexity int, rawArgs map[string]interface{}) (int, bool) {
-	ec := executionContext{nil, e}
-	_ = ec
-	switch typeName + "." + field {
+		args, err := ec.field_Query_node_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Node(childComplexity, args["id"].(uint64)), true
 
-	case "Mutation.updatePasswordByAccount":
-		if e.complexity.Mutation.UpdatePasswordByAccount == nil {
+	case "Query.nodes":
+		if e.complexity.Query.Nodes == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_updatePasswordByAccount_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_nodes_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.UpdatePasswordByAccount(childComplexity, args["account"].(string), args["password"].(string)), true
+		return e.complexity.Query.Nodes(childComplexity, args["ids"].([]uint64)), true
 
 	case "Query.userByAccount":
 		if e.complexity.Query.UserByAccount == nil {
@@ -94,6 +1046,60 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.UserByAccount(childComplexity, args["account"].(string)), true
 
+	case "Query.users":
+		if e.complexity.Query.Users == nil {
+			break
+		}
+
+		args, err := ec.field_Query_users_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Users(childComplexity, args["after"].(*entgql.Cursor[uint64]), args["first"].(*int), args["before"].(*entgql.Cursor[uint64]), args["last"].(*int), args["orderBy"].([]*ent.UserOrder), args["where"].(*ent.UserWhereInput)), true
+
+	case "Tenant.createdAt":
+		if e.complexity.Tenant.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.Tenant.CreatedAt(childComplexity), true
+
+	case "Tenant.id":
+		if e.complexity.Tenant.ID == nil {
+			break
+		}
+
+		return e.complexity.Tenant.ID(childComplexity), true
+
+	case "Tenant.name":
+		if e.complexity.Tenant.Name == nil {
+			break
+		}
+
+		return e.complexity.Tenant.Name(childComplexity), true
+
+	case "Tenant.slug":
+		if e.complexity.Tenant.Slug == nil {
+			break
+		}
+
+		return e.complexity.Tenant.Slug(childComplexity), true
+
+	case "TotpEnrollment.secret":
+		if e.complexity.TotpEnrollment.Secret == nil {
+			break
+		}
+
+		return e.complexity.TotpEnrollment.Secret(childComplexity), true
+
+	case "TotpEnrollment.uri":
+		if e.complexity.TotpEnrollment.URI == nil {
+			break
+		}
+
+		return e.complexity.TotpEnrollment.URI(childComplexity), true
+
 	case "User.Account":
 		if e.complexity.User.Account == nil {
 			break
@@ -108,6 +1114,48 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.User.Age(childComplexity), true
 
+	case "User.avatar":
+		if e.complexity.User.Avatar == nil {
+			break
+		}
+
+		return e.complexity.User.Avatar(childComplexity), true
+
+	case "User.createdAt":
+		if e.complexity.User.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.User.CreatedAt(childComplexity), true
+
+	case "User.createdBy":
+		if e.complexity.User.CreatedBy == nil {
+			break
+		}
+
+		return e.complexity.User.CreatedBy(childComplexity), true
+
+	case "User.deletedAt":
+		if e.complexity.User.DeletedAt == nil {
+			break
+		}
+
+		return e.complexity.User.DeletedAt(childComplexity), true
+
+	case "User.email":
+		if e.complexity.User.Email == nil {
+			break
+		}
+
+		return e.complexity.User.Email(childComplexity), true
+
+	case "User.emailVerifiedAt":
+		if e.complexity.User.EmailVerifiedAt == nil {
+			break
+		}
+
+		return e.complexity.User.EmailVerifiedAt(childComplexity), true
+
 	case "User.id":
 		if e.complexity.User.ID == nil {
 			break
@@ -122,19 +1170,75 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.User.Name(childComplexity), true
 
-	case "User.Password":
-		if e.complexity.User.Password == nil {
+	case "User.sex":
+		if e.complexity.User.Sex == nil {
 			break
 		}
 
-		return e.complexity.User.Password(childComplexity), true
+		return e.complexity.User.Sex(childComplexity), true
 
-	case "User.sex":
-		if e.complexity.User.Sex == nil {
+	case "User.totpEnabled":
+		if e.complexity.User.TotpEnabled == nil {
 			break
 		}
 
-		return e.complexity.User.Sex(childComplexity), true
+		return e.complexity.User.TotpEnabled(childComplexity), true
+
+	case "User.updatedAt":
+		if e.complexity.User.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.User.UpdatedAt(childComplexity), true
+
+	case "User.updatedBy":
+		if e.complexity.User.UpdatedBy == nil {
+			break
+		}
+
+		return e.complexity.User.UpdatedBy(childComplexity), true
+
+	case "User.version":
+		if e.complexity.User.Version == nil {
+			break
+		}
+
+		return e.complexity.User.Version(childComplexity), true
+
+	case "UserConnection.edges":
+		if e.complexity.UserConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.UserConnection.Edges(childComplexity), true
+
+	case "UserConnection.pageInfo":
+		if e.complexity.UserConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.UserConnection.PageInfo(childComplexity), true
+
+	case "UserConnection.totalCount":
+		if e.complexity.UserConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.UserConnection.TotalCount(childComplexity), true
+
+	case "UserEdge.cursor":
+		if e.complexity.UserEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.UserEdge.Cursor(childComplexity), true
+
+	case "UserEdge.node":
+		if e.complexity.UserEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.UserEdge.Node(childComplexity), true
 
 	}
 	return 0, false
@@ -142,25 +1246,55 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 	rc := graphql.GetOperationContext(ctx)
-	ec := executionContext{rc, e}
-	inputUnmarshalMap := graphql.BuildUnmarshalerMap()
+	ec := executionContext{rc, e, 0, 0, make(chan graphql.DeferredResult)}
+	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
+		ec.unmarshalInputAPIKeyWhereInput,
+		ec.unmarshalInputAccountErasureWhereInput,
+		ec.unmarshalInputAuditLogOrder,
+		ec.unmarshalInputAuditLogWhereInput,
+		ec.unmarshalInputCreateUserInput,
+		ec.unmarshalInputDataExportWhereInput,
+		ec.unmarshalInputFileWhereInput,
+		ec.unmarshalInputIdentityWhereInput,
+		ec.unmarshalInputPermissionWhereInput,
+		ec.unmarshalInputRoleWhereInput,
+		ec.unmarshalInputTenantWhereInput,
+		ec.unmarshalInputUpdateUserInput,
+		ec.unmarshalInputUserOrder,
+		ec.unmarshalInputUserWhereInput,
+	)
 	first := true
 
 	switch rc.Operation.Operation {
 	case ast.Query:
 		return func(ctx context.Context) *graphql.Response {
-			if !first {
-				return nil
+			var response graphql.Response
+			var data graphql.Marshaler
+			if first {
+				first = false
+				ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
+				data = ec._Query(ctx, rc.Operation.SelectionSet)
+			} else {
+				if atomic.LoadInt32(&ec.pendingDeferred) > 0 {
+					result := <-ec.deferredResults
+					atomic.AddInt32(&ec.pendingDeferred, -1)
+					data = result.Result
+					response.Path = result.Path
+					response.Label = result.Label
+					response.Errors = result.Errors
+				} else {
+					return nil
+				}
 			}
-			first = false
-			ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
-			data := ec._Query(ctx, rc.Operation.SelectionSet)
 			var buf bytes.Buffer
 			data.MarshalGQL(&buf)
-
-			return &graphql.Response{
-				Data: buf.Bytes(),
+			response.Data = buf.Bytes()
+			if atomic.LoadInt32(&ec.deferred) > 0 {
+				hasNext := atomic.LoadInt32(&ec.pendingDeferred) > 0
+				response.HasNext = &hasNext
 			}
+
+			return &response
 		}
 	case ast.Mutation:
 		return func(ctx context.Context) *graphql.Response {
@@ -186,23 +1320,277 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 type executionContext struct {
 	*graphql.OperationContext
 	*executableSchema
+	deferred        int32
+	pendingDeferred int32
+	deferredResults chan graphql.DeferredResult
+}
+
+func (ec *executionContext) processDeferredGroup(dg graphql.DeferredGroup) {
+	atomic.AddInt32(&ec.pendingDeferred, 1)
+	go func() {
+		ctx := graphql.WithFreshResponseContext(dg.Context)
+		dg.FieldSet.Dispatch(ctx)
+		ds := graphql.DeferredResult{
+			Path:   dg.Path,
+			Label:  dg.Label,
+			Result: dg.FieldSet,
+			Errors: graphql.GetErrors(ctx),
+		}
+		// null fields should bubble up
+		if dg.FieldSet.Invalids > 0 {
+			ds.Result = graphql.Null
+		}
+		ec.deferredResults <- ds
+	}()
 }
 
 func (ec *executionContext) introspectSchema() (*introspection.Schema, error) {
 	if ec.DisableIntrospection {
 		return nil, errors.New("introspection disabled")
 	}
-	return introspection.WrapSchema(parsedSchema), nil
+	return introspection.WrapSchema(ec.Schema()), nil
 }
 
 func (ec *executionContext) introspectType(name string) (*introspection.Type, error) {
 	if ec.DisableIntrospection {
 		return nil, errors.New("introspection disabled")
 	}
-	return introspection.WrapTypeFromDef(parsedSchema, parsedSchema.Types[name]), nil
+	return introspection.WrapTypeFromDef(ec.Schema(), ec.Schema().Types[name]), nil
 }
 
 var sources = []*ast.Source{
+	{Name: "../accounterasure.graphql", Input: `"""
+A scheduled deletion of the viewer's account. When the grace period ends the
+account is anonymized, credentials and personal data are deleted and audit
+logs are kept with a pseudonymous user ID.
+"""
+type AccountErasure implements Node {
+    id: ID!
+    userID: ID!
+    status: AccountErasureStatus!
+    "when the account is deleted, the deletion can be cancelled until then"
+    scheduledFor: Time!
+    startedAt: Time
+    completedAt: Time
+    cancelledAt: Time
+    createdAt: Time!
+}
+
+enum AccountErasureStatus {
+    scheduled
+    running
+    completed
+    cancelled
+}
+
+extend type Query {
+    "the scheduled deletion of the viewer's account, null when none is scheduled"
+    accountErasure: AccountErasure
+}
+
+extend type Mutation {
+    "schedule the deletion of the viewer's account after the grace period. Returns the scheduled deletion when there is one"
+    deleteMyAccount(password: String!): AccountErasure!
+    "cancel the scheduled deletion of the viewer's account"
+    cancelAccountDeletion: AccountErasure!
+}
+`, BuiltIn: false},
+	{Name: "../apikey.graphql", Input: `"""API key used by backend services to call the API without a user login."""
+type APIKey implements Node {
+    id: ID!
+    name: String!
+    "public part of the key, shown to identify it"
+    prefix: String!
+    "permissions granted to requests using this key"
+    scopes: [String!]!
+    ownerID: ID!
+    expiresAt: Time
+    lastUsedAt: Time
+    revokedAt: Time
+    createdAt: Time!
+}
+
+"""A newly created API key. The plaintext key is only returned once."""
+type CreateAPIKeyPayload {
+    apiKey: APIKey!
+    key: String!
+}
+
+extend type Query {
+    "list the viewer's api keys, admins see every key"
+    apiKeys: [APIKey!]!
+}
+
+extend type Mutation {
+    "create an api key whose scopes are a subset of the viewer's permissions"
+    createAPIKey(name: String!, scopes: [String!]!, expiresAt: Time): CreateAPIKeyPayload!
+    "revoke an api key"
+    revokeAPIKey(id: ID!): Boolean!
+}
+`, BuiltIn: false},
+	{Name: "../auditlog.graphql", Input: `"""A record of a create, update or delete of an entity."""
+type AuditLog implements Node {
+    id: ID!
+    actorType: AuditLogActorType!
+    "the acting user, null for system or anonymous changes"
+    actorID: ID
+    requestID: String
+    entityType: String!
+    entityID: ID!
+    operation: AuditLogOperation!
+    "changed fields before the change, sensitive fields are redacted"
+    before: Map
+    "changed fields after the change, sensitive fields are redacted"
+    after: Map
+    createdAt: Time!
+}
+
+"""Who made an audited change."""
+enum AuditLogActorType {
+    user
+    system
+    anonymous
+}
+
+enum AuditLogOperation {
+    create
+    update
+    delete
+}
+
+type AuditLogEdge {
+    node: AuditLog
+    cursor: Cursor!
+}
+
+type AuditLogConnection {
+    edges: [AuditLogEdge]
+    pageInfo: PageInfo!
+    totalCount: Int!
+}
+
+enum AuditLogOrderField {
+    CREATED_AT
+}
+
+input AuditLogOrder {
+    direction: OrderDirection! = DESC
+    field: AuditLogOrderField!
+}
+
+extend type Query {
+    "list audit log records, newest first unless ordered otherwise. Pages hold at most 100 records, 20 when neither first nor last is given"
+    auditLogs(
+        after: Cursor
+        first: Int
+        before: Cursor
+        last: Int
+        orderBy: AuditLogOrder
+        where: AuditLogWhereInput
+    ): AuditLogConnection! @hasRole(role: "admin")
+}
+`, BuiltIn: false},
+	{Name: "../auth.graphql", Input: `"""
+Credentials issued after a successful login. In session mode the session ID is
+only sent as an HttpOnly cookie, so accessToken and refreshToken are null.
+
+When the user has two-factor authentication enabled, login only returns a
+challengeToken with secondFactorRequired set. No credentials are issued until
+verifyTwoFactor succeeds. expiresAt is then the expiry of the challenge.
+"""
+type AuthPayload {
+    accessToken: String
+    refreshToken: String
+    tokenType: String!
+    expiresAt: Time!
+    secondFactorRequired: Boolean!
+    challengeToken: String
+}
+
+extend type Mutation {
+    "login with account and password"
+    login(account: String!, password: String!): AuthPayload!
+    "complete a login that requires a second factor with a TOTP or recovery code"
+    verifyTwoFactor(challengeToken: String!, code: String!): AuthPayload!
+    "exchange a single-use refresh token for a new token pair (jwt mode only)"
+    refreshToken(refreshToken: String!): AuthPayload!
+    "revoke the current session"
+    logout: Boolean!
+    "revoke every session of the current user"
+    logoutAllSessions: Boolean!
+    "clear failed login attempts and lift the lockout of an account"
+    unlockAccount(account: String!): Boolean! @hasRole(role: "admin")
+}
+`, BuiltIn: false},
+	{Name: "../dataexport.graphql", Input: `"""
+A personal data export. The archive is generated in the background, poll the
+export until its status is completed and download it from downloadURL.
+"""
+type DataExport implements Node {
+    id: ID!
+    "the user whose data is exported"
+    userID: ID!
+    "the user who requested the export, differs from userID when an admin exported the data"
+    requestedBy: ID
+    status: DataExportStatus!
+    "why the export failed"
+    error: String
+    "archive size in bytes"
+    size: Int
+    createdAt: Time!
+    completedAt: Time
+    "when the archive is deleted"
+    expiresAt: Time
+    "signed link to download the archive without logging in, null until the export is completed or after the archive expired. Every read returns a new link that expires after a while"
+    downloadURL: String
+}
+
+enum DataExportStatus {
+    pending
+    running
+    completed
+    failed
+}
+
+extend type Query {
+    "a data export of the viewer, admins can read any export"
+    dataExport(id: ID!): DataExport
+}
+
+extend type Mutation {
+    "export all personal data of the viewer into a JSON archive. Returns the export in progress when there is one"
+    exportMyData: DataExport!
+    "export all personal data of a user into a JSON archive on their behalf"
+    exportUserData(userID: ID!): DataExport! @hasRole(role: "admin")
+}
+`, BuiltIn: false},
+	{Name: "../file.graphql", Input: `"""
+An uploaded file. The content is read from url, which works without
+credentials so it can be used directly in an <img> tag.
+"""
+type File implements Node {
+    id: ID!
+    name: String!
+    "size in bytes"
+    size: Int!
+    "detected from the content, not taken from the client"
+    contentType: String!
+    "hex encoded SHA-256 of the content"
+    checksum: String!
+    "the user who uploaded the file"
+    ownerID: ID!
+    createdAt: Time!
+    "signed link to the content, every read returns a new link that expires after a while"
+    url: String!
+}
+
+extend type Mutation {
+    "upload an avatar for the viewer, replacing the current one. Size and type limits are set in the server configuration"
+    uploadAvatar(file: Upload!): User!
+    "remove the viewer's avatar"
+    removeAvatar: User!
+}
+`, BuiltIn: false},
 	{Name: "../schema.graphql", Input: `"""Maps a Time GraphQL scalar to a Go time.Time struct."""
 scalar Time
 
@@ -212,26 +1600,1134 @@ https://relay.dev/graphql/connections.htm#sec-Cursor
 """
 scalar Cursor
 
-type Query
+"""Arbitrary JSON object."""
+scalar Map
+
+"""A file sent as part of a GraphQL multipart request."""
+scalar Upload
+
+"""
+An object with a globally unique ID.
+https://relay.dev/graphql/objectidentification.htm
+"""
+interface Node {
+    "the ID of the object"
+    id: ID!
+}
+
+"""
+Information about pagination in a connection.
+https://relay.dev/graphql/connections.htm#sec-undefined.PageInfo
+"""
+type PageInfo {
+    hasNextPage: Boolean!
+    hasPreviousPage: Boolean!
+    startCursor: Cursor
+    endCursor: Cursor
+}
+
+"""Possible directions in which to order a list of items when provided an ` + "`" + `orderBy` + "`" + ` argument."""
+enum OrderDirection {
+    ASC
+    DESC
+}
+
+"""Requires the viewer to have the given role."""
+directive @hasRole(role: String!) on FIELD_DEFINITION
+
+"""Requires the viewer to have the given permission. Admins have every permission."""
+directive @hasPermission(permission: String!) on FIELD_DEFINITION
+
+type Query {
+    "fetch an object by its ID, null when it does not exist or is not visible to the viewer"
+    node(id: ID!): Node
+    "fetch objects by their IDs, at most 100 at a time"
+    nodes(ids: [ID!]!): [Node]!
+}
 
 type Mutation`, BuiltIn: false},
-	{Name: "../user.graphql", Input: `type User {
+	{Name: "../tenant.graphql", Input: `"""A tenant, every user and its data belongs to exactly one tenant."""
+type Tenant implements Node {
+    id: ID!
+    name: String!
+    "identifier used in the X-Tenant-ID header and as subdomain"
+    slug: String!
+    createdAt: Time!
+}
+
+extend type Query {
+    "the tenant the request is scoped to"
+    currentTenant: Tenant!
+}
+`, BuiltIn: false},
+	{Name: "../twofactor.graphql", Input: `"""Pending TOTP enrollment. Add the secret to an authenticator app, then confirm with a code."""
+type TotpEnrollment {
+    secret: String!
+    "otpauth:// URI for QR codes"
+    uri: String!
+}
+
+extend type Mutation {
+    "start two-factor enrollment for the viewer"
+    enrollTotp: TotpEnrollment!
+    "confirm enrollment with a code from the authenticator app, returns recovery codes shown only once"
+    confirmTotp(code: String!): [String!]!
+    "disable two-factor authentication with a TOTP or recovery code"
+    disableTotp(code: String!): Boolean!
+}
+`, BuiltIn: false},
+	{Name: "../user.graphql", Input: `type User implements Node {
     id: ID!
     name: String!
     sex: Boolean!
     age: Int!
     Account: String!
-    Password: String!
+    email: String
+    emailVerifiedAt: Time
+    "set when the user is soft deleted"
+    deletedAt: Time
+    totpEnabled: Boolean!
+    "null when the user has not uploaded an avatar"
+    avatar: File
+    createdAt: Time!
+    updatedAt: Time!
+    "the user who created this user, null when created by the system"
+    createdBy: ID
+    "the user who last updated this user, null when updated by the system"
+    updatedBy: ID
+    "incremented on every update, pass it back as expectedVersion to detect concurrent edits"
+    version: Int!
+}
+
+"""Properties by which user connections can be ordered."""
+enum UserOrderField {
+    NAME
+    AGE
+    ACCOUNT
+    CREATED_AT
+    UPDATED_AT
+}
+
+"""Ordering options for user connections."""
+input UserOrder {
+    direction: OrderDirection! = ASC
+    field: UserOrderField!
+}
+
+type UserEdge {
+    node: User
+    cursor: Cursor!
+}
+
+type UserConnection {
+    edges: [UserEdge]
+    pageInfo: PageInfo!
+    totalCount: Int!
 }
 
 extend type Query {
+    "list users, viewers without user:read only see themselves. Pages hold at most 100 users, 20 when neither first nor last is given"
+    users(
+        after: Cursor
+        first: Int
+        before: Cursor
+        last: Int
+        "sort keys applied in order, ties are broken by ID"
+        orderBy: [UserOrder!]
+        where: UserWhereInput
+    ): UserConnection!
     "find user by account"
-    userByAccount(account: String!): User!
+    userByAccount(account: String!): User! @hasPermission(permission: "user:read")
 }
 
 extend type Mutation {
-    "update user account password"
-    updatePasswordByAccount(account: String!, password: String!): Boolean!
+    "send a single-use password reset link for the account, always returns true"
+    requestPasswordReset(account: String!): Boolean!
+    "set a new password with a reset token, all sessions of the user are revoked"
+    resetPassword(token: String!, newPassword: String!): Boolean!
+    "change the viewer's password, all sessions of the user are revoked"
+    changePassword(currentPassword: String!, newPassword: String!): Boolean!
+    "set the viewer's email, the email must be verified again and a verification link is sent to it"
+    updateEmail(email: String!): User!
+    "resend the verification link to the viewer's unverified email"
+    sendVerificationEmail: Boolean!
+    "mark the email as verified with a verification token, the email:verified permission applies after the next login or token refresh"
+    verifyEmail(token: String!): User!
+    "create a user in the current tenant"
+    createUser(input: CreateUserInput!): User! @hasPermission(permission: "user:write")
+    "update a user, fails with a version conflict if the user changed since expectedVersion was read"
+    updateUser(id: ID!, expectedVersion: Int!, input: UpdateUserInput!): User! @hasPermission(permission: "user:write")
+    "soft delete a user, it can be brought back with restoreUser"
+    deleteUser(id: ID!): Boolean! @hasPermission(permission: "user:write")
+    "restore a soft deleted user"
+    restoreUser(id: ID!): User! @hasPermission(permission: "user:write")
 }`, BuiltIn: false},
+	{Name: "../generate/ent.graphql", Input: `"""
+APIKeyWhereInput is used for filtering APIKey objects.
+Input was generated by ent.
+"""
+input APIKeyWhereInput {
+  not: APIKeyWhereInput
+  and: [APIKeyWhereInput!]
+  or: [APIKeyWhereInput!]
+  """id field predicates"""
+  id: ID
+  idNEQ: ID
+  idIn: [ID!]
+  idNotIn: [ID!]
+  idGT: ID
+  idGTE: ID
+  idLT: ID
+  idLTE: ID
+  """tenant_id field predicates"""
+  tenantID: ID
+  tenantIDNEQ: ID
+  tenantIDIn: [ID!]
+  tenantIDNotIn: [ID!]
+  tenantIDGT: ID
+  tenantIDGTE: ID
+  tenantIDLT: ID
+  tenantIDLTE: ID
+  """name field predicates"""
+  name: String
+  nameNEQ: String
+  nameIn: [String!]
+  nameNotIn: [String!]
+  nameGT: String
+  nameGTE: String
+  nameLT: String
+  nameLTE: String
+  nameContains: String
+  nameHasPrefix: String
+  nameHasSuffix: String
+  nameEqualFold: String
+  nameContainsFold: String
+  """prefix field predicates"""
+  prefix: String
+  prefixNEQ: String
+  prefixIn: [String!]
+  prefixNotIn: [String!]
+  prefixGT: String
+  prefixGTE: String
+  prefixLT: String
+  prefixLTE: String
+  prefixContains: String
+  prefixHasPrefix: String
+  prefixHasSuffix: String
+  prefixEqualFold: String
+  prefixContainsFold: String
+  """owner_id field predicates"""
+  ownerID: ID
+  ownerIDNEQ: ID
+  ownerIDIn: [ID!]
+  ownerIDNotIn: [ID!]
+  """expires_at field predicates"""
+  expiresAt: Time
+  expiresAtNEQ: Time
+  expiresAtIn: [Time!]
+  expiresAtNotIn: [Time!]
+  expiresAtGT: Time
+  expiresAtGTE: Time
+  expiresAtLT: Time
+  expiresAtLTE: Time
+  expiresAtIsNil: Boolean
+  expiresAtNotNil: Boolean
+  """last_used_at field predicates"""
+  lastUsedAt: Time
+  lastUsedAtNEQ: Time
+  lastUsedAtIn: [Time!]
+  lastUsedAtNotIn: [Time!]
+  lastUsedAtGT: Time
+  lastUsedAtGTE: Time
+  lastUsedAtLT: Time
+  lastUsedAtLTE: Time
+  lastUsedAtIsNil: Boolean
+  lastUsedAtNotNil: Boolean
+  """revoked_at field predicates"""
+  revokedAt: Time
+  revokedAtNEQ: Time
+  revokedAtIn: [Time!]
+  revokedAtNotIn: [Time!]
+  revokedAtGT: Time
+  revokedAtGTE: Time
+  revokedAtLT: Time
+  revokedAtLTE: Time
+  revokedAtIsNil: Boolean
+  revokedAtNotNil: Boolean
+  """created_at field predicates"""
+  createdAt: Time
+  createdAtNEQ: Time
+  createdAtIn: [Time!]
+  createdAtNotIn: [Time!]
+  createdAtGT: Time
+  createdAtGTE: Time
+  createdAtLT: Time
+  createdAtLTE: Time
+  """owner edge predicates"""
+  hasOwner: Boolean
+  hasOwnerWith: [UserWhereInput!]
+}
+"""
+AccountErasureWhereInput is used for filtering AccountErasure objects.
+Input was generated by ent.
+"""
+input AccountErasureWhereInput {
+  not: AccountErasureWhereInput
+  and: [AccountErasureWhereInput!]
+  or: [AccountErasureWhereInput!]
+  """id field predicates"""
+  id: ID
+  idNEQ: ID
+  idIn: [ID!]
+  idNotIn: [ID!]
+  idGT: ID
+  idGTE: ID
+  idLT: ID
+  idLTE: ID
+  """tenant_id field predicates"""
+  tenantID: ID
+  tenantIDNEQ: ID
+  tenantIDIn: [ID!]
+  tenantIDNotIn: [ID!]
+  tenantIDGT: ID
+  tenantIDGTE: ID
+  tenantIDLT: ID
+  tenantIDLTE: ID
+  """user_id field predicates"""
+  userID: ID
+  userIDNEQ: ID
+  userIDIn: [ID!]
+  userIDNotIn: [ID!]
+  userIDGT: ID
+  userIDGTE: ID
+  userIDLT: ID
+  userIDLTE: ID
+  """status field predicates"""
+  status: AccountErasureStatus
+  statusNEQ: AccountErasureStatus
+  statusIn: [AccountErasureStatus!]
+  statusNotIn: [AccountErasureStatus!]
+  """scheduled_for field predicates"""
+  scheduledFor: Time
+  scheduledForNEQ: Time
+  scheduledForIn: [Time!]
+  scheduledForNotIn: [Time!]
+  scheduledForGT: Time
+  scheduledForGTE: Time
+  scheduledForLT: Time
+  scheduledForLTE: Time
+  """started_at field predicates"""
+  startedAt: Time
+  startedAtNEQ: Time
+  startedAtIn: [Time!]
+  startedAtNotIn: [Time!]
+  startedAtGT: Time
+  startedAtGTE: Time
+  startedAtLT: Time
+  startedAtLTE: Time
+  startedAtIsNil: Boolean
+  startedAtNotNil: Boolean
+  """completed_at field predicates"""
+  completedAt: Time
+  completedAtNEQ: Time
+  completedAtIn: [Time!]
+  completedAtNotIn: [Time!]
+  completedAtGT: Time
+  completedAtGTE: Time
+  completedAtLT: Time
+  completedAtLTE: Time
+  completedAtIsNil: Boolean
+  completedAtNotNil: Boolean
+  """cancelled_at field predicates"""
+  cancelledAt: Time
+  cancelledAtNEQ: Time
+  cancelledAtIn: [Time!]
+  cancelledAtNotIn: [Time!]
+  cancelledAtGT: Time
+  cancelledAtGTE: Time
+  cancelledAtLT: Time
+  cancelledAtLTE: Time
+  cancelledAtIsNil: Boolean
+  cancelledAtNotNil: Boolean
+  """created_at field predicates"""
+  createdAt: Time
+  createdAtNEQ: Time
+  createdAtIn: [Time!]
+  createdAtNotIn: [Time!]
+  createdAtGT: Time
+  createdAtGTE: Time
+  createdAtLT: Time
+  createdAtLTE: Time
+}
+"""
+AuditLogWhereInput is used for filtering AuditLog objects.
+Input was generated by ent.
+"""
+input AuditLogWhereInput {
+  not: AuditLogWhereInput
+  and: [AuditLogWhereInput!]
+  or: [AuditLogWhereInput!]
+  """id field predicates"""
+  id: ID
+  idNEQ: ID
+  idIn: [ID!]
+  idNotIn: [ID!]
+  idGT: ID
+  idGTE: ID
+  idLT: ID
+  idLTE: ID
+  """tenant_id field predicates"""
+  tenantID: ID
+  tenantIDNEQ: ID
+  tenantIDIn: [ID!]
+  tenantIDNotIn: [ID!]
+  tenantIDGT: ID
+  tenantIDGTE: ID
+  tenantIDLT: ID
+  tenantIDLTE: ID
+  tenantIDIsNil: Boolean
+  tenantIDNotNil: Boolean
+  """actor_type field predicates"""
+  actorType: AuditLogActorType
+  actorTypeNEQ: AuditLogActorType
+  actorTypeIn: [AuditLogActorType!]
+  actorTypeNotIn: [AuditLogActorType!]
+  """actor_id field predicates"""
+  actorID: ID
+  actorIDNEQ: ID
+  actorIDIn: [ID!]
+  actorIDNotIn: [ID!]
+  actorIDGT: ID
+  actorIDGTE: ID
+  actorIDLT: ID
+  actorIDLTE: ID
+  actorIDIsNil: Boolean
+  actorIDNotNil: Boolean
+  """request_id field predicates"""
+  requestID: String
+  requestIDNEQ: String
+  requestIDIn: [String!]
+  requestIDNotIn: [String!]
+  requestIDGT: String
+  requestIDGTE: String
+  requestIDLT: String
+  requestIDLTE: String
+  requestIDContains: String
+  requestIDHasPrefix: String
+  requestIDHasSuffix: String
+  requestIDIsNil: Boolean
+  requestIDNotNil: Boolean
+  requestIDEqualFold: String
+  requestIDContainsFold: String
+  """entity_type field predicates"""
+  entityType: String
+  entityTypeNEQ: String
+  entityTypeIn: [String!]
+  entityTypeNotIn: [String!]
+  entityTypeGT: String
+  entityTypeGTE: String
+  entityTypeLT: String
+  entityTypeLTE: String
+  entityTypeContains: String
+  entityTypeHasPrefix: String
+  entityTypeHasSuffix: String
+  entityTypeEqualFold: String
+  entityTypeContainsFold: String
+  """entity_id field predicates"""
+  entityID: ID
+  entityIDNEQ: ID
+  entityIDIn: [ID!]
+  entityIDNotIn: [ID!]
+  entityIDGT: ID
+  entityIDGTE: ID
+  entityIDLT: ID
+  entityIDLTE: ID
+  """operation field predicates"""
+  operation: AuditLogOperation
+  operationNEQ: AuditLogOperation
+  operationIn: [AuditLogOperation!]
+  operationNotIn: [AuditLogOperation!]
+  """created_at field predicates"""
+  createdAt: Time
+  createdAtNEQ: Time
+  createdAtIn: [Time!]
+  createdAtNotIn: [Time!]
+  createdAtGT: Time
+  createdAtGTE: Time
+  createdAtLT: Time
+  createdAtLTE: Time
+}
+"""
+CreateUserInput is used for create User object.
+Input was generated by ent.
+"""
+input CreateUserInput {
+  """姓名"""
+  name: String!
+  """性别"""
+  sex: Boolean!
+  """年龄"""
+  age: Int!
+  """账号"""
+  account: String!
+  """邮箱，写入前转为小写"""
+  email: String
+  """密码，写入前转为哈希"""
+  password: String!
+}
+"""
+DataExportWhereInput is used for filtering DataExport objects.
+Input was generated by ent.
+"""
+input DataExportWhereInput {
+  not: DataExportWhereInput
+  and: [DataExportWhereInput!]
+  or: [DataExportWhereInput!]
+  """id field predicates"""
+  id: ID
+  idNEQ: ID
+  idIn: [ID!]
+  idNotIn: [ID!]
+  idGT: ID
+  idGTE: ID
+  idLT: ID
+  idLTE: ID
+  """tenant_id field predicates"""
+  tenantID: ID
+  tenantIDNEQ: ID
+  tenantIDIn: [ID!]
+  tenantIDNotIn: [ID!]
+  tenantIDGT: ID
+  tenantIDGTE: ID
+  tenantIDLT: ID
+  tenantIDLTE: ID
+  """user_id field predicates"""
+  userID: ID
+  userIDNEQ: ID
+  userIDIn: [ID!]
+  userIDNotIn: [ID!]
+  """requested_by field predicates"""
+  requestedBy: ID
+  requestedByNEQ: ID
+  requestedByIn: [ID!]
+  requestedByNotIn: [ID!]
+  requestedByGT: ID
+  requestedByGTE: ID
+  requestedByLT: ID
+  requestedByLTE: ID
+  requestedByIsNil: Boolean
+  requestedByNotNil: Boolean
+  """status field predicates"""
+  status: DataExportStatus
+  statusNEQ: DataExportStatus
+  statusIn: [DataExportStatus!]
+  statusNotIn: [DataExportStatus!]
+  """error field predicates"""
+  error: String
+  errorNEQ: String
+  errorIn: [String!]
+  errorNotIn: [String!]
+  errorGT: String
+  errorGTE: String
+  errorLT: String
+  errorLTE: String
+  errorContains: String
+  errorHasPrefix: String
+  errorHasSuffix: String
+  errorIsNil: Boolean
+  errorNotNil: Boolean
+  errorEqualFold: String
+  errorContainsFold: String
+  """size field predicates"""
+  size: Int
+  sizeNEQ: Int
+  sizeIn: [Int!]
+  sizeNotIn: [Int!]
+  sizeGT: Int
+  sizeGTE: Int
+  sizeLT: Int
+  sizeLTE: Int
+  sizeIsNil: Boolean
+  sizeNotNil: Boolean
+  """created_at field predicates"""
+  createdAt: Time
+  createdAtNEQ: Time
+  createdAtIn: [Time!]
+  createdAtNotIn: [Time!]
+  createdAtGT: Time
+  createdAtGTE: Time
+  createdAtLT: Time
+  createdAtLTE: Time
+  """completed_at field predicates"""
+  completedAt: Time
+  completedAtNEQ: Time
+  completedAtIn: [Time!]
+  completedAtNotIn: [Time!]
+  completedAtGT: Time
+  completedAtGTE: Time
+  completedAtLT: Time
+  completedAtLTE: Time
+  completedAtIsNil: Boolean
+  completedAtNotNil: Boolean
+  """expires_at field predicates"""
+  expiresAt: Time
+  expiresAtNEQ: Time
+  expiresAtIn: [Time!]
+  expiresAtNotIn: [Time!]
+  expiresAtGT: Time
+  expiresAtGTE: Time
+  expiresAtLT: Time
+  expiresAtLTE: Time
+  expiresAtIsNil: Boolean
+  expiresAtNotNil: Boolean
+  """user edge predicates"""
+  hasUser: Boolean
+  hasUserWith: [UserWhereInput!]
+}
+"""
+FileWhereInput is used for filtering File objects.
+Input was generated by ent.
+"""
+input FileWhereInput {
+  not: FileWhereInput
+  and: [FileWhereInput!]
+  or: [FileWhereInput!]
+  """id field predicates"""
+  id: ID
+  idNEQ: ID
+  idIn: [ID!]
+  idNotIn: [ID!]
+  idGT: ID
+  idGTE: ID
+  idLT: ID
+  idLTE: ID
+  """tenant_id field predicates"""
+  tenantID: ID
+  tenantIDNEQ: ID
+  tenantIDIn: [ID!]
+  tenantIDNotIn: [ID!]
+  tenantIDGT: ID
+  tenantIDGTE: ID
+  tenantIDLT: ID
+  tenantIDLTE: ID
+  """name field predicates"""
+  name: String
+  nameNEQ: String
+  nameIn: [String!]
+  nameNotIn: [String!]
+  nameGT: String
+  nameGTE: String
+  nameLT: String
+  nameLTE: String
+  nameContains: String
+  nameHasPrefix: String
+  nameHasSuffix: String
+  nameEqualFold: String
+  nameContainsFold: String
+  """size field predicates"""
+  size: Int
+  sizeNEQ: Int
+  sizeIn: [Int!]
+  sizeNotIn: [Int!]
+  sizeGT: Int
+  sizeGTE: Int
+  sizeLT: Int
+  sizeLTE: Int
+  """content_type field predicates"""
+  contentType: String
+  contentTypeNEQ: String
+  contentTypeIn: [String!]
+  contentTypeNotIn: [String!]
+  contentTypeGT: String
+  contentTypeGTE: String
+  contentTypeLT: String
+  contentTypeLTE: String
+  contentTypeContains: String
+  contentTypeHasPrefix: String
+  contentTypeHasSuffix: String
+  contentTypeEqualFold: String
+  contentTypeContainsFold: String
+  """checksum field predicates"""
+  checksum: String
+  checksumNEQ: String
+  checksumIn: [String!]
+  checksumNotIn: [String!]
+  checksumGT: String
+  checksumGTE: String
+  checksumLT: String
+  checksumLTE: String
+  checksumContains: String
+  checksumHasPrefix: String
+  checksumHasSuffix: String
+  checksumEqualFold: String
+  checksumContainsFold: String
+  """owner_id field predicates"""
+  ownerID: ID
+  ownerIDNEQ: ID
+  ownerIDIn: [ID!]
+  ownerIDNotIn: [ID!]
+  """created_at field predicates"""
+  createdAt: Time
+  createdAtNEQ: Time
+  createdAtIn: [Time!]
+  createdAtNotIn: [Time!]
+  createdAtGT: Time
+  createdAtGTE: Time
+  createdAtLT: Time
+  createdAtLTE: Time
+  """owner edge predicates"""
+  hasOwner: Boolean
+  hasOwnerWith: [UserWhereInput!]
+}
+"""
+IdentityWhereInput is used for filtering Identity objects.
+Input was generated by ent.
+"""
+input IdentityWhereInput {
+  not: IdentityWhereInput
+  and: [IdentityWhereInput!]
+  or: [IdentityWhereInput!]
+  """id field predicates"""
+  id: ID
+  idNEQ: ID
+  idIn: [ID!]
+  idNotIn: [ID!]
+  idGT: ID
+  idGTE: ID
+  idLT: ID
+  idLTE: ID
+  """tenant_id field predicates"""
+  tenantID: ID
+  tenantIDNEQ: ID
+  tenantIDIn: [ID!]
+  tenantIDNotIn: [ID!]
+  tenantIDGT: ID
+  tenantIDGTE: ID
+  tenantIDLT: ID
+  tenantIDLTE: ID
+  """provider field predicates"""
+  provider: String
+  providerNEQ: String
+  providerIn: [String!]
+  providerNotIn: [String!]
+  providerGT: String
+  providerGTE: String
+  providerLT: String
+  providerLTE: String
+  providerContains: String
+  providerHasPrefix: String
+  providerHasSuffix: String
+  providerEqualFold: String
+  providerContainsFold: String
+  """issuer field predicates"""
+  issuer: String
+  issuerNEQ: String
+  issuerIn: [String!]
+  issuerNotIn: [String!]
+  issuerGT: String
+  issuerGTE: String
+  issuerLT: String
+  issuerLTE: String
+  issuerContains: String
+  issuerHasPrefix: String
+  issuerHasSuffix: String
+  issuerEqualFold: String
+  issuerContainsFold: String
+  """subject field predicates"""
+  subject: String
+  subjectNEQ: String
+  subjectIn: [String!]
+  subjectNotIn: [String!]
+  subjectGT: String
+  subjectGTE: String
+  subjectLT: String
+  subjectLTE: String
+  subjectContains: String
+  subjectHasPrefix: String
+  subjectHasSuffix: String
+  subjectEqualFold: String
+  subjectContainsFold: String
+  """email field predicates"""
+  email: String
+  emailNEQ: String
+  emailIn: [String!]
+  emailNotIn: [String!]
+  emailGT: String
+  emailGTE: String
+  emailLT: String
+  emailLTE: String
+  emailContains: String
+  emailHasPrefix: String
+  emailHasSuffix: String
+  emailIsNil: Boolean
+  emailNotNil: Boolean
+  emailEqualFold: String
+  emailContainsFold: String
+  """user_id field predicates"""
+  userID: ID
+  userIDNEQ: ID
+  userIDIn: [ID!]
+  userIDNotIn: [ID!]
+  """created_at field predicates"""
+  createdAt: Time
+  createdAtNEQ: Time
+  createdAtIn: [Time!]
+  createdAtNotIn: [Time!]
+  createdAtGT: Time
+  createdAtGTE: Time
+  createdAtLT: Time
+  createdAtLTE: Time
+  """last_login_at field predicates"""
+  lastLoginAt: Time
+  lastLoginAtNEQ: Time
+  lastLoginAtIn: [Time!]
+  lastLoginAtNotIn: [Time!]
+  lastLoginAtGT: Time
+  lastLoginAtGTE: Time
+  lastLoginAtLT: Time
+  lastLoginAtLTE: Time
+  lastLoginAtIsNil: Boolean
+  lastLoginAtNotNil: Boolean
+  """user edge predicates"""
+  hasUser: Boolean
+  hasUserWith: [UserWhereInput!]
+}
+"""
+PermissionWhereInput is used for filtering Permission objects.
+Input was generated by ent.
+"""
+input PermissionWhereInput {
+  not: PermissionWhereInput
+  and: [PermissionWhereInput!]
+  or: [PermissionWhereInput!]
+  """id field predicates"""
+  id: ID
+  idNEQ: ID
+  idIn: [ID!]
+  idNotIn: [ID!]
+  idGT: ID
+  idGTE: ID
+  idLT: ID
+  idLTE: ID
+  """name field predicates"""
+  name: String
+  nameNEQ: String
+  nameIn: [String!]
+  nameNotIn: [String!]
+  nameGT: String
+  nameGTE: String
+  nameLT: String
+  nameLTE: String
+  nameContains: String
+  nameHasPrefix: String
+  nameHasSuffix: String
+  nameEqualFold: String
+  nameContainsFold: String
+  """description field predicates"""
+  description: String
+  descriptionNEQ: String
+  descriptionIn: [String!]
+  descriptionNotIn: [String!]
+  descriptionGT: String
+  descriptionGTE: String
+  descriptionLT: String
+  descriptionLTE: String
+  descriptionContains: String
+  descriptionHasPrefix: String
+  descriptionHasSuffix: String
+  descriptionEqualFold: String
+  descriptionContainsFold: String
+  """roles edge predicates"""
+  hasRoles: Boolean
+  hasRolesWith: [RoleWhereInput!]
+}
+"""
+RoleWhereInput is used for filtering Role objects.
+Input was generated by ent.
+"""
+input RoleWhereInput {
+  not: RoleWhereInput
+  and: [RoleWhereInput!]
+  or: [RoleWhereInput!]
+  """id field predicates"""
+  id: ID
+  idNEQ: ID
+  idIn: [ID!]
+  idNotIn: [ID!]
+  idGT: ID
+  idGTE: ID
+  idLT: ID
+  idLTE: ID
+  """name field predicates"""
+  name: String
+  nameNEQ: String
+  nameIn: [String!]
+  nameNotIn: [String!]
+  nameGT: String
+  nameGTE: String
+  nameLT: String
+  nameLTE: String
+  nameContains: String
+  nameHasPrefix: String
+  nameHasSuffix: String
+  nameEqualFold: String
+  nameContainsFold: String
+  """description field predicates"""
+  description: String
+  descriptionNEQ: String
+  descriptionIn: [String!]
+  descriptionNotIn: [String!]
+  descriptionGT: String
+  descriptionGTE: String
+  descriptionLT: String
+  descriptionLTE: String
+  descriptionContains: String
+  descriptionHasPrefix: String
+  descriptionHasSuffix: String
+  descriptionEqualFold: String
+  descriptionContainsFold: String
+  """users edge predicates"""
+  hasUsers: Boolean
+  hasUsersWith: [UserWhereInput!]
+  """permissions edge predicates"""
+  hasPermissions: Boolean
+  hasPermissionsWith: [PermissionWhereInput!]
+}
+"""
+TenantWhereInput is used for filtering Tenant objects.
+Input was generated by ent.
+"""
+input TenantWhereInput {
+  not: TenantWhereInput
+  and: [TenantWhereInput!]
+  or: [TenantWhereInput!]
+  """id field predicates"""
+  id: ID
+  idNEQ: ID
+  idIn: [ID!]
+  idNotIn: [ID!]
+  idGT: ID
+  idGTE: ID
+  idLT: ID
+  idLTE: ID
+  """name field predicates"""
+  name: String
+  nameNEQ: String
+  nameIn: [String!]
+  nameNotIn: [String!]
+  nameGT: String
+  nameGTE: String
+  nameLT: String
+  nameLTE: String
+  nameContains: String
+  nameHasPrefix: String
+  nameHasSuffix: String
+  nameEqualFold: String
+  nameContainsFold: String
+  """slug field predicates"""
+  slug: String
+  slugNEQ: String
+  slugIn: [String!]
+  slugNotIn: [String!]
+  slugGT: String
+  slugGTE: String
+  slugLT: String
+  slugLTE: String
+  slugContains: String
+  slugHasPrefix: String
+  slugHasSuffix: String
+  slugEqualFold: String
+  slugContainsFold: String
+  """created_at field predicates"""
+  createdAt: Time
+  createdAtNEQ: Time
+  createdAtIn: [Time!]
+  createdAtNotIn: [Time!]
+  createdAtGT: Time
+  createdAtGTE: Time
+  createdAtLT: Time
+  createdAtLTE: Time
+}
+"""
+UpdateUserInput is used for update User object.
+Input was generated by ent.
+"""
+input UpdateUserInput {
+  """姓名"""
+  name: String
+  """性别"""
+  sex: Boolean
+  """年龄"""
+  age: Int
+  """账号"""
+  account: String
+  """邮箱，写入前转为小写"""
+  email: String
+  clearEmail: Boolean
+}
+"""
+UserWhereInput is used for filtering User objects.
+Input was generated by ent.
+"""
+input UserWhereInput {
+  not: UserWhereInput
+  and: [UserWhereInput!]
+  or: [UserWhereInput!]
+  """id field predicates"""
+  id: ID
+  idNEQ: ID
+  idIn: [ID!]
+  idNotIn: [ID!]
+  idGT: ID
+  idGTE: ID
+  idLT: ID
+  idLTE: ID
+  """tenant_id field predicates"""
+  tenantID: ID
+  tenantIDNEQ: ID
+  tenantIDIn: [ID!]
+  tenantIDNotIn: [ID!]
+  tenantIDGT: ID
+  tenantIDGTE: ID
+  tenantIDLT: ID
+  tenantIDLTE: ID
+  """created_at field predicates"""
+  createdAt: Time
+  createdAtNEQ: Time
+  createdAtIn: [Time!]
+  createdAtNotIn: [Time!]
+  createdAtGT: Time
+  createdAtGTE: Time
+  createdAtLT: Time
+  createdAtLTE: Time
+  """updated_at field predicates"""
+  updatedAt: Time
+  updatedAtNEQ: Time
+  updatedAtIn: [Time!]
+  updatedAtNotIn: [Time!]
+  updatedAtGT: Time
+  updatedAtGTE: Time
+  updatedAtLT: Time
+  updatedAtLTE: Time
+  """created_by field predicates"""
+  createdBy: ID
+  createdByNEQ: ID
+  createdByIn: [ID!]
+  createdByNotIn: [ID!]
+  createdByGT: ID
+  createdByGTE: ID
+  createdByLT: ID
+  createdByLTE: ID
+  createdByIsNil: Boolean
+  createdByNotNil: Boolean
+  """updated_by field predicates"""
+  updatedBy: ID
+  updatedByNEQ: ID
+  updatedByIn: [ID!]
+  updatedByNotIn: [ID!]
+  updatedByGT: ID
+  updatedByGTE: ID
+  updatedByLT: ID
+  updatedByLTE: ID
+  updatedByIsNil: Boolean
+  updatedByNotNil: Boolean
+  """version field predicates"""
+  version: Int
+  versionNEQ: Int
+  versionIn: [Int!]
+  versionNotIn: [Int!]
+  versionGT: Int
+  versionGTE: Int
+  versionLT: Int
+  versionLTE: Int
+  """deleted_at field predicates"""
+  deletedAt: Time
+  deletedAtNEQ: Time
+  deletedAtIn: [Time!]
+  deletedAtNotIn: [Time!]
+  deletedAtGT: Time
+  deletedAtGTE: Time
+  deletedAtLT: Time
+  deletedAtLTE: Time
+  deletedAtIsNil: Boolean
+  deletedAtNotNil: Boolean
+  """name field predicates"""
+  name: String
+  nameNEQ: String
+  nameIn: [String!]
+  nameNotIn: [String!]
+  nameGT: String
+  nameGTE: String
+  nameLT: String
+  nameLTE: String
+  nameContains: String
+  nameHasPrefix: String
+  nameHasSuffix: String
+  nameEqualFold: String
+  nameContainsFold: String
+  """sex field predicates"""
+  sex: Boolean
+  sexNEQ: Boolean
+  """age field predicates"""
+  age: Int
+  ageNEQ: Int
+  ageIn: [Int!]
+  ageNotIn: [Int!]
+  ageGT: Int
+  ageGTE: Int
+  ageLT: Int
+  ageLTE: Int
+  """account field predicates"""
+  account: String
+  accountNEQ: String
+  accountIn: [String!]
+  accountNotIn: [String!]
+  accountGT: String
+  accountGTE: String
+  accountLT: String
+  accountLTE: String
+  accountContains: String
+  accountHasPrefix: String
+  accountHasSuffix: String
+  accountEqualFold: String
+  accountContainsFold: String
+  """email field predicates"""
+  email: String
+  emailNEQ: String
+  emailIn: [String!]
+  emailNotIn: [String!]
+  emailGT: String
+  emailGTE: String
+  emailLT: String
+  emailLTE: String
+  emailContains: String
+  emailHasPrefix: String
+  emailHasSuffix: String
+  emailIsNil: Boolean
+  emailNotNil: Boolean
+  emailEqualFold: String
+  emailContainsFold: String
+  """email_verified_at field predicates"""
+  emailVerifiedAt: Time
+  emailVerifiedAtNEQ: Time
+  emailVerifiedAtIn: [Time!]
+  emailVerifiedAtNotIn: [Time!]
+  emailVerifiedAtGT: Time
+  emailVerifiedAtGTE: Time
+  emailVerifiedAtLT: Time
+  emailVerifiedAtLTE: Time
+  emailVerifiedAtIsNil: Boolean
+  emailVerifiedAtNotNil: Boolean
+  """totp_enabled field predicates"""
+  totpEnabled: Boolean
+  totpEnabledNEQ: Boolean
+  """roles edge predicates"""
+  hasRoles: Boolean
+  hasRolesWith: [RoleWhereInput!]
+  """avatar edge predicates"""
+  hasAvatar: Boolean
+  hasAvatarWith: [FileWhereInput!]
+}
+`, BuiltIn: false},
 }
 var parsedSchema = gqlparser.MustLoadSchema(sources...)
@@ -0,0 +1,36 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package model
+
+import (
+	"go-web/ent"
+	"time"
+)
+
+// Credentials issued after a successful login. In session mode the session ID is
+// only sent as an HttpOnly cookie, so accessToken and refreshToken are null.
+//
+// When the user has two-factor authentication enabled, login only returns a
+// challengeToken with secondFactorRequired set. No credentials are issued until
+// verifyTwoFactor succeeds. expiresAt is then the expiry of the challenge.
+type AuthPayload struct {
+	AccessToken          *string   `json:"accessToken,omitempty"`
+	RefreshToken         *string   `json:"refreshToken,omitempty"`
+	TokenType            string    `json:"tokenType"`
+	ExpiresAt            time.Time `json:"expiresAt"`
+	SecondFactorRequired bool      `json:"secondFactorRequired"`
+	ChallengeToken       *string   `json:"challengeToken,omitempty"`
+}
+
+// A newly created API key. The plaintext key is only returned once.
+type CreateAPIKeyPayload struct {
+	APIKey *ent.APIKey `json:"apiKey"`
+	Key    string      `json:"key"`
+}
+
+// Pending TOTP enrollment. Add the secret to an authenticator app, then confirm with a code.
+type TotpEnrollment struct {
+	Secret string `json:"secret"`
+	// otpauth:// URI for QR codes
+	URI string `json:"uri"`
+}
@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	goWebErrors "go-web/pkg/errors"
+	"go-web/pkg/export"
+	"go-web/pkg/i18n"
+	"go-web/pkg/response"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// ExportHandler 个人数据导出的下载路由，通过签名链接访问，无需登录
+type ExportHandler struct {
+	exports *export.Service
+	logger  *zap.Logger
+}
+
+// NewExportHandler 创建数据导出下载路由
+func NewExportHandler(exports *export.Service, logger *zap.Logger) *ExportHandler {
+	return &ExportHandler{
+		exports: exports,
+		logger:  logger.With(zap.String("component", "export_handler")),
+	}
+}
+
+// Register 注册路由
+func (h *ExportHandler) Register(r gin.IRouter) {
+	r.GET("/exports/:id/download", h.Download)
+}
+
+// Download 校验签名后返回归档文件
+func (h *ExportHandler) Download(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		h.notFound(c)
+		return
+	}
+	expires, err := strconv.ParseInt(c.Query("expires"), 10, 64)
+	if err != nil {
+		h.notFound(c)
+		return
+	}
+
+	f, err := h.exports.Open(id, time.Unix(expires, 0), c.Query("signature"))
+	if err != nil {
+		var e *goWebErrors.Error
+		if errors.As(err, &e) && e.IsNotFound() {
+			h.notFound(c)
+			return
+		}
+		h.logger.Error("failed to open archive", zap.Uint64("export_id", id), zap.Error(err))
+		i18n.ErrorResponse(c, err)
+		return
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		i18n.ErrorResponse(c, err)
+		return
+	}
+	c.Header("Cache-Control", "no-store")
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="data-export-%d.json"`, id))
+	c.Header("Content-Type", "application/json")
+	http.ServeContent(c.Writer, c.Request, info.Name(), info.ModTime(), f)
+}
+
+// notFound 签名无效、链接过期与归档不存在都返回 404，不区分具体原因
+func (h *ExportHandler) notFound(c *gin.Context) {
+	response.Error(c, http.StatusNotFound, i18n.T(c, "not_found"), "export not found or link expired")
+}
@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"time"
+
+	goWebErrors "go-web/pkg/errors"
+	"go-web/pkg/i18n"
+	"go-web/pkg/response"
+	"go-web/pkg/upload"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// FileHandler 上传文件的读取路由，通过签名链接访问，无需登录，可以直接用于 <img> 等标签
+type FileHandler struct {
+	uploads *upload.Service
+	logger  *zap.Logger
+}
+
+// NewFileHandler 创建文件读取路由
+func NewFileHandler(uploads *upload.Service, logger *zap.Logger) *FileHandler {
+	return &FileHandler{
+		uploads: uploads,
+		logger:  logger.With(zap.String("component", "file_handler")),
+	}
+}
+
+// Register 注册路由
+func (h *FileHandler) Register(r gin.IRouter) {
+	r.GET("/files/:id", h.Get)
+}
+
+// Get 校验签名后返回文件内容
+func (h *FileHandler) Get(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		h.notFound(c)
+		return
+	}
+	expires, err := strconv.ParseInt(c.Query("expires"), 10, 64)
+	if err != nil {
+		h.notFound(c)
+		return
+	}
+
+	f, body, err := h.uploads.Open(c.Request.Context(), id, time.Unix(expires, 0), c.Query("signature"))
+	if err != nil {
+		var e *goWebErrors.Error
+		if errors.As(err, &e) && e.IsNotFound() {
+			h.notFound(c)
+			return
+		}
+		h.logger.Error("failed to open file", zap.Uint64("file_id", id), zap.Error(err))
+		i18n.ErrorResponse(c, err)
+		return
+	}
+	defer body.Close()
+
+	// 文件内容不可修改，链接有效期内可以缓存
+	maxAge := int(time.Until(time.Unix(expires, 0)).Seconds())
+	c.Header("Cache-Control", fmt.Sprintf("private, max-age=%d, immutable", maxAge))
+	c.Header("X-Content-Type-Options", "nosniff")
+	c.Header("Content-Disposition", mime.FormatMediaType("inline", map[string]string{"filename": f.Name}))
+	c.Header("Content-Type", f.ContentType)
+	c.Header("Content-Length", strconv.FormatInt(f.Size, 10))
+	c.Status(http.StatusOK)
+	if _, err := io.Copy(c.Writer, body); err != nil {
+		h.logger.Warn("failed to send file", zap.Uint64("file_id", id), zap.Error(err))
+	}
+}
+
+// notFound 签名无效、链接过期与文件不存在都返回 404，不区分具体原因
+func (h *FileHandler) notFound(c *gin.Context) {
+	response.Error(c, http.StatusNotFound, i18n.T(c, "not_found"), "file not found or link expired")
+}
@@ -0,0 +1,6 @@
+// Package handler 提供 GraphQL 之外的 HTTP 路由，例如需要浏览器重定向的 OIDC 登录流程、数据导出下载与上传文件的读取。
+package handler
+
+import "github.com/google/wire"
+
+var ProviderSet = wire.NewSet(NewOIDCHandler, NewExportHandler, NewFileHandler)
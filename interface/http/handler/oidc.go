@@ -0,0 +1,192 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"go-web/ent"
+	"go-web/pkg/auth"
+	"go-web/pkg/config"
+	goWebErrors "go-web/pkg/errors"
+	"go-web/pkg/i18n"
+	"go-web/pkg/viewer"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+const (
+	// oidcStateCookie 保存授权请求 state 绑定值的 cookie
+	oidcStateCookie = "oidc_state"
+	// oidcCookiePath cookie 只发送到 OIDC 路由
+	oidcCookiePath = "/auth/oidc/"
+)
+
+// tokenTypeSecondFactor 需要输入验证码时返回的令牌类型，与 GraphQL 登录一致
+const tokenTypeSecondFactor = "SecondFactor"
+
+// loginResponse OIDC 登录成功后的 JSON 响应，字段与 GraphQL AuthPayload 一致
+type loginResponse struct {
+	AccessToken          string    `json:"accessToken,omitempty"`
+	RefreshToken         string    `json:"refreshToken,omitempty"`
+	TokenType            string    `json:"tokenType"`
+	ExpiresAt            time.Time `json:"expiresAt"`
+	SecondFactorRequired bool      `json:"secondFactorRequired"`
+	ChallengeToken       string    `json:"challengeToken,omitempty"`
+}
+
+// OIDCHandler OpenID Connect 登录路由
+type OIDCHandler struct {
+	oidc      *auth.OIDCService
+	tokens    *auth.TokenService
+	sessions  *auth.SessionStore
+	twoFactor *auth.TwoFactorService
+	authMode  string
+	stateTTL  time.Duration
+	secure    bool
+	logger    *zap.Logger
+}
+
+// NewOIDCHandler 创建 OIDC 登录路由
+func NewOIDCHandler(cfg *config.Config, oidc *auth.OIDCService, tokens *auth.TokenService, sessions *auth.SessionStore, twoFactor *auth.TwoFactorService, logger *zap.Logger) *OIDCHandler {
+	return &OIDCHandler{
+		oidc:      oidc,
+		tokens:    tokens,
+		sessions:  sessions,
+		twoFactor: twoFactor,
+		authMode:  cfg.Auth.Mode,
+		stateTTL:  cfg.Auth.OIDC.StateTTL,
+		secure:    cfg.Auth.Session.Secure,
+		logger:    logger.With(zap.String("component", "oidc_handler")),
+	}
+}
+
+// Register 注册路由
+func (h *OIDCHandler) Register(r gin.IRouter) {
+	g := r.Group("/auth/oidc/:provider")
+	g.GET("/login", h.Login)
+	g.GET("/callback", h.Callback)
+}
+
+// Login 重定向到身份提供方。已登录用户访问时，回调成功后外部身份关联到该用户。
+func (h *OIDCHandler) Login(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var linkUserID uint64
+	if v := viewer.FromContext(ctx); v != nil && auth.APIKeyFromContext(ctx) == nil {
+		linkUserID = v.ID
+	}
+
+	authURL, binding, err := h.oidc.Begin(ctx, c.Param("provider"), linkUserID)
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	h.setStateCookie(c, binding, int(h.stateTTL.Seconds()))
+	c.Redirect(http.StatusFound, authURL)
+}
+
+// Callback 处理身份提供方的回调并登录
+func (h *OIDCHandler) Callback(c *gin.Context) {
+	// state 只能使用一次，cookie 随之失效
+	binding, _ := c.Cookie(oidcStateCookie)
+	h.setStateCookie(c, "", -1)
+
+	if errCode := c.Query("error"); errCode != "" {
+		h.fail(c, goWebErrors.New(goWebErrors.ErrUnauthorized, "unauthorized", errCode+": "+c.Query("error_description")))
+		return
+	}
+
+	provider := c.Param("provider")
+	ctx := c.Request.Context()
+	u, err := h.oidc.Complete(ctx, provider, c.Query("state"), binding, c.Query("code"))
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+
+	resp, err := h.issue(ctx, u)
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+
+	p, _ := h.oidc.Provider(provider)
+	if target := p.Config().PostLoginRedirect; target != "" {
+		c.Redirect(http.StatusFound, redirectWithCredentials(target, resp))
+		return
+	}
+	i18n.SuccessResponse(c, resp)
+}
+
+// issue 签发令牌对或创建会话。与账号密码登录相同，启用两步验证的用户只得到挑战令牌，
+// 由客户端通过 verifyTwoFactor 输入验证码后获得凭证。
+func (h *OIDCHandler) issue(ctx context.Context, u *ent.User) (loginResponse, error) {
+	if u.TotpEnabled {
+		token, expiresAt, err := h.twoFactor.Challenge(ctx, u.ID)
+		if err != nil {
+			return loginResponse{}, err
+		}
+		return loginResponse{
+			TokenType:            tokenTypeSecondFactor,
+			ExpiresAt:            expiresAt,
+			SecondFactorRequired: true,
+			ChallengeToken:       token,
+		}, nil
+	}
+
+	if h.authMode == auth.ModeSession {
+		sess, err := h.sessions.Login(ctx, u.ID)
+		if err != nil {
+			return loginResponse{}, err
+		}
+		return loginResponse{TokenType: "Session", ExpiresAt: sess.ExpiresAt}, nil
+	}
+	pair, err := h.tokens.Login(ctx, u.ID)
+	if err != nil {
+		return loginResponse{}, err
+	}
+	return loginResponse{
+		AccessToken:  pair.AccessToken,
+		RefreshToken: pair.RefreshToken,
+		TokenType:    "Bearer",
+		ExpiresAt:    pair.ExpiresAt,
+	}, nil
+}
+
+// setStateCookie 写入 state 绑定值。身份提供方回调是跨站的顶级跳转，SameSite 只能为 Lax。
+func (h *OIDCHandler) setStateCookie(c *gin.Context, value string, maxAge int) {
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(oidcStateCookie, value, maxAge, oidcCookiePath, "", h.secure, true)
+}
+
+// fail 记录并返回错误
+func (h *OIDCHandler) fail(c *gin.Context, err error) {
+	h.logger.Warn("oidc login failed",
+		zap.Error(err),
+		zap.String("provider", c.Param("provider")),
+		zap.String("ip", c.ClientIP()),
+	)
+	i18n.ErrorResponse(c, err)
+}
+
+// redirectWithCredentials 将令牌或挑战令牌放在 URL 片段中跳转，片段不会发送到服务器或写入访问日志
+func redirectWithCredentials(target string, resp loginResponse) string {
+	frag := url.Values{}
+	switch {
+	case resp.ChallengeToken != "":
+		frag.Set("challenge_token", resp.ChallengeToken)
+		frag.Set("second_factor_required", "true")
+	case resp.AccessToken != "":
+		frag.Set("access_token", resp.AccessToken)
+		frag.Set("refresh_token", resp.RefreshToken)
+	default:
+		return target
+	}
+	frag.Set("token_type", resp.TokenType)
+	frag.Set("expires_at", strconv.FormatInt(resp.ExpiresAt.Unix(), 10))
+	return target + "#" + frag.Encode()
+}
@@ -0,0 +1,223 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"go-web/ent"
+	"go-web/ent/user"
+	"go-web/pkg/auth"
+	"go-web/pkg/config"
+	"go-web/pkg/oidc"
+	"go-web/pkg/oidc/oidctest"
+	"go-web/pkg/tenancy"
+	"go-web/pkg/testutil"
+	"go-web/pkg/totp"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// oidcFixture 连接到模拟身份提供方的 OIDC 路由，请求限定在一个租户内
+type oidcFixture struct {
+	router    *gin.Engine
+	mock      *oidctest.Provider
+	client    *ent.Client
+	ctx       context.Context
+	twoFactor *auth.TwoFactorService
+}
+
+func newOIDCFixture(t *testing.T) *oidcFixture {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	mock := oidctest.NewProvider("client-id", "client-secret")
+	t.Cleanup(mock.Close)
+
+	cfg := &config.Config{}
+	cfg.Auth.Mode = auth.ModeJWT
+	cfg.Auth.JWT.Secret = strings.Repeat("s", 32)
+	cfg.Auth.JWT.AccessTTL = time.Minute
+	cfg.Auth.JWT.RefreshTTL = time.Hour
+	cfg.Auth.OIDC.StateTTL = time.Minute
+	cfg.Auth.TOTP.ChallengeTTL = time.Minute
+	cfg.Auth.TOTP.MaxAttempts = 3
+	pc := mock.Config("http://localhost/auth/oidc/mock/callback")
+	pc.AutoRegister = true
+	cfg.Auth.OIDC.Providers = map[string]config.OIDCProvider{"mock": pc}
+
+	client := testutil.NewClient(t)
+	rdb, _ := testutil.NewRedis(t)
+	tn, ctx := testutil.NewTenant(t, client, "acme")
+	logger := zap.NewNop()
+
+	tokens := auth.NewTokenService(auth.NewTokenManager(cfg), rdb, client, logger)
+	twoFactor := auth.NewTwoFactorService(cfg, client, rdb, auth.NewLoginGuard(cfg, rdb, nil, logger), logger)
+	h := NewOIDCHandler(cfg, auth.NewOIDCService(cfg, oidc.NewRegistry(cfg), rdb, client, logger), tokens, nil, twoFactor, logger)
+
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		c.Request = c.Request.WithContext(tenancy.NewContext(c.Request.Context(), tn.ID))
+	})
+	h.Register(r)
+	return &oidcFixture{router: r, mock: mock, client: client, ctx: ctx, twoFactor: twoFactor}
+}
+
+// serve 执行请求并返回响应
+func serve(r http.Handler, target string, cookies ...*http.Cookie) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	for _, c := range cookies {
+		req.AddCookie(c)
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+// stateCookie 从响应中取出 state cookie
+func stateCookie(t *testing.T, w *httptest.ResponseRecorder) *http.Cookie {
+	t.Helper()
+	for _, c := range w.Result().Cookies() {
+		if c.Name == oidcStateCookie {
+			return c
+		}
+	}
+	t.Fatalf("response has no %s cookie", oidcStateCookie)
+	return nil
+}
+
+// begin 访问登录路由并经过模拟身份提供方，返回回调地址与登录时写入的 cookie
+func begin(t *testing.T, r http.Handler, mock *oidctest.Provider) (string, *http.Cookie) {
+	t.Helper()
+	w := serve(r, "/auth/oidc/mock/login")
+	if w.Code != http.StatusFound {
+		t.Fatalf("login status = %d, body %s", w.Code, w.Body)
+	}
+	callback, err := mock.Authorize(w.Header().Get("Location"))
+	if err != nil {
+		t.Fatalf("Authorize: %v", err)
+	}
+	return callback.RequestURI(), stateCookie(t, w)
+}
+
+func TestOIDCLoginSetsStateCookie(t *testing.T) {
+	f := newOIDCFixture(t)
+	r, mock := f.router, f.mock
+
+	callback, cookie := begin(t, r, mock)
+	if !cookie.HttpOnly || cookie.Path != oidcCookiePath || cookie.SameSite != http.SameSiteLaxMode {
+		t.Fatalf("state cookie = %+v, want HttpOnly, Lax, path %s", cookie, oidcCookiePath)
+	}
+	if cookie.MaxAge <= 0 || cookie.MaxAge > 60 {
+		t.Fatalf("state cookie max age = %d, want the state TTL", cookie.MaxAge)
+	}
+	u, _ := url.Parse(callback)
+	if state := u.Query().Get("state"); state == "" || strings.Contains(cookie.Value, state) {
+		t.Fatalf("state cookie %q must hold a hash of state %q", cookie.Value, state)
+	}
+}
+
+func TestOIDCCallbackRequiresStateCookie(t *testing.T) {
+	f := newOIDCFixture(t)
+	r, mock := f.router, f.mock
+
+	callback, cookie := begin(t, r, mock)
+	_, other := begin(t, r, mock)
+
+	for name, cookies := range map[string][]*http.Cookie{
+		"missing":  nil,
+		"mismatch": {other},
+	} {
+		if w := serve(r, callback, cookies...); w.Code != http.StatusUnauthorized {
+			t.Fatalf("%s cookie: callback status = %d, want 401", name, w.Code)
+		}
+	}
+
+	w := serve(r, callback, cookie)
+	if w.Code != http.StatusOK {
+		t.Fatalf("callback status = %d, body %s", w.Code, w.Body)
+	}
+	if c := stateCookie(t, w); c.MaxAge >= 0 {
+		t.Fatalf("callback must clear the state cookie, got %+v", c)
+	}
+}
+
+// callback 完成一次登录并解析响应
+func (f *oidcFixture) callback(t *testing.T) loginResponse {
+	t.Helper()
+	target, cookie := begin(t, f.router, f.mock)
+	w := serve(f.router, target, cookie)
+	if w.Code != http.StatusOK {
+		t.Fatalf("callback status = %d, body %s", w.Code, w.Body)
+	}
+	var body struct {
+		Data loginResponse `json:"data"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatalf("failed to decode callback response %s: %v", w.Body, err)
+	}
+	return body.Data
+}
+
+func TestOIDCCallbackRequiresSecondFactor(t *testing.T) {
+	f := newOIDCFixture(t)
+
+	// 首次登录创建用户，未启用两步验证时直接签发令牌
+	if resp := f.callback(t); resp.AccessToken == "" || resp.SecondFactorRequired {
+		t.Fatalf("login without two-factor = %+v, want tokens", resp)
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	u := f.client.User.Query().Where(user.AccountHasPrefix("oidc_")).OnlyX(f.ctx)
+	f.client.User.UpdateOne(u).SetTotpSecret(secret).SetTotpEnabled(true).ExecX(f.ctx)
+
+	resp := f.callback(t)
+	if resp.AccessToken != "" || resp.RefreshToken != "" || !resp.SecondFactorRequired || resp.ChallengeToken == "" {
+		t.Fatalf("login with two-factor = %+v, want only a challenge", resp)
+	}
+	if resp.TokenType != tokenTypeSecondFactor {
+		t.Fatalf("token type = %q, want %q", resp.TokenType, tokenTypeSecondFactor)
+	}
+
+	code, err := totp.Code(secret, totp.Step(time.Now()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	userID, err := f.twoFactor.VerifyChallenge(f.ctx, resp.ChallengeToken, code, "127.0.0.1")
+	if err != nil {
+		t.Fatalf("VerifyChallenge: %v", err)
+	}
+	if userID != u.ID {
+		t.Fatalf("challenge resolved user %d, want %d", userID, u.ID)
+	}
+}
+
+func TestRedirectWithCredentials(t *testing.T) {
+	expiresAt := time.Unix(1700000000, 0)
+	tests := []struct {
+		name string
+		resp loginResponse
+		want string
+	}{
+		{"tokens", loginResponse{AccessToken: "a", RefreshToken: "r", TokenType: "Bearer", ExpiresAt: expiresAt},
+			"https://app.example.com/#access_token=a&expires_at=1700000000&refresh_token=r&token_type=Bearer"},
+		{"challenge", loginResponse{TokenType: tokenTypeSecondFactor, ExpiresAt: expiresAt, SecondFactorRequired: true, ChallengeToken: "c"},
+			"https://app.example.com/#challenge_token=c&expires_at=1700000000&second_factor_required=true&token_type=SecondFactor"},
+		{"session", loginResponse{TokenType: "Session", ExpiresAt: expiresAt},
+			"https://app.example.com/"},
+	}
+	for _, tt := range tests {
+		if got := redirectWithCredentials("https://app.example.com/", tt.resp); got != tt.want {
+			t.Errorf("%s: redirectWithCredentials = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
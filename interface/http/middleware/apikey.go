@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"go-web/pkg/auth"
+	"go-web/pkg/viewer"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// APIKey 返回一个 API 密钥认证中间件，校验 X-API-Key 请求头并将密钥对应的访问者写入请求 context。
+// 与 Auth 一样，认证失败不会中断请求，失败原因记录在 context 中。
+func APIKey(logger *zap.Logger, keys *auth.APIKeyService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		raw := c.GetHeader(auth.APIKeyHeader)
+		if raw == "" {
+			c.Next()
+			return
+		}
+
+		ctx := c.Request.Context()
+		key, v, err := keys.Authenticate(ctx, raw)
+		if err != nil {
+			logger.Warn("api key rejected",
+				zap.Error(err),
+				zap.String("path", c.Request.URL.Path),
+				zap.String("ip", c.ClientIP()),
+				zap.String("request_id", c.GetString(RequestIDKey)),
+			)
+			// 携带无效密钥的请求不使用其他凭证建立的访问者
+			ctx = viewer.NewContext(auth.WithError(ctx, err), nil)
+			c.Request = c.Request.WithContext(ctx)
+			c.Next()
+			return
+		}
+
+		ctx = auth.NewAPIKeyContext(ctx, key)
+		ctx = viewer.NewContext(ctx, v)
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+	}
+}
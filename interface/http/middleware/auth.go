@@ -0,0 +1,56 @@
+package middleware
+
+import (
+	"strings"
+
+	"go-web/pkg/auth"
+	goWebErrors "go-web/pkg/errors"
+	"go-web/pkg/viewer"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// Auth 返回一个认证中间件，校验 Authorization: Bearer 访问令牌并将访问者写入请求 context。
+// 认证失败不会中断请求，失败原因记录在 context 中，由需要登录的 resolver 返回。
+func Auth(logger *zap.Logger, tokens *auth.TokenService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		header := c.GetHeader("Authorization")
+		if header == "" {
+			c.Next()
+			return
+		}
+
+		ctx := c.Request.Context()
+		scheme, token, found := strings.Cut(header, " ")
+		if !found || !strings.EqualFold(scheme, "Bearer") || token == "" {
+			ctx = auth.WithError(ctx, goWebErrors.New(goWebErrors.ErrUnauthorized, "unauthorized", "malformed authorization header"))
+			c.Request = c.Request.WithContext(ctx)
+			c.Next()
+			return
+		}
+
+		claims, err := tokens.Validate(ctx, strings.TrimSpace(token))
+		if err != nil {
+			logger.Debug("access token rejected",
+				zap.Error(err),
+				zap.String("path", c.Request.URL.Path),
+				zap.String("request_id", c.GetString(RequestIDKey)),
+			)
+			c.Request = c.Request.WithContext(auth.WithError(ctx, err))
+			c.Next()
+			return
+		}
+
+		userID, _ := claims.UserID()
+		ctx = auth.NewContext(ctx, claims)
+		ctx = viewer.NewContext(ctx, &viewer.Viewer{
+			ID:          userID,
+			Roles:       claims.Roles,
+			Permissions: claims.Permissions,
+		})
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+	}
+}
@@ -7,10 +7,12 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
 	"go-web/pkg/cache"
+	"go-web/pkg/tenancy"
 
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
@@ -39,7 +41,7 @@ func DefaultCacheConfig(redisClient *cache.RedisClient) *CacheConfig {
 	return &CacheConfig{
 		DefaultTTL:         5 * time.Minute,
 		Enabled:            true,
-		ExcludePaths:       []string{"/api/v1/graphql"},
+		ExcludePaths:       []string{"/api/v1/graphql", "/auth/", "/exports/", "/files/"},
 		ExcludeMethods:     []string{"POST", "PUT", "DELETE", "PATCH"},
 		ExcludeStatusCodes: []int{http.StatusBadRequest, http.StatusUnauthorized, http.StatusForbidden, http.StatusInternalServerError},
 		KeyPrefix:          "cache:",
@@ -177,6 +179,10 @@ func generateCacheKey(c *gin.Context, prefix string) string {
 	hash := md5.New()
 	io.WriteString(hash, path)
 	io.WriteString(hash, query)
+	// 不同租户的响应分别缓存
+	if id, ok := tenancy.FromContext(c.Request.Context()); ok {
+		io.WriteString(hash, strconv.FormatUint(id, 10))
+	}
 	hashStr := hex.EncodeToString(hash.Sum(nil))
 
 	return fmt.Sprintf("%s%s", prefix, hashStr)
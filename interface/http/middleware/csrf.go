@@ -23,6 +23,8 @@ type CSRFConfig struct {
 	SameSite http.SameSite
 	// SkipPaths 不需要 CSRF 防护的路径列表
 	SkipPaths []string
+	// SkipHeaders 携带这些请求头的请求不需要 CSRF 防护，浏览器不会在跨站请求中自动附带它们
+	SkipHeaders []string
 }
 
 // DefaultCSRFConfig 返回默认的 CSRF 配置
@@ -37,6 +39,9 @@ func DefaultCSRFConfig() *CSRFConfig {
 			"/health",
 			"/metrics",
 		},
+		SkipHeaders: []string{
+			"X-API-Key",
+		},
 	}
 }
 
@@ -59,6 +64,16 @@ func shouldSkip(path string, skipPaths []string) bool {
 	return false
 }
 
+// hasAnyHeader 检查请求是否携带任一指定的请求头
+func hasAnyHeader(c *gin.Context, headers []string) bool {
+	for _, h := range headers {
+		if c.GetHeader(h) != "" {
+			return true
+		}
+	}
+	return false
+}
+
 // CSRF 返回一个 CSRF 防护中间件
 func CSRF(logger *zap.Logger, config *CSRFConfig) gin.HandlerFunc {
 	if config == nil {
@@ -67,7 +82,7 @@ func CSRF(logger *zap.Logger, config *CSRFConfig) gin.HandlerFunc {
 
 	return func(c *gin.Context) {
 		// 检查是否需要跳过 CSRF 防护
-		if shouldSkip(c.Request.URL.Path, config.SkipPaths) {
+		if shouldSkip(c.Request.URL.Path, config.SkipPaths) || hasAnyHeader(c, config.SkipHeaders) {
 			c.Next()
 			return
 		}
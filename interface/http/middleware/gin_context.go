@@ -0,0 +1,20 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GinContextKey 是 gin.Context 在请求 context 中的键名，与 resolvers.GinContextFromContext 保持一致
+const GinContextKey = "GinContextKey"
+
+// GinContext 返回一个中间件，将 gin.Context 写入请求 context，
+// 使 GraphQL resolver 可以读取客户端 IP、语言等请求信息
+func GinContext() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := context.WithValue(c.Request.Context(), GinContextKey, c)
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
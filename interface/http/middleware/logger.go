@@ -2,7 +2,13 @@ package middleware
 
 import (
 	"bytes"
+	"encoding/json"
 	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -13,32 +19,220 @@ import (
 type bodyLogWriter struct {
 	gin.ResponseWriter
 	body *bytes.Buffer
+	// limit 最多捕获的字节数
+	limit int64
+	// skip 不捕获响应体
+	skip bool
+	// checked 是否已根据响应头判断过是否捕获
+	checked bool
+	// truncated 响应体超过 limit，不再捕获
+	truncated bool
 }
 
-// Write 重写 Write 方法，同时写入响应体和缓冲区
-func (w bodyLogWriter) Write(b []byte) (int, error) {
-	w.body.Write(b)
+// Write 重写 Write 方法，同时写入响应体和缓冲区。只捕获内联返回的 JSON，下载的文件不写入日志。
+func (w *bodyLogWriter) Write(b []byte) (int, error) {
+	if !w.checked {
+		w.checked = true
+		w.skip = w.skip || !loggableResponse(w.Header())
+	}
+	if !w.skip && !w.truncated {
+		if int64(w.body.Len()+len(b)) > w.limit {
+			w.truncated = true
+			w.body.Reset()
+		} else {
+			w.body.Write(b)
+		}
+	}
 	return w.ResponseWriter.Write(b)
 }
 
-// Logger 返回一个日志中间件，记录请求和响应的详细信息
-func Logger(logger *zap.Logger) gin.HandlerFunc {
+// logged 返回写入日志的响应体
+func (w *bodyLogWriter) logged(rd *redactor) []byte {
+	if w.truncated {
+		return []byte(truncatedValue)
+	}
+	return rd.redact(w.body.Bytes())
+}
+
+// loggableResponse 响应是否为内联返回的 JSON
+func loggableResponse(h http.Header) bool {
+	if d, _, err := mime.ParseMediaType(h.Get("Content-Disposition")); err == nil && d == "attachment" {
+		return false
+	}
+	return isJSON(h.Get("Content-Type"))
+}
+
+// isJSON 内容类型是否为 JSON
+func isJSON(contentType string) bool {
+	mt, _, err := mime.ParseMediaType(contentType)
+	return err == nil && (mt == "application/json" || strings.HasSuffix(mt, "+json"))
+}
+
+const (
+	// redactedValue 脱敏后的占位值
+	redactedValue = "[REDACTED]"
+	// truncatedValue 超过长度限制的内容不完整，无法脱敏，以占位值代替
+	truncatedValue = "[TRUNCATED]"
+)
+
+// LoggerConfig 是日志中间件的配置选项
+type LoggerConfig struct {
+	// RedactKeys 需要脱敏的 JSON 字段名，不区分大小写
+	RedactKeys []string
+	// RedactQueryKeys 需要脱敏的查询参数，不区分大小写
+	RedactQueryKeys []string
+	// SkipBodyPaths 不记录请求体和响应体的路径前缀
+	SkipBodyPaths []string
+	// MaxBodySize 记录的请求体和响应体的最大字节数，超过时只记录占位值，不大于 0 时不记录
+	MaxBodySize int64
+}
+
+// DefaultLoggerConfig 返回默认的日志配置
+func DefaultLoggerConfig() *LoggerConfig {
+	return &LoggerConfig{
+		RedactKeys: []string{
+			"password",
+			"newPassword",
+			"currentPassword",
+			"accessToken",
+			"refreshToken",
+			"token",
+			"code",
+			"challengeToken",
+			"secret",
+		},
+		// 签名链接的签名、授权回调的授权码与 state
+		RedactQueryKeys: []string{"signature", "code", "state"},
+		// 数据导出归档与上传文件的下载
+		SkipBodyPaths: []string{"/exports/", "/files/"},
+		MaxBodySize:   64 << 10,
+	}
+}
+
+// redactor 对请求和响应体中的敏感字段脱敏
+type redactor struct {
+	keys map[string]struct{}
+	// 匹配 GraphQL 查询文本中的内联参数，如 password: "xxx"
+	inline *regexp.Regexp
+}
+
+// newRedactor 根据字段名创建脱敏器
+func newRedactor(keys []string) *redactor {
+	r := &redactor{keys: make(map[string]struct{}, len(keys))}
+	quoted := make([]string, 0, len(keys))
+	for _, k := range keys {
+		r.keys[strings.ToLower(k)] = struct{}{}
+		quoted = append(quoted, regexp.QuoteMeta(k))
+	}
+	if len(quoted) > 0 {
+		r.inline = regexp.MustCompile(`(?i)\b(` + strings.Join(quoted, "|") + `)(\s*:\s*)"(?:[^"\\]|\\.)*"`)
+	}
+	return r
+}
+
+// redact 返回脱敏后的 JSON，非 JSON 内容原样返回
+func (r *redactor) redact(body []byte) []byte {
+	if len(r.keys) == 0 || len(body) == 0 {
+		return body
+	}
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return body
+	}
+	redacted, err := json.Marshal(r.walk(v))
+	if err != nil {
+		return body
+	}
+	return redacted
+}
+
+// walk 递归替换敏感字段的值
+func (r *redactor) walk(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for k, child := range val {
+			if _, ok := r.keys[strings.ToLower(k)]; ok {
+				val[k] = redactedValue
+				continue
+			}
+			if s, ok := child.(string); ok && k == "query" && r.inline != nil {
+				val[k] = r.inline.ReplaceAllString(s, `$1$2"`+redactedValue+`"`)
+				continue
+			}
+			val[k] = r.walk(child)
+		}
+		return val
+	case []interface{}:
+		for i, child := range val {
+			val[i] = r.walk(child)
+		}
+		return val
+	default:
+		return v
+	}
+}
+
+// redactQuery 返回敏感参数脱敏后的查询字符串
+func redactQuery(raw string, keys map[string]struct{}) string {
+	if raw == "" || len(keys) == 0 {
+		return raw
+	}
+	// 解析出错时仍使用已解析的参数，不原样记录无法解析的查询字符串
+	values, _ := url.ParseQuery(raw)
+	for k := range values {
+		if _, ok := keys[strings.ToLower(k)]; ok {
+			values[k] = []string{redactedValue}
+		}
+	}
+	return values.Encode()
+}
+
+// captureRequestBody 读取最多 limit 字节的请求体用于记录，读取的部分放回请求体，处理器仍能读到完整内容。
+// 只记录 JSON 请求体，文件上传等其他内容不读取；超过 limit 时返回占位值。
+func captureRequestBody(c *gin.Context, limit int64) []byte {
+	if c.Request.Body == nil || c.Request.Body == http.NoBody || !isJSON(c.GetHeader("Content-Type")) {
+		return nil
+	}
+	body := c.Request.Body
+	head, _ := io.ReadAll(io.LimitReader(body, limit+1))
+	c.Request.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(head), body), body}
+	if int64(len(head)) > limit {
+		return []byte(truncatedValue)
+	}
+	return head
+}
+
+// Logger 返回一个日志中间件，记录请求和响应的详细信息，敏感字段会被脱敏
+func Logger(logger *zap.Logger, config *LoggerConfig) gin.HandlerFunc {
+	if config == nil {
+		config = DefaultLoggerConfig()
+	}
+	rd := newRedactor(config.RedactKeys)
+	queryKeys := make(map[string]struct{}, len(config.RedactQueryKeys))
+	for _, k := range config.RedactQueryKeys {
+		queryKeys[strings.ToLower(k)] = struct{}{}
+	}
+
 	return func(c *gin.Context) {
 		// 开始时间
 		start := time.Now()
+		skipBody := config.MaxBodySize <= 0 || shouldExcludePath(c.Request.URL.Path, config.SkipBodyPaths)
 
 		// 获取请求体
 		var requestBody []byte
-		if c.Request.Body != nil {
-			requestBody, _ = io.ReadAll(c.Request.Body)
-			// 恢复请求体，因为读取后需要重新设置
-			c.Request.Body = io.NopCloser(bytes.NewBuffer(requestBody))
+		if !skipBody {
+			requestBody = captureRequestBody(c, config.MaxBodySize)
 		}
 
 		// 创建自定义响应写入器
 		blw := &bodyLogWriter{
 			ResponseWriter: c.Writer,
 			body:           bytes.NewBufferString(""),
+			limit:          config.MaxBodySize,
+			skip:           skipBody,
 		}
 		c.Writer = blw
 
@@ -65,14 +259,14 @@ func Logger(logger *zap.Logger) gin.HandlerFunc {
 			zap.String("request_id", requestID),
 			zap.String("method", c.Request.Method),
 			zap.String("path", c.Request.URL.Path),
-			zap.String("query", c.Request.URL.RawQuery),
+			zap.String("query", redactQuery(c.Request.URL.RawQuery, queryKeys)),
 			zap.String("ip", c.ClientIP()),
 			zap.String("user_agent", c.Request.UserAgent()),
 			zap.Int("status", c.Writer.Status()),
 			zap.Int("size", c.Writer.Size()),
 			zap.Duration("latency", latency),
-			zap.ByteString("request_body", requestBody),
-			zap.String("response_body", blw.body.String()),
+			zap.ByteString("request_body", rd.redact(requestBody)),
+			zap.ByteString("response_body", blw.logged(rd)),
 			zap.Strings("errors", c.Errors.Errors()),
 		)
 	}
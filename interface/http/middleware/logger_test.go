@@ -0,0 +1,163 @@
+package middleware
+
+import (
+	"bytes"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+// newLoggerRouter 创建使用日志中间件的路由，返回记录的日志
+func newLoggerRouter(t *testing.T, register func(r *gin.Engine)) (*gin.Engine, *observer.ObservedLogs) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	core, logs := observer.New(zapcore.InfoLevel)
+	r := gin.New()
+	r.Use(Logger(zap.New(core), DefaultLoggerConfig()))
+	register(r)
+	return r, logs
+}
+
+// loggedFields 执行请求并返回请求日志的字段
+func loggedFields(t *testing.T, r http.Handler, logs *observer.ObservedLogs, req *http.Request) map[string]interface{} {
+	t.Helper()
+	r.ServeHTTP(httptest.NewRecorder(), req)
+	entries := logs.TakeAll()
+	if len(entries) != 1 {
+		t.Fatalf("got %d log entries, want 1", len(entries))
+	}
+	return entries[0].ContextMap()
+}
+
+func TestLoggerRedactsJSONBodies(t *testing.T) {
+	r, logs := newLoggerRouter(t, func(r *gin.Engine) {
+		r.POST("/login", func(c *gin.Context) {
+			c.JSON(http.StatusOK, gin.H{"accessToken": "secret-access-token", "tokenType": "Bearer"})
+		})
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/login", strings.NewReader(`{"account":"alice","password":"hunter2"}`))
+	req.Header.Set("Content-Type", "application/json")
+	fields := loggedFields(t, r, logs, req)
+
+	reqBody, _ := fields["request_body"].(string)
+	respBody, _ := fields["response_body"].(string)
+	if strings.Contains(reqBody, "hunter2") || !strings.Contains(reqBody, "alice") {
+		t.Fatalf("request body not redacted: %s", reqBody)
+	}
+	if strings.Contains(respBody, "secret-access-token") || !strings.Contains(respBody, "Bearer") {
+		t.Fatalf("response body not redacted: %s", respBody)
+	}
+}
+
+func TestLoggerSkipsDownloads(t *testing.T) {
+	r, logs := newLoggerRouter(t, func(r *gin.Engine) {
+		r.GET("/exports/:id/download", func(c *gin.Context) {
+			c.Header("Content-Disposition", `attachment; filename="data-export-1.json"`)
+			c.Data(http.StatusOK, "application/json", []byte(`{"user":{"email":"alice@example.com"}}`))
+		})
+		r.GET("/report", func(c *gin.Context) {
+			c.Header("Content-Disposition", `attachment; filename="report.json"`)
+			c.Data(http.StatusOK, "application/json", []byte(`{"email":"alice@example.com"}`))
+		})
+		r.GET("/raw", func(c *gin.Context) {
+			c.Data(http.StatusOK, "application/octet-stream", []byte("alice@example.com"))
+		})
+	})
+
+	for _, target := range []string{"/exports/1/download?expires=1700000000&signature=abc", "/report", "/raw"} {
+		fields := loggedFields(t, r, logs, httptest.NewRequest(http.MethodGet, target, nil))
+		if body, _ := fields["response_body"].(string); body != "" {
+			t.Fatalf("%s: response body logged: %s", target, body)
+		}
+	}
+}
+
+func TestLoggerRedactsQuery(t *testing.T) {
+	r, logs := newLoggerRouter(t, func(r *gin.Engine) {
+		r.GET("/exports/:id/download", func(c *gin.Context) { c.Status(http.StatusNotFound) })
+	})
+
+	fields := loggedFields(t, r, logs, httptest.NewRequest(http.MethodGet, "/exports/1/download?expires=1700000000&Signature=abc%2Fdef", nil))
+	query, _ := fields["query"].(string)
+	if strings.Contains(query, "abc") || !strings.Contains(query, "expires=1700000000") {
+		t.Fatalf("query not redacted: %s", query)
+	}
+}
+
+func TestLoggerSkipsUploads(t *testing.T) {
+	var received int64
+	r, logs := newLoggerRouter(t, func(r *gin.Engine) {
+		r.POST("/upload", func(c *gin.Context) {
+			f, _, err := c.Request.FormFile("file")
+			if err != nil {
+				c.Status(http.StatusBadRequest)
+				return
+			}
+			defer f.Close()
+			received, _ = io.Copy(io.Discard, f)
+			c.JSON(http.StatusOK, gin.H{"size": received})
+		})
+		r.GET("/files/:id", func(c *gin.Context) {
+			c.Data(http.StatusOK, "image/png", []byte("\x89PNG file bytes"))
+		})
+	})
+
+	content := bytes.Repeat([]byte("x"), 1<<20)
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+	fw, _ := mw.CreateFormFile("file", "avatar.png")
+	_, _ = fw.Write(content)
+	_ = mw.Close()
+	req := httptest.NewRequest(http.MethodPost, "/upload", &buf)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+
+	fields := loggedFields(t, r, logs, req)
+	if received != int64(len(content)) {
+		t.Fatalf("handler received %d bytes, want %d", received, len(content))
+	}
+	if body, _ := fields["request_body"].(string); body != "" {
+		t.Fatalf("multipart request body logged (%d bytes)", len(body))
+	}
+
+	fields = loggedFields(t, r, logs, httptest.NewRequest(http.MethodGet, "/files/1?expires=1700000000&signature=abc", nil))
+	if body, _ := fields["response_body"].(string); body != "" {
+		t.Fatalf("file response body logged: %q", body)
+	}
+	if query, _ := fields["query"].(string); strings.Contains(query, "abc") {
+		t.Fatalf("file link signature logged: %s", query)
+	}
+}
+
+func TestLoggerTruncatesLargeBodies(t *testing.T) {
+	large := `{"password":"hunter2","data":"` + strings.Repeat("x", 128<<10) + `"}`
+	var received int
+	r, logs := newLoggerRouter(t, func(r *gin.Engine) {
+		r.POST("/echo", func(c *gin.Context) {
+			body, _ := io.ReadAll(c.Request.Body)
+			received = len(body)
+			c.Data(http.StatusOK, "application/json", body)
+		})
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/echo", strings.NewReader(large))
+	req.Header.Set("Content-Type", "application/json")
+	fields := loggedFields(t, r, logs, req)
+
+	if received != len(large) {
+		t.Fatalf("handler received %d bytes, want %d", received, len(large))
+	}
+	for _, name := range []string{"request_body", "response_body"} {
+		if body, _ := fields[name].(string); body != truncatedValue {
+			t.Fatalf("%s = %.40q..., want %s", name, body, truncatedValue)
+		}
+	}
+}
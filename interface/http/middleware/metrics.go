@@ -26,11 +26,47 @@ var (
 		},
 		[]string{"method", "path", "status"},
 	)
+
+	authLoginFailuresTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "auth_login_failures_total",
+			Help: "Total number of failed logins",
+		},
+		[]string{"scope"},
+	)
+
+	authLockoutsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "auth_lockouts_total",
+			Help: "Total number of login lockouts",
+		},
+		[]string{"scope"},
+	)
 )
 
 func init() {
 	prometheus.MustRegister(httpRequestsTotal)
 	prometheus.MustRegister(httpRequestDuration)
+	prometheus.MustRegister(authLoginFailuresTotal)
+	prometheus.MustRegister(authLockoutsTotal)
+}
+
+// AuthMetrics 将登录失败保护事件导出到 Prometheus，实现 auth.GuardMetrics
+type AuthMetrics struct{}
+
+// NewAuthMetrics 创建登录失败保护指标
+func NewAuthMetrics() *AuthMetrics {
+	return &AuthMetrics{}
+}
+
+// LoginFailed 记录一次登录失败
+func (AuthMetrics) LoginFailed(scope string) {
+	authLoginFailuresTotal.WithLabelValues(scope).Inc()
+}
+
+// LockedOut 记录一次锁定
+func (AuthMetrics) LockedOut(scope string) {
+	authLockoutsTotal.WithLabelValues(scope).Inc()
 }
 
 // Metrics 性能监控中间件
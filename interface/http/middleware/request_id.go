@@ -1,6 +1,8 @@
 package middleware
 
 import (
+	"go-web/pkg/requestid"
+
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
@@ -26,10 +28,10 @@ func RequestID(logger *zap.Logger) gin.HandlerFunc {
 
 		// 将请求 ID 设置到上下文中，方便后续使用
 		c.Set(RequestIDKey, requestID)
+		c.Request = c.Request.WithContext(requestid.NewContext(c.Request.Context(), requestID))
 
-		// 将请求 ID 添加到日志中
-		logger = logger.With(zap.String("request_id", requestID))
-		c.Set("logger", logger)
+		// 将请求 ID 添加到日志中，每个请求使用独立的 logger
+		c.Set("logger", logger.With(zap.String("request_id", requestID)))
 
 		c.Next()
 	}
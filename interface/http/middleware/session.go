@@ -0,0 +1,120 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"go-web/pkg/auth"
+	"go-web/pkg/config"
+	"go-web/pkg/viewer"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// SessionConfig 是会话中间件的配置选项
+type SessionConfig struct {
+	// CookieName 会话 cookie 名称
+	CookieName string
+	// Domain cookie 域名
+	Domain string
+	// Path cookie 路径
+	Path string
+	// Secure 是否只在 HTTPS 连接中发送 cookie
+	Secure bool
+	// HTTPOnly 是否禁止脚本读取 cookie
+	HTTPOnly bool
+	// SameSite cookie 的 SameSite 属性
+	SameSite http.SameSite
+}
+
+// NewSessionConfig 根据配置文件创建会话中间件配置
+func NewSessionConfig(cfg *config.Config) *SessionConfig {
+	sc := cfg.Auth.Session
+	return &SessionConfig{
+		CookieName: sc.CookieName,
+		Domain:     sc.Domain,
+		Path:       sc.Path,
+		Secure:     sc.Secure,
+		HTTPOnly:   sc.HTTPOnly,
+		SameSite:   parseSameSite(sc.SameSite),
+	}
+}
+
+// sessionCookie 实现 auth.SessionCookie，通过 gin.Context 写入 cookie
+type sessionCookie struct {
+	c      *gin.Context
+	config *SessionConfig
+}
+
+// Set 写入会话 cookie，有效期与会话一致
+func (w *sessionCookie) Set(s *auth.Session) {
+	w.c.SetSameSite(w.config.SameSite)
+	w.c.SetCookie(w.config.CookieName, s.ID, int(time.Until(s.ExpiresAt).Seconds()), w.config.Path, w.config.Domain, w.config.Secure, w.config.HTTPOnly)
+}
+
+// Clear 清除会话 cookie
+func (w *sessionCookie) Clear() {
+	w.c.SetSameSite(w.config.SameSite)
+	w.c.SetCookie(w.config.CookieName, "", -1, w.config.Path, w.config.Domain, w.config.Secure, w.config.HTTPOnly)
+}
+
+// Session 返回一个会话中间件，根据会话 cookie 从 Redis 加载会话并将访问者写入请求 context
+func Session(logger *zap.Logger, store *auth.SessionStore, config *SessionConfig) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		writer := &sessionCookie{c: c, config: config}
+		ctx := auth.WithSessionCookie(c.Request.Context(), writer)
+
+		id, err := c.Cookie(config.CookieName)
+		if err != nil || id == "" {
+			c.Request = c.Request.WithContext(ctx)
+			c.Next()
+			return
+		}
+
+		sess, err := store.Get(ctx, id)
+		if err != nil {
+			logger.Error("failed to load session",
+				zap.Error(err),
+				zap.String("path", c.Request.URL.Path),
+				zap.String("request_id", c.GetString(RequestIDKey)),
+			)
+			c.Request = c.Request.WithContext(ctx)
+			c.Next()
+			return
+		}
+		if sess == nil {
+			// 会话已过期或被删除，清除失效的 cookie
+			writer.Clear()
+			c.Request = c.Request.WithContext(ctx)
+			c.Next()
+			return
+		}
+
+		// 滑动续期，同步顺延 cookie 有效期
+		writer.Set(sess)
+
+		ctx = auth.NewSessionContext(ctx, sess)
+		ctx = viewer.NewContext(ctx, &viewer.Viewer{
+			ID:          sess.UserID,
+			Roles:       sess.Roles,
+			Permissions: sess.Permissions,
+		})
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+	}
+}
+
+// parseSameSite 解析 SameSite 配置
+func parseSameSite(s string) http.SameSite {
+	switch strings.ToLower(s) {
+	case "strict":
+		return http.SameSiteStrictMode
+	case "none":
+		return http.SameSiteNoneMode
+	default:
+		return http.SameSiteLaxMode
+	}
+}
@@ -0,0 +1,152 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"go-web/pkg/auth"
+	"go-web/pkg/config"
+	goWebErrors "go-web/pkg/errors"
+	"go-web/pkg/tenancy"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// TenantConfig 是租户中间件的配置选项
+type TenantConfig struct {
+	// Header 指定租户标识的请求头
+	Header string
+	// BaseDomain 子域名解析的基础域名，为空时不按子域名解析
+	BaseDomain string
+	// Default 请求未指定租户且未登录时使用的租户标识
+	Default string
+}
+
+// NewTenantConfig 根据配置文件创建租户中间件配置
+func NewTenantConfig(cfg *config.Config) *TenantConfig {
+	tc := cfg.Tenant
+	return &TenantConfig{
+		Header:     tc.Header,
+		BaseDomain: strings.ToLower(strings.TrimPrefix(tc.BaseDomain, ".")),
+		Default:    tc.Default,
+	}
+}
+
+// Tenant 返回一个租户解析中间件，必须在认证中间件之后使用。
+// 租户依次取自请求头、子域名、凭证中的租户，都没有时使用默认租户；
+// 凭证属于其他租户时拒绝请求，因此无法携带一个租户的凭证访问另一个租户的数据。
+func Tenant(logger *zap.Logger, resolver *tenancy.Resolver, config *TenantConfig) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		credentials, err := credentialTenants(c, resolver, config)
+		if err != nil {
+			abortTenant(c, logger, err)
+			return
+		}
+
+		var id uint64
+		if slug := requestedTenant(c, config); slug != "" {
+			if id, err = resolver.Resolve(ctx, slug); err != nil {
+				abortTenant(c, logger, err)
+				return
+			}
+		} else if len(credentials) > 0 {
+			id = credentials[0]
+		} else if id, err = resolver.Resolve(ctx, config.Default); err != nil {
+			abortTenant(c, logger, err)
+			return
+		}
+
+		for _, cid := range credentials {
+			if cid != id {
+				logger.Warn("tenant mismatch",
+					zap.Uint64("tenant_id", id),
+					zap.Uint64("credential_tenant_id", cid),
+					zap.String("path", c.Request.URL.Path),
+					zap.String("ip", c.ClientIP()),
+					zap.String("request_id", c.GetString(RequestIDKey)),
+				)
+				c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+					"code":    http.StatusForbidden,
+					"message": "Credentials belong to another tenant",
+				})
+				return
+			}
+		}
+
+		c.Request = c.Request.WithContext(tenancy.NewContext(ctx, id))
+		c.Next()
+	}
+}
+
+// requestedTenant 返回请求头或子域名指定的租户标识
+func requestedTenant(c *gin.Context, config *TenantConfig) string {
+	if config.Header != "" {
+		if slug := strings.TrimSpace(c.GetHeader(config.Header)); slug != "" {
+			return strings.ToLower(slug)
+		}
+	}
+	if config.BaseDomain == "" {
+		return ""
+	}
+	host := strings.ToLower(c.Request.Host)
+	if i := strings.LastIndexByte(host, ':'); i > strings.LastIndexByte(host, ']') {
+		host = host[:i]
+	}
+	sub, ok := strings.CutSuffix(host, "."+config.BaseDomain)
+	if !ok || sub == "" || strings.Contains(sub, ".") {
+		return ""
+	}
+	return sub
+}
+
+// credentialTenants 返回请求携带的令牌、会话与 API 密钥所属的租户。
+// 多租户之前签发的凭证没有租户，视为属于默认租户。
+func credentialTenants(c *gin.Context, resolver *tenancy.Resolver, config *TenantConfig) ([]uint64, error) {
+	ctx := c.Request.Context()
+	var ids []uint64
+	if claims := auth.ClaimsFromContext(ctx); claims != nil {
+		ids = append(ids, claims.TenantID)
+	}
+	if sess := auth.SessionFromContext(ctx); sess != nil {
+		ids = append(ids, sess.TenantID)
+	}
+	if key := auth.APIKeyFromContext(ctx); key != nil {
+		ids = append(ids, key.TenantID)
+	}
+	for i, id := range ids {
+		if id != 0 {
+			continue
+		}
+		id, err := resolver.Resolve(ctx, config.Default)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+// abortTenant 租户不存在时返回 404，其他错误返回 500
+func abortTenant(c *gin.Context, logger *zap.Logger, err error) {
+	var e *goWebErrors.Error
+	if errors.As(err, &e) && e.Code == goWebErrors.ErrNotFound {
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+			"code":    http.StatusNotFound,
+			"message": "Tenant not found",
+		})
+		return
+	}
+	logger.Error("failed to resolve tenant",
+		zap.Error(err),
+		zap.String("path", c.Request.URL.Path),
+		zap.String("request_id", c.GetString(RequestIDKey)),
+	)
+	c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+		"code":    http.StatusInternalServerError,
+		"message": "Internal Server Error",
+	})
+}
@@ -0,0 +1,162 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"go-web/ent"
+	"go-web/pkg/auth"
+	"go-web/pkg/config"
+	"go-web/pkg/tenancy"
+	"go-web/pkg/testutil"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// newTenantRouter 创建使用租户中间件的路由，响应当前租户 ID。
+// credentials 模拟认证中间件写入的凭证。
+func newTenantRouter(t *testing.T, credentials func(context.Context) context.Context) (*gin.Engine, *ent.Tenant, *ent.Tenant) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	client := testutil.NewClient(t)
+	acme, _ := testutil.NewTenant(t, client, "acme")
+	globex, _ := testutil.NewTenant(t, client, "globex")
+
+	cfg := &config.Config{}
+	cfg.Tenant.CacheTTL = time.Minute
+	tc := &TenantConfig{Header: "X-Tenant", BaseDomain: "example.com", Default: "acme"}
+
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		if credentials != nil {
+			c.Request = c.Request.WithContext(credentials(c.Request.Context()))
+		}
+	})
+	r.Use(Tenant(zap.NewNop(), tenancy.NewResolver(cfg, client), tc))
+	r.GET("/", func(c *gin.Context) {
+		id, _ := tenancy.FromContext(c.Request.Context())
+		c.String(http.StatusOK, strconv.FormatUint(id, 10))
+	})
+	return r, acme, globex
+}
+
+// tenantRequest 发送请求，返回状态码与响应中的租户 ID
+func tenantRequest(r http.Handler, host, header string) (int, string) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Host = host
+	if header != "" {
+		req.Header.Set("X-Tenant", header)
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w.Code, w.Body.String()
+}
+
+func TestTenantResolution(t *testing.T) {
+	r, acme, globex := newTenantRouter(t, nil)
+	acmeID := strconv.FormatUint(acme.ID, 10)
+	globexID := strconv.FormatUint(globex.ID, 10)
+
+	tests := []struct {
+		name       string
+		host       string
+		header     string
+		wantStatus int
+		wantTenant string
+	}{
+		{"default", "api.internal", "", http.StatusOK, acmeID},
+		{"header", "api.internal", "globex", http.StatusOK, globexID},
+		{"header is case insensitive", "api.internal", " GLOBEX ", http.StatusOK, globexID},
+		{"subdomain", "globex.example.com", "", http.StatusOK, globexID},
+		{"subdomain with port", "globex.example.com:8080", "", http.StatusOK, globexID},
+		{"header before subdomain", "globex.example.com", "acme", http.StatusOK, acmeID},
+		{"base domain", "example.com", "", http.StatusOK, acmeID},
+		{"nested subdomain", "a.globex.example.com", "", http.StatusOK, acmeID},
+		{"other domain", "globex.example.org", "", http.StatusOK, acmeID},
+		{"unknown header", "api.internal", "initech", http.StatusNotFound, ""},
+		{"unknown subdomain", "initech.example.com", "", http.StatusNotFound, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, body := tenantRequest(r, tt.host, tt.header)
+			if status != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (body %s)", status, tt.wantStatus, body)
+			}
+			if tt.wantTenant != "" && body != tt.wantTenant {
+				t.Fatalf("tenant = %s, want %s", body, tt.wantTenant)
+			}
+		})
+	}
+}
+
+func TestTenantFromCredentials(t *testing.T) {
+	var tenantID uint64
+	r, acme, globex := newTenantRouter(t, func(ctx context.Context) context.Context {
+		return auth.NewContext(ctx, &auth.Claims{TenantID: tenantID})
+	})
+
+	// 未指定租户时使用凭证所属的租户
+	tenantID = globex.ID
+	if status, body := tenantRequest(r, "api.internal", ""); status != http.StatusOK || body != strconv.FormatUint(globex.ID, 10) {
+		t.Fatalf("credential tenant = (%d, %s), want globex", status, body)
+	}
+	if status, _ := tenantRequest(r, "globex.example.com", "globex"); status != http.StatusOK {
+		t.Fatalf("matching tenant status = %d, want 200", status)
+	}
+
+	// 多租户之前签发的凭证属于默认租户
+	tenantID = 0
+	if status, body := tenantRequest(r, "api.internal", ""); status != http.StatusOK || body != strconv.FormatUint(acme.ID, 10) {
+		t.Fatalf("legacy credential tenant = (%d, %s), want acme", status, body)
+	}
+}
+
+func TestTenantRejectsCredentialMismatch(t *testing.T) {
+	tests := []struct {
+		name        string
+		credentials func(tenantID uint64) func(context.Context) context.Context
+	}{
+		{"token", func(id uint64) func(context.Context) context.Context {
+			return func(ctx context.Context) context.Context {
+				return auth.NewContext(ctx, &auth.Claims{TenantID: id})
+			}
+		}},
+		{"session", func(id uint64) func(context.Context) context.Context {
+			return func(ctx context.Context) context.Context {
+				return auth.NewSessionContext(ctx, &auth.Session{TenantID: id})
+			}
+		}},
+		{"api key", func(id uint64) func(context.Context) context.Context {
+			return func(ctx context.Context) context.Context {
+				return auth.NewAPIKeyContext(ctx, &ent.APIKey{TenantID: id})
+			}
+		}},
+		{"legacy token", func(uint64) func(context.Context) context.Context {
+			return func(ctx context.Context) context.Context {
+				return auth.NewContext(ctx, &auth.Claims{})
+			}
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var acmeID uint64
+			r, acme, _ := newTenantRouter(t, func(ctx context.Context) context.Context {
+				return tt.credentials(acmeID)(ctx)
+			})
+			acmeID = acme.ID
+
+			if status, body := tenantRequest(r, "api.internal", "globex"); status != http.StatusForbidden {
+				t.Fatalf("header for another tenant: status = %d (body %s), want 403", status, body)
+			}
+			if status, body := tenantRequest(r, "globex.example.com", ""); status != http.StatusForbidden {
+				t.Fatalf("subdomain of another tenant: status = %d (body %s), want 403", status, body)
+			}
+		})
+	}
+}
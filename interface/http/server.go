@@ -8,8 +8,12 @@ import (
 	"os"
 	"time"
 
+	"go-web/ent"
 	"go-web/interface/http/middleware"
+	"go-web/pkg/auth"
 	"go-web/pkg/cache"
+	"go-web/pkg/config"
+	"go-web/pkg/tenancy"
 
 	"github.com/gin-contrib/gzip"
 	gin_zap "github.com/gin-contrib/zap"
@@ -18,7 +22,12 @@ import (
 	"go.uber.org/zap"
 )
 
-var ProviderSet = wire.NewSet(NewServer, NewRouter)
+var ProviderSet = wire.NewSet(
+	NewServer,
+	NewRouter,
+	middleware.NewAuthMetrics,
+	wire.Bind(new(auth.GuardMetrics), new(*middleware.AuthMetrics)),
+)
 
 type Server struct {
 	logger     *zap.Logger
@@ -30,21 +39,39 @@ const closeWaitTime = 30 * time.Second
 
 type InitRoutersFunc func(r *gin.Engine)
 
-func NewRouter(logger *zap.Logger, redisClient *cache.RedisClient, initRoutersFunc InitRoutersFunc) *gin.Engine {
+func NewRouter(cfg *config.Config, logger *zap.Logger, redisClient *cache.RedisClient, tokens *auth.TokenService, sessions *auth.SessionStore, apiKeys *auth.APIKeyService, tenants *tenancy.Resolver, initRoutersFunc InitRoutersFunc) *gin.Engine {
 	gin.SetMode(gin.ReleaseMode)
 
 	r := gin.New()
 
 	r.Use(middleware.RequestID(logger))
 
+	r.Use(middleware.GinContext())
+
 	r.Use(middleware.RateLimit(logger, middleware.DefaultRateLimitConfig()))
 
-	r.Use(middleware.Logger(logger))
+	// 日志中脱敏 ent schema 中标记为 Sensitive 的字段
+	loggerConfig := middleware.DefaultLoggerConfig()
+	loggerConfig.RedactKeys = append(loggerConfig.RedactKeys, ent.SensitiveFields...)
+	r.Use(middleware.Logger(logger, loggerConfig))
 
 	r.Use(middleware.Security(middleware.DefaultSecurityConfig()))
 
 	r.Use(middleware.CSRF(logger, middleware.DefaultCSRFConfig()))
 
+	// 根据配置选择会话或 Bearer 令牌认证，两者写入相同的访问者信息
+	if cfg.Auth.Mode == auth.ModeSession {
+		r.Use(middleware.Session(logger, sessions, middleware.NewSessionConfig(cfg)))
+	} else {
+		r.Use(middleware.Auth(logger, tokens))
+	}
+
+	// 服务间调用使用 X-API-Key 认证
+	r.Use(middleware.APIKey(logger, apiKeys))
+
+	// 根据请求头、子域名或凭证确定租户，之后的数据访问都限定在该租户内
+	r.Use(middleware.Tenant(logger, tenants, middleware.NewTenantConfig(cfg)))
+
 	r.Use(middleware.Validator(logger, middleware.DefaultValidatorConfig()))
 
 	r.Use(middleware.Cache(logger, middleware.DefaultCacheConfig(redisClient)))
@@ -0,0 +1,38 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.40
+
+import (
+	"context"
+	"go-web/ent"
+	"go-web/pkg/auth"
+)
+
+// DeleteMyAccount is the resolver for the deleteMyAccount field.
+func (r *mutationResolver) DeleteMyAccount(ctx context.Context, password string) (*ent.AccountErasure, error) {
+	v, err := requireUserViewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.erasures.Schedule(ctx, v.ID, password)
+}
+
+// CancelAccountDeletion is the resolver for the cancelAccountDeletion field.
+func (r *mutationResolver) CancelAccountDeletion(ctx context.Context) (*ent.AccountErasure, error) {
+	v, err := requireUserViewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.erasures.Cancel(ctx, v.ID)
+}
+
+// AccountErasure is the resolver for the accountErasure field.
+func (r *queryResolver) AccountErasure(ctx context.Context) (*ent.AccountErasure, error) {
+	v, err := auth.RequireViewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.erasures.Current(ctx, v.ID)
+}
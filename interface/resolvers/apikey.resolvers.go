@@ -0,0 +1,47 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.40
+
+import (
+	"context"
+	"go-web/ent"
+	"go-web/graph/model"
+	"time"
+)
+
+// CreateAPIKey is the resolver for the createAPIKey field.
+func (r *mutationResolver) CreateAPIKey(ctx context.Context, name string, scopes []string, expiresAt *time.Time) (*model.CreateAPIKeyPayload, error) {
+	if _, err := requireUserViewer(ctx); err != nil {
+		return nil, err
+	}
+
+	key, plaintext, err := r.apiKeys.Create(ctx, name, scopes, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.CreateAPIKeyPayload{APIKey: key, Key: plaintext}, nil
+}
+
+// RevokeAPIKey is the resolver for the revokeAPIKey field.
+func (r *mutationResolver) RevokeAPIKey(ctx context.Context, id uint64) (bool, error) {
+	if _, err := requireUserViewer(ctx); err != nil {
+		return false, err
+	}
+
+	if err := r.apiKeys.Revoke(ctx, id); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// APIKeys is the resolver for the apiKeys field.
+func (r *queryResolver) APIKeys(ctx context.Context) ([]*ent.APIKey, error) {
+	if _, err := requireUserViewer(ctx); err != nil {
+		return nil, err
+	}
+
+	return r.apiKeys.List(ctx)
+}
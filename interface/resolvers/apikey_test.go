@@ -0,0 +1,84 @@
+package resolvers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go-web/ent"
+	"go-web/pkg/auth"
+	"go-web/pkg/config"
+	goWebErrors "go-web/pkg/errors"
+	"go-web/pkg/testutil"
+	"go-web/pkg/viewer"
+
+	"go.uber.org/zap"
+)
+
+// apiKeyContext 返回通过 API 密钥认证的访问者 context，授权范围包含全部权限
+func apiKeyContext(ctx context.Context, userID uint64) context.Context {
+	ctx = auth.NewAPIKeyContext(ctx, &ent.APIKey{ID: 1, OwnerID: userID})
+	return viewer.NewContext(ctx, &viewer.Viewer{
+		ID:          userID,
+		Permissions: []string{"user:read", "user:write", "api_key:write"},
+	})
+}
+
+// isForbidden 是否为 ErrForbidden 业务错误
+func isForbidden(err error) bool {
+	var e *goWebErrors.Error
+	return errors.As(err, &e) && e.Code == goWebErrors.ErrForbidden
+}
+
+func TestAPIKeyResolversRejectAPIKeys(t *testing.T) {
+	client := testutil.NewClient(t)
+	_, ctx := testutil.NewTenant(t, client, "acme")
+	r := &Resolver{client: client, apiKeys: auth.NewAPIKeyService(client, zap.NewNop())}
+	ctx = apiKeyContext(ctx, 1)
+
+	if _, err := r.Query().APIKeys(ctx); !isForbidden(err) {
+		t.Fatalf("apiKeys with an api key: err = %v, want ErrForbidden", err)
+	}
+	if _, err := r.Mutation().RevokeAPIKey(ctx, 1); !isForbidden(err) {
+		t.Fatalf("revokeAPIKey with an api key: err = %v, want ErrForbidden", err)
+	}
+	if _, err := r.Mutation().CreateAPIKey(ctx, "nested", []string{"user:read"}, nil); !isForbidden(err) {
+		t.Fatalf("createAPIKey with an api key: err = %v, want ErrForbidden", err)
+	}
+}
+
+func TestLogoutAllSessionsRejectsAPIKeys(t *testing.T) {
+	client := testutil.NewClient(t)
+	_, ctx := testutil.NewTenant(t, client, "acme")
+	rdb, _ := testutil.NewRedis(t)
+	u, err := client.User.Create().
+		SetName("alice").
+		SetSex(false).
+		SetAge(30).
+		SetAccount("alice").
+		SetPassword("correct horse").
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := &config.Config{}
+	cfg.Auth.JWT.Secret = "k3J9vQ2xT7mR4wZ8pL1nB6cF5hD0sG3y"
+	cfg.Auth.JWT.AccessTTL = time.Minute
+	cfg.Auth.JWT.RefreshTTL = time.Hour
+	cfg.Auth.Session.TTL = time.Hour
+	tokens := auth.NewTokenService(auth.NewTokenManager(cfg), rdb, client, zap.NewNop())
+	r := &Resolver{client: client, tokens: tokens, sessions: auth.NewSessionStore(cfg, rdb, client, zap.NewNop())}
+
+	pair, err := tokens.Login(ctx, u.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Mutation().LogoutAllSessions(apiKeyContext(ctx, u.ID)); !isForbidden(err) {
+		t.Fatalf("logoutAllSessions with an api key: err = %v, want ErrForbidden", err)
+	}
+	if _, err := tokens.Validate(ctx, pair.AccessToken); err != nil {
+		t.Fatalf("session revoked by an api key: %v", err)
+	}
+}
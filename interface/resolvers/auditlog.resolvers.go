@@ -0,0 +1,34 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.40
+
+import (
+	"context"
+	"fmt"
+	"go-web/ent"
+
+	"entgo.io/contrib/entgql"
+)
+
+// AuditLogs is the resolver for the auditLogs field.
+func (r *queryResolver) AuditLogs(ctx context.Context, after *entgql.Cursor[uint64], first *int, before *entgql.Cursor[uint64], last *int, orderBy *ent.AuditLogOrder, where *ent.AuditLogWhereInput) (*ent.AuditLogConnection, error) {
+	first, last, err := pageBounds(first, last)
+	if err != nil {
+		return nil, err
+	}
+	if orderBy == nil {
+		orderBy = &ent.AuditLogOrder{Direction: entgql.OrderDirectionDesc, Field: ent.AuditLogOrderFieldCreatedAt}
+	}
+
+	conn, err := r.client.AuditLog.Query().
+		Paginate(ctx, after, first, before, last,
+			ent.WithAuditLogOrder(orderBy),
+			ent.WithAuditLogFilter(where.Filter),
+		)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query audit logs: %w", err)
+	}
+	return conn, nil
+}
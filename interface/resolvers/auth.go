@@ -0,0 +1,90 @@
+package resolvers
+
+import (
+	"context"
+	"time"
+
+	"go-web/graph/model"
+	"go-web/pkg/auth"
+	goWebErrors "go-web/pkg/errors"
+	"go-web/pkg/viewer"
+)
+
+const (
+	// tokenTypeBearer 令牌类型，对应 Authorization 请求头的认证方案
+	tokenTypeBearer = "Bearer"
+	// tokenTypeSession 会话模式，凭证仅通过 cookie 下发
+	tokenTypeSession = "Session"
+	// tokenTypeSecondFactor 等待两步验证，只下发挑战令牌
+	tokenTypeSecondFactor = "SecondFactor"
+)
+
+// newAuthPayload 将令牌对转换为 GraphQL 返回值
+func newAuthPayload(pair *auth.TokenPair) *model.AuthPayload {
+	return &model.AuthPayload{
+		AccessToken:  &pair.AccessToken,
+		RefreshToken: &pair.RefreshToken,
+		TokenType:    tokenTypeBearer,
+		ExpiresAt:    pair.ExpiresAt,
+	}
+}
+
+// newSessionPayload 将会话转换为 GraphQL 返回值，会话 ID 不出现在响应体中
+func newSessionPayload(sess *auth.Session) *model.AuthPayload {
+	return &model.AuthPayload{
+		TokenType: tokenTypeSession,
+		ExpiresAt: sess.ExpiresAt,
+	}
+}
+
+// newChallengePayload 返回需要两步验证的登录结果
+func newChallengePayload(token string, expiresAt time.Time) *model.AuthPayload {
+	return &model.AuthPayload{
+		TokenType:            tokenTypeSecondFactor,
+		ExpiresAt:            expiresAt,
+		SecondFactorRequired: true,
+		ChallengeToken:       &token,
+	}
+}
+
+// issueCredentials 根据认证方式为用户签发令牌对或创建会话
+func (r *Resolver) issueCredentials(ctx context.Context, userID uint64) (*model.AuthPayload, error) {
+	if r.authMode == auth.ModeSession {
+		return r.loginSession(ctx, userID)
+	}
+
+	pair, err := r.tokens.Login(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return newAuthPayload(pair), nil
+}
+
+// requireUserViewer 返回以用户凭证登录的访问者，API 密钥不能管理账号安全设置
+func requireUserViewer(ctx context.Context) (*viewer.Viewer, error) {
+	v, err := auth.RequireViewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if auth.APIKeyFromContext(ctx) != nil {
+		return nil, goWebErrors.New(goWebErrors.ErrForbidden, "forbidden", "not allowed with an api key")
+	}
+	return v, nil
+}
+
+// loginSession 为用户创建新会话并写入 cookie
+func (r *Resolver) loginSession(ctx context.Context, userID uint64) (*model.AuthPayload, error) {
+	sess, err := r.sessions.Login(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return newSessionPayload(sess), nil
+}
+
+// clientIP 返回客户端 IP，无法获取 gin.Context 时返回空字符串
+func clientIP(ctx context.Context) string {
+	if c := GinContextFromContext(ctx); c != nil {
+		return c.ClientIP()
+	}
+	return ""
+}
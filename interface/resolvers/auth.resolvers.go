@@ -0,0 +1,123 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.40
+
+import (
+	"context"
+	"fmt"
+	"go-web/graph/model"
+	"go-web/pkg/auth"
+	goWebErrors "go-web/pkg/errors"
+)
+
+// Login is the resolver for the login field.
+func (r *mutationResolver) Login(ctx context.Context, account string, password string) (*model.AuthPayload, error) {
+	ip := clientIP(ctx)
+	if err := r.guard.Check(ctx, account, ip); err != nil {
+		return nil, err
+	}
+
+	u, err := r.authenticator.Authenticate(ctx, account, password)
+	if err != nil {
+		if e, ok := err.(*goWebErrors.Error); ok && e.Code == goWebErrors.ErrUnauthorized {
+			if guardErr := r.guard.Failure(ctx, account, ip); guardErr != nil {
+				return nil, guardErr
+			}
+		}
+		return nil, err
+	}
+
+	// 启用两步验证时，验证码通过后才清除失败记录
+	if u.TotpEnabled {
+		token, expiresAt, err := r.twoFactor.Challenge(ctx, u.ID)
+		if err != nil {
+			return nil, err
+		}
+		return newChallengePayload(token, expiresAt), nil
+	}
+
+	if err := r.guard.Success(ctx, account); err != nil {
+		return nil, err
+	}
+	return r.issueCredentials(ctx, u.ID)
+}
+
+// VerifyTwoFactor is the resolver for the verifyTwoFactor field.
+func (r *mutationResolver) VerifyTwoFactor(ctx context.Context, challengeToken string, code string) (*model.AuthPayload, error) {
+	userID, err := r.twoFactor.VerifyChallenge(ctx, challengeToken, code, clientIP(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return r.issueCredentials(ctx, userID)
+}
+
+// RefreshToken is the resolver for the refreshToken field.
+func (r *mutationResolver) RefreshToken(ctx context.Context, refreshToken string) (*model.AuthPayload, error) {
+	if r.authMode == auth.ModeSession {
+		return nil, goWebErrors.New(goWebErrors.ErrInvalidState, "invalid_state", "refresh tokens are not used in session mode")
+	}
+
+	pair, err := r.tokens.Refresh(ctx, refreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return newAuthPayload(pair), nil
+}
+
+// Logout is the resolver for the logout field.
+func (r *mutationResolver) Logout(ctx context.Context) (bool, error) {
+	if _, err := auth.RequireViewer(ctx); err != nil {
+		return false, err
+	}
+
+	if sess := auth.SessionFromContext(ctx); sess != nil {
+		if err := r.sessions.Destroy(ctx, sess); err != nil {
+			return false, fmt.Errorf("failed to logout: %w", err)
+		}
+		if cookie := auth.SessionCookieFromContext(ctx); cookie != nil {
+			cookie.Clear()
+		}
+		return true, nil
+	}
+
+	claims := auth.ClaimsFromContext(ctx)
+	if claims == nil {
+		return false, goWebErrors.New(goWebErrors.ErrUnauthorized, "unauthorized")
+	}
+
+	if err := r.tokens.Logout(ctx, claims); err != nil {
+		return false, fmt.Errorf("failed to logout: %w", err)
+	}
+	return true, nil
+}
+
+// LogoutAllSessions is the resolver for the logoutAllSessions field.
+func (r *mutationResolver) LogoutAllSessions(ctx context.Context) (bool, error) {
+	v, err := requireUserViewer(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if err := r.tokens.LogoutAll(ctx, v.ID); err != nil {
+		return false, fmt.Errorf("failed to logout all sessions: %w", err)
+	}
+	if err := r.sessions.DestroyAll(ctx, v.ID); err != nil {
+		return false, fmt.Errorf("failed to logout all sessions: %w", err)
+	}
+	if cookie := auth.SessionCookieFromContext(ctx); cookie != nil {
+		cookie.Clear()
+	}
+	return true, nil
+}
+
+// UnlockAccount is the resolver for the unlockAccount field.
+func (r *mutationResolver) UnlockAccount(ctx context.Context, account string) (bool, error) {
+	if err := r.guard.Unlock(ctx, account); err != nil {
+		return false, fmt.Errorf("failed to unlock account: %w", err)
+	}
+	return true, nil
+}
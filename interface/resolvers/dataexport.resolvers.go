@@ -0,0 +1,58 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.40
+
+import (
+	"context"
+	"fmt"
+	"go-web/ent"
+	graph "go-web/graph/generated"
+	"go-web/pkg/auth"
+)
+
+// DownloadURL is the resolver for the downloadURL field.
+func (r *dataExportResolver) DownloadURL(ctx context.Context, obj *ent.DataExport) (*string, error) {
+	link, _ := r.exports.DownloadURL(obj)
+	if link == "" {
+		return nil, nil
+	}
+	return &link, nil
+}
+
+// ExportMyData is the resolver for the exportMyData field.
+func (r *mutationResolver) ExportMyData(ctx context.Context) (*ent.DataExport, error) {
+	v, err := requireUserViewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.exports.Request(ctx, v.ID)
+}
+
+// ExportUserData is the resolver for the exportUserData field.
+func (r *mutationResolver) ExportUserData(ctx context.Context, userID uint64) (*ent.DataExport, error) {
+	return r.exports.Request(ctx, userID)
+}
+
+// DataExport is the resolver for the dataExport field.
+func (r *queryResolver) DataExport(ctx context.Context, id uint64) (*ent.DataExport, error) {
+	if _, err := auth.RequireViewer(ctx); err != nil {
+		return nil, err
+	}
+
+	// 访问者无权读取的导出被隐私策略过滤，与不存在的导出一样返回 null
+	e, err := r.client.DataExport.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to query data export: %w", err)
+	}
+	return e, nil
+}
+
+// DataExport returns graph.DataExportResolver implementation.
+func (r *Resolver) DataExport() graph.DataExportResolver { return &dataExportResolver{r} }
+
+type dataExportResolver struct{ *Resolver }
@@ -0,0 +1,152 @@
+package resolvers
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"go-web/ent"
+	"go-web/ent/audit"
+	"go-web/ent/dataexport"
+	"go-web/pkg/config"
+	"go-web/pkg/export"
+	"go-web/pkg/testutil"
+	"go-web/pkg/viewer"
+
+	"go.uber.org/zap"
+)
+
+// createExportSubject 创建用户及其外部身份、API 密钥、文件与审计记录，返回用户本人的访问者 context
+func createExportSubject(t *testing.T, ctx context.Context, client *ent.Client, account string) (*ent.User, context.Context) {
+	t.Helper()
+	u, err := client.User.Create().
+		SetName(account).
+		SetSex(false).
+		SetAge(30).
+		SetAccount(account).
+		SetPassword("correct horse").
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.Identity.Create().
+		SetProvider("github").
+		SetIssuer("https://github.com").
+		SetSubject(account + "-subject").
+		SetUserID(u.ID).
+		ExecX(ctx)
+	client.APIKey.Create().
+		SetName(account + "-key").
+		SetPrefix(account + "-prefix").
+		SetSecretHash(strings.Repeat("0", 64)).
+		SetOwnerID(u.ID).
+		ExecX(ctx)
+	client.File.Create().
+		SetName(account + ".png").
+		SetSize(1).
+		SetContentType("image/png").
+		SetChecksum(strings.Repeat("0", 64)).
+		SetOwnerID(u.ID).
+		ExecX(ctx)
+
+	self := viewer.NewContext(ctx, &viewer.Viewer{ID: u.ID})
+	client.User.UpdateOne(u).SetAge(31).ExecX(self)
+	return u, self
+}
+
+// waitExport 等待导出完成
+func waitExport(t *testing.T, ctx context.Context, client *ent.Client, id uint64) *ent.DataExport {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		e := client.DataExport.GetX(ctx, id)
+		switch e.Status {
+		case dataexport.StatusCompleted:
+			return e
+		case dataexport.StatusFailed:
+			t.Fatalf("data export failed: %v", e.Error)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("data export did not complete")
+	return nil
+}
+
+func TestExportMyDataCollectsOwnEntities(t *testing.T) {
+	client := testutil.NewClient(t)
+	client.Use(audit.Hook())
+	_, ctx := testutil.NewTenant(t, client, "acme")
+
+	cfg := &config.Config{}
+	cfg.Export.Dir = t.TempDir()
+	cfg.Export.SigningKey = "e4Tq8Wz1Nc6Ym3Hp9Kx2Lv5Rb7Dg0Sj4"
+	cfg.Export.LinkTTL = time.Hour
+	cfg.Export.Retention = time.Hour
+	exports, err := export.NewService(cfg, client, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &Resolver{client: client, exports: exports}
+
+	alice, self := createExportSubject(t, ctx, client, "alice")
+	bob, _ := createExportSubject(t, ctx, client, "bob")
+
+	e, err := r.Mutation().ExportMyData(self)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.UserID != alice.ID {
+		t.Fatalf("export user = %d, want the viewer %d", e.UserID, alice.ID)
+	}
+	e = waitExport(t, ctx, client, e.ID)
+
+	link, _ := exports.DownloadURL(e)
+	u, err := url.Parse(link)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expires, _ := strconv.ParseInt(u.Query().Get("expires"), 10, 64)
+	f, err := exports.Open(e.ID, time.Unix(expires, 0), u.Query().Get("signature"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	raw, err := io.ReadAll(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 归档中不包含其他用户的任何数据
+	if strings.Contains(string(raw), strconv.FormatUint(bob.ID, 10)) || strings.Contains(string(raw), "bob") {
+		t.Fatalf("archive contains another user's data:\n%s", raw)
+	}
+
+	var archive struct {
+		UserID string                     `json:"user_id"`
+		Data   map[string]json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(raw, &archive); err != nil {
+		t.Fatal(err)
+	}
+	if archive.UserID != strconv.FormatUint(alice.ID, 10) {
+		t.Fatalf("archive user = %s, want %d", archive.UserID, alice.ID)
+	}
+	for _, name := range []string{"api_keys", "identities", "files"} {
+		var items []json.RawMessage
+		if err := json.Unmarshal(archive.Data[name], &items); err != nil {
+			t.Fatalf("section %s: %v", name, err)
+		}
+		if len(items) != 1 {
+			t.Fatalf("section %s has %d entries, want the viewer's 1", name, len(items))
+		}
+	}
+	var logs []json.RawMessage
+	if err := json.Unmarshal(archive.Data["audit_logs"], &logs); err != nil || len(logs) == 0 {
+		t.Fatalf("audit_logs section = %s, want the viewer's audit logs", archive.Data["audit_logs"])
+	}
+}
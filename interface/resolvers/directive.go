@@ -0,0 +1,43 @@
+package resolvers
+
+import (
+	"context"
+
+	generated "go-web/graph/generated"
+	"go-web/pkg/auth"
+	goWebErrors "go-web/pkg/errors"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// newDirectiveRoot 返回 schema 中声明的指令实现
+func newDirectiveRoot() generated.DirectiveRoot {
+	return generated.DirectiveRoot{
+		HasRole:       hasRole,
+		HasPermission: hasPermission,
+	}
+}
+
+// hasRole 实现 @hasRole，要求访问者拥有指定角色
+func hasRole(ctx context.Context, obj interface{}, next graphql.Resolver, role string) (interface{}, error) {
+	v, err := auth.RequireViewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !v.HasRole(role) {
+		return nil, goWebErrors.New(goWebErrors.ErrForbidden, "forbidden", "role "+role+" required")
+	}
+	return next(ctx)
+}
+
+// hasPermission 实现 @hasPermission，要求访问者拥有指定权限
+func hasPermission(ctx context.Context, obj interface{}, next graphql.Resolver, permission string) (interface{}, error) {
+	v, err := auth.RequireViewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !v.HasPermission(permission) {
+		return nil, goWebErrors.New(goWebErrors.ErrForbidden, "forbidden", "permission "+permission+" required")
+	}
+	return next(ctx)
+}
@@ -0,0 +1,41 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.40
+
+import (
+	"context"
+	"go-web/ent"
+	graph "go-web/graph/generated"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// URL is the resolver for the url field.
+func (r *fileResolver) URL(ctx context.Context, obj *ent.File) (string, error) {
+	return r.uploads.URL(obj), nil
+}
+
+// UploadAvatar is the resolver for the uploadAvatar field.
+func (r *mutationResolver) UploadAvatar(ctx context.Context, file graphql.Upload) (*ent.User, error) {
+	v, err := requireUserViewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.uploads.SetAvatar(ctx, v.ID, file.Filename, file.File, file.Size)
+}
+
+// RemoveAvatar is the resolver for the removeAvatar field.
+func (r *mutationResolver) RemoveAvatar(ctx context.Context) (*ent.User, error) {
+	v, err := requireUserViewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.uploads.RemoveAvatar(ctx, v.ID)
+}
+
+// File returns graph.FileResolver implementation.
+func (r *Resolver) File() graph.FileResolver { return &fileResolver{r} }
+
+type fileResolver struct{ *Resolver }
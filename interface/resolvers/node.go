@@ -0,0 +1,71 @@
+package resolvers
+
+import (
+	"context"
+	"errors"
+
+	"go-web/ent"
+	"go-web/ent/accounterasure"
+	"go-web/ent/apikey"
+	"go-web/ent/auditlog"
+	"go-web/ent/dataexport"
+	"go-web/ent/file"
+	"go-web/ent/privacy"
+	"go-web/ent/tenant"
+	"go-web/ent/user"
+
+	"entgo.io/contrib/entgql"
+)
+
+// maxNodes nodes 查询一次最多解析的 ID 数量
+const maxNodes = 100
+
+// nodeTable 实现 Node 接口的实体表及其存在性查询
+type nodeTable struct {
+	table string
+	exist func(ctx context.Context, client *ent.Client, id uint64) (bool, error)
+}
+
+// nodeTables 实现 Node 接口的实体，按被查询的频率排列。
+// ID 由 sonyflake 生成，无法像自增 ID 那样从取值范围推断实体类型，因此依次在各表中查找。
+var nodeTables = []nodeTable{
+	{user.Table, func(ctx context.Context, client *ent.Client, id uint64) (bool, error) {
+		return client.User.Query().Where(user.ID(id)).Exist(ctx)
+	}},
+	{apikey.Table, func(ctx context.Context, client *ent.Client, id uint64) (bool, error) {
+		return client.APIKey.Query().Where(apikey.ID(id)).Exist(ctx)
+	}},
+	{tenant.Table, func(ctx context.Context, client *ent.Client, id uint64) (bool, error) {
+		return client.Tenant.Query().Where(tenant.ID(id)).Exist(ctx)
+	}},
+	{auditlog.Table, func(ctx context.Context, client *ent.Client, id uint64) (bool, error) {
+		return client.AuditLog.Query().Where(auditlog.ID(id)).Exist(ctx)
+	}},
+	{dataexport.Table, func(ctx context.Context, client *ent.Client, id uint64) (bool, error) {
+		return client.DataExport.Query().Where(dataexport.ID(id)).Exist(ctx)
+	}},
+	{accounterasure.Table, func(ctx context.Context, client *ent.Client, id uint64) (bool, error) {
+		return client.AccountErasure.Query().Where(accounterasure.ID(id)).Exist(ctx)
+	}},
+	{file.Table, func(ctx context.Context, client *ent.Client, id uint64) (bool, error) {
+		return client.File.Query().Where(file.ID(id)).Exist(ctx)
+	}},
+}
+
+// nodeType 返回 ID 所属的实体表。查询经过隐私策略与租户过滤，
+// 访问者无权读取的实体与不存在的实体一样返回 ErrNodeNotFound，不泄露其类型。
+func (r *Resolver) nodeType(ctx context.Context, id uint64) (string, error) {
+	for _, t := range nodeTables {
+		ok, err := t.exist(ctx, r.client, id)
+		if err != nil {
+			if errors.Is(err, privacy.Deny) {
+				continue
+			}
+			return "", err
+		}
+		if ok {
+			return t.table, nil
+		}
+	}
+	return "", entgql.ErrNodeNotFound(id)
+}
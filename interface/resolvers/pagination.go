@@ -0,0 +1,70 @@
+package resolvers
+
+import (
+	"fmt"
+
+	"go-web/ent"
+	generated "go-web/graph/generated"
+	goWebErrors "go-web/pkg/errors"
+
+	"entgo.io/contrib/entgql"
+)
+
+const (
+	// defaultPageSize 连接查询未指定 first 与 last 时返回的数量
+	defaultPageSize = 20
+	// maxPageSize 连接查询一次最多返回的数量
+	maxPageSize = 100
+)
+
+// pageBounds 校验分页参数，都未指定时返回第一页，避免一次读取全部记录
+func pageBounds(first, last *int) (*int, *int, error) {
+	for _, n := range []*int{first, last} {
+		if n != nil && (*n < 0 || *n > maxPageSize) {
+			return nil, nil, goWebErrors.New(goWebErrors.ErrInvalidParam, "invalid_param", fmt.Sprintf("first and last must be between 0 and %d", maxPageSize))
+		}
+	}
+	if first == nil && last == nil {
+		n := defaultPageSize
+		first = &n
+	}
+	return first, last, nil
+}
+
+// pageComplexity 连接查询的复杂度为单个节点的复杂度乘以返回数量
+func pageComplexity(childComplexity int, first, last *int) int {
+	n := defaultPageSize
+	switch {
+	case first != nil:
+		n = *first
+	case last != nil:
+		n = *last
+	}
+	return childComplexity * max(n, 1)
+}
+
+// setComplexity 为返回列表的查询设置与返回数量成正比的复杂度，配合 maxQueryComplexity 限制单次查询的开销
+func setComplexity(c *generated.ComplexityRoot) {
+	c.Query.Nodes = func(childComplexity int, ids []uint64) int {
+		return childComplexity * max(len(ids), 1)
+	}
+	c.Query.Users = func(childComplexity int, _ *entgql.Cursor[uint64], first *int, _ *entgql.Cursor[uint64], last *int, _ []*ent.UserOrder, _ *ent.UserWhereInput) int {
+		return pageComplexity(childComplexity, first, last)
+	}
+	c.Query.AuditLogs = func(childComplexity int, _ *entgql.Cursor[uint64], first *int, _ *entgql.Cursor[uint64], last *int, _ *ent.AuditLogOrder, _ *ent.AuditLogWhereInput) int {
+		return pageComplexity(childComplexity, first, last)
+	}
+}
+
+// checkCursors 多字段排序的游标保存了生成时各排序字段的值，与本次排序字段数量不一致时游标无法使用
+func checkCursors(orderFields int, cursors ...*entgql.Cursor[uint64]) error {
+	for _, c := range cursors {
+		if c == nil || c.Value == nil {
+			continue
+		}
+		if values, ok := c.Value.([]any); !ok || len(values) != orderFields {
+			return goWebErrors.New(goWebErrors.ErrInvalidParam, "invalid_param", "cursor does not match orderBy")
+		}
+	}
+	return nil
+}
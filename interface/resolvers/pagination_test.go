@@ -0,0 +1,207 @@
+package resolvers
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"go-web/ent"
+	generated "go-web/graph/generated"
+	goWebErrors "go-web/pkg/errors"
+	"go-web/pkg/testutil"
+
+	"entgo.io/contrib/entgql"
+)
+
+// paginationFixture 名称与年龄存在重复的用户
+type paginationFixture struct {
+	ctx   context.Context
+	query generated.QueryResolver
+	users []*ent.User
+}
+
+func newPaginationFixture(t *testing.T) *paginationFixture {
+	t.Helper()
+	client := testutil.NewClient(t)
+	_, ctx := testutil.NewTenant(t, client, "acme")
+
+	f := &paginationFixture{ctx: ctx, query: (&Resolver{client: client}).Query()}
+	for i, p := range []struct {
+		name string
+		age  int
+	}{
+		{"carol", 30}, {"alice", 25}, {"bob", 30}, {"alice", 30}, {"dave", 25},
+		{"bob", 25}, {"erin", 40}, {"alice", 25}, {"carol", 40}, {"frank", 30},
+	} {
+		u, err := client.User.Create().
+			SetName(p.name).
+			SetSex(i%2 == 0).
+			SetAge(p.age).
+			SetAccount("user" + strconv.Itoa(i)).
+			SetPassword("correct horse").
+			Save(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		f.users = append(f.users, u)
+	}
+	return f
+}
+
+// page 查询一页用户
+func (f *paginationFixture) page(after *entgql.Cursor[uint64], first *int, before *entgql.Cursor[uint64], last *int, orderBy []*ent.UserOrder) (*ent.UserConnection, error) {
+	return f.query.Users(f.ctx, after, first, before, last, orderBy, nil)
+}
+
+// roundTrip 将游标编码为客户端收到的字符串再解析回来
+func roundTrip(t *testing.T, c *entgql.Cursor[uint64]) *entgql.Cursor[uint64] {
+	t.Helper()
+	var buf bytes.Buffer
+	c.MarshalGQL(&buf)
+	s, err := strconv.Unquote(buf.String())
+	if err != nil {
+		t.Fatalf("cursor %s is not a quoted string: %v", buf.String(), err)
+	}
+	parsed := &entgql.Cursor[uint64]{}
+	if err := parsed.UnmarshalGQL(s); err != nil {
+		t.Fatalf("UnmarshalGQL: %v", err)
+	}
+	return parsed
+}
+
+// expectedOrder 在内存中按排序字段与 ID 排列用户
+func expectedOrder(users []*ent.User, orderBy []*ent.UserOrder) []uint64 {
+	sorted := append([]*ent.User(nil), users...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		for _, o := range orderBy {
+			var c int
+			switch o.Field {
+			case ent.UserOrderFieldName:
+				c = compare(a.Name, b.Name)
+			case ent.UserOrderFieldAge:
+				c = compare(a.Age, b.Age)
+			case ent.UserOrderFieldAccount:
+				c = compare(a.Account, b.Account)
+			case ent.UserOrderFieldCreatedAt:
+				c = a.CreatedAt.Compare(b.CreatedAt)
+			}
+			if o.Direction == entgql.OrderDirectionDesc {
+				c = -c
+			}
+			if c != 0 {
+				return c < 0
+			}
+		}
+		return a.ID < b.ID
+	})
+	ids := make([]uint64, len(sorted))
+	for i, u := range sorted {
+		ids[i] = u.ID
+	}
+	return ids
+}
+
+func compare[T int | string](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func TestUsersCursorRoundTrip(t *testing.T) {
+	asc, desc := entgql.OrderDirectionAsc, entgql.OrderDirectionDesc
+	tests := [][]*ent.UserOrder{
+		nil,
+		{{Direction: asc, Field: ent.UserOrderFieldAge}},
+		{{Direction: asc, Field: ent.UserOrderFieldName}, {Direction: desc, Field: ent.UserOrderFieldAge}},
+		{{Direction: desc, Field: ent.UserOrderFieldAge}, {Direction: asc, Field: ent.UserOrderFieldName}, {Direction: asc, Field: ent.UserOrderFieldAccount}},
+		{{Direction: desc, Field: ent.UserOrderFieldCreatedAt}, {Direction: asc, Field: ent.UserOrderFieldName}},
+	}
+	f := newPaginationFixture(t)
+	for _, orderBy := range tests {
+		name := "default"
+		if len(orderBy) > 0 {
+			keys := make([]string, len(orderBy))
+			for i, o := range orderBy {
+				keys[i] = fmt.Sprintf("%s_%s", o.Field, o.Direction)
+			}
+			name = strings.Join(keys, ",")
+		}
+		t.Run(name, func(t *testing.T) {
+			want := expectedOrder(f.users, orderBy)
+			size := 3
+
+			// 向后翻页
+			var got []uint64
+			var after *entgql.Cursor[uint64]
+			for {
+				conn, err := f.page(after, &size, nil, nil, orderBy)
+				if err != nil {
+					t.Fatalf("page after %v: %v", after, err)
+				}
+				for _, e := range conn.Edges {
+					got = append(got, e.Node.ID)
+				}
+				if !conn.PageInfo.HasNextPage {
+					break
+				}
+				after = roundTrip(t, conn.PageInfo.EndCursor)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(want) {
+				t.Fatalf("forward walk = %v, want %v", got, want)
+			}
+
+			// 向前翻页
+			got = nil
+			var before *entgql.Cursor[uint64]
+			for {
+				conn, err := f.page(nil, nil, before, &size, orderBy)
+				if err != nil {
+					t.Fatalf("page before %v: %v", before, err)
+				}
+				page := make([]uint64, 0, len(conn.Edges))
+				for _, e := range conn.Edges {
+					page = append(page, e.Node.ID)
+				}
+				got = append(page, got...)
+				if !conn.PageInfo.HasPreviousPage {
+					break
+				}
+				before = roundTrip(t, conn.PageInfo.StartCursor)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(want) {
+				t.Fatalf("backward walk = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestUsersRejectsMismatchedCursor(t *testing.T) {
+	f := newPaginationFixture(t)
+	size := 2
+	byAge := []*ent.UserOrder{{Direction: entgql.OrderDirectionAsc, Field: ent.UserOrderFieldAge}}
+	byAgeName := append(byAge, &ent.UserOrder{Direction: entgql.OrderDirectionAsc, Field: ent.UserOrderFieldName})
+
+	conn, err := f.page(nil, &size, nil, nil, byAge)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cursor := roundTrip(t, conn.PageInfo.EndCursor)
+
+	for _, orderBy := range [][]*ent.UserOrder{nil, byAgeName} {
+		_, err := f.page(cursor, &size, nil, nil, orderBy)
+		var e *goWebErrors.Error
+		if !errors.As(err, &e) || e.Code != goWebErrors.ErrInvalidParam {
+			t.Fatalf("cursor for %d keys used with %d keys: err = %v, want ErrInvalidParam", len(byAge), len(orderBy), err)
+		}
+	}
+}
@@ -4,15 +4,21 @@ import (
 	"context"
 	"go-web/ent"
 	generated "go-web/graph/generated"
+	"go-web/pkg/auth"
+	"go-web/pkg/config"
+	"go-web/pkg/erasure"
 	goWebErrors "go-web/pkg/errors"
+	"go-web/pkg/export"
 	"go-web/pkg/i18n"
 	"go-web/pkg/redis"
+	"go-web/pkg/upload"
 	"time"
 
 	"entgo.io/contrib/entgql"
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
 	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/gin-gonic/gin"
 	redisv9 "github.com/redis/go-redis/v9"
@@ -25,25 +31,54 @@ import (
 // It serves as dependency injection for your app, add any dependencies you require here.
 
 type Resolver struct {
-	client *ent.Client
-	rdb    redis.Service
-	logger *zap.Logger
+	client        *ent.Client
+	rdb           redis.Service
+	logger        *zap.Logger
+	authenticator *auth.Authenticator
+	tokens        *auth.TokenService
+	sessions      *auth.SessionStore
+	apiKeys       *auth.APIKeyService
+	twoFactor     *auth.TwoFactorService
+	guard         *auth.LoginGuard
+	passwords     *auth.PasswordService
+	emails        *auth.EmailService
+	exports       *export.Service
+	erasures      *erasure.Service
+	uploads       *upload.Service
+	authMode      string
 }
 
 const (
 	maxQueryComplexity = 300
 	defaultCacheTTL    = 5 * time.Minute
+	// queryCacheSize 解析后查询文档的缓存数量
+	queryCacheSize = 1000
 )
 
 // NewConfig
-func NewConfig(client *ent.Client, rdb redis.Service, logger *zap.Logger) *generated.Config {
-	return &generated.Config{
+func NewConfig(cfg *config.Config, client *ent.Client, rdb redis.Service, logger *zap.Logger, authenticator *auth.Authenticator, tokens *auth.TokenService, sessions *auth.SessionStore, apiKeys *auth.APIKeyService, twoFactor *auth.TwoFactorService, guard *auth.LoginGuard, passwords *auth.PasswordService, emails *auth.EmailService, exports *export.Service, erasures *erasure.Service, uploads *upload.Service) *generated.Config {
+	c := &generated.Config{
 		Resolvers: &Resolver{
-			client: client,
-			rdb:    rdb,
-			logger: logger,
+			client:        client,
+			rdb:           rdb,
+			logger:        logger,
+			authenticator: authenticator,
+			tokens:        tokens,
+			sessions:      sessions,
+			apiKeys:       apiKeys,
+			twoFactor:     twoFactor,
+			guard:         guard,
+			passwords:     passwords,
+			emails:        emails,
+			exports:       exports,
+			erasures:      erasures,
+			uploads:       uploads,
+			authMode:      cfg.Auth.Mode,
 		},
+		Directives: newDirectiveRoot(),
 	}
+	setComplexity(&c.Complexity)
+	return c
 }
 
 // ErrorPresenter 统一 GraphQL 错误格式与国际化
@@ -73,7 +108,7 @@ func GinContextFromContext(ctx context.Context) *gin.Context {
 }
 
 // NewGraphqlHandler
-func NewGraphqlHandler(c *generated.Config, client *ent.Client, rdb *redisv9.Client, logger *zap.Logger) *handler.Server {
+func NewGraphqlHandler(cfg *config.Config, c *generated.Config, client *ent.Client, rdb *redisv9.Client, logger *zap.Logger) *handler.Server {
 	if c == nil {
 		panic("graphql config is required")
 	}
@@ -82,7 +117,24 @@ func NewGraphqlHandler(c *generated.Config, client *ent.Client, rdb *redisv9.Cli
 		panic("ent client is required")
 	}
 
-	h := handler.NewDefaultServer(generated.NewExecutableSchema(*c))
+	// 不使用 handler.NewDefaultServer：它注册的 MultipartForm 使用默认的 32MB 限制，
+	// 且 gqlgen 选用第一个支持请求的传输层，后添加的同类传输层不会生效
+	h := handler.New(generated.NewExecutableSchema(*c))
+
+	// 配置传输层
+	h.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: 10 * time.Second,
+	})
+	h.AddTransport(transport.Options{})
+	h.AddTransport(transport.GET{})
+	h.AddTransport(transport.POST{})
+	// 文件上传，遵循 GraphQL multipart request 规范
+	h.AddTransport(transport.MultipartForm{
+		MaxUploadSize: cfg.Upload.MaxSize,
+		MaxMemory:     cfg.Upload.MaxMemory,
+	})
+
+	h.SetQueryCache(lru.New(queryCacheSize))
 
 	// 添加事务支持
 	h.Use(entgql.Transactioner{TxOpener: client})
@@ -96,14 +148,6 @@ func NewGraphqlHandler(c *generated.Config, client *ent.Client, rdb *redisv9.Cli
 		Cache: redis.NewAPQCache(rdb, defaultCacheTTL),
 	})
 
-	// 配置传输层
-	h.AddTransport(transport.Websocket{
-		KeepAlivePingInterval: 10 * time.Second,
-	})
-	h.AddTransport(transport.Options{})
-	h.AddTransport(transport.GET{})
-	h.AddTransport(transport.POST{})
-
 	// 注册自定义 ErrorPresenter
 	h.SetErrorPresenter(ErrorPresenter)
 
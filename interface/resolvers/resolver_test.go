@@ -0,0 +1,99 @@
+package resolvers
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	generated "go-web/graph/generated"
+	"go-web/pkg/config"
+	"go-web/pkg/testutil"
+
+	"github.com/alicebob/miniredis/v2"
+	redisv9 "github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+// newTestGraphqlHandler 创建上传大小限制为 maxSize 的 GraphQL 处理器
+func newTestGraphqlHandler(t *testing.T, maxSize int64) http.Handler {
+	t.Helper()
+	cfg := &config.Config{}
+	cfg.Upload.MaxSize = maxSize
+	cfg.Upload.MaxMemory = maxSize / 2
+
+	client := testutil.NewClient(t)
+	rdb := redisv9.NewClient(&redisv9.Options{Addr: miniredis.RunT(t).Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+
+	c := &generated.Config{
+		Resolvers:  &Resolver{client: client},
+		Directives: newDirectiveRoot(),
+	}
+	return NewGraphqlHandler(cfg, c, client, rdb, zap.NewNop())
+}
+
+// uploadAvatarRequest 构造上传 size 字节头像的 multipart 请求
+func uploadAvatarRequest(t *testing.T, size int) *http.Request {
+	t.Helper()
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+	_ = mw.WriteField("operations", `{"query":"mutation($file: Upload!) { uploadAvatar(file: $file) { id } }","variables":{"file":null}}`)
+	_ = mw.WriteField("map", `{"0":["variables.file"]}`)
+	fw, _ := mw.CreateFormFile("0", "avatar.png")
+	_, _ = fw.Write(bytes.Repeat([]byte("x"), size))
+	_ = mw.Close()
+
+	req := httptest.NewRequest(http.MethodPost, "/query", &buf)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	return req
+}
+
+// graphqlErrors 返回响应中的错误信息
+func graphqlErrors(t *testing.T, body io.Reader) []string {
+	t.Helper()
+	var resp struct {
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if err := json.NewDecoder(body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	messages := make([]string, len(resp.Errors))
+	for i, e := range resp.Errors {
+		messages[i] = e.Message
+	}
+	return messages
+}
+
+func TestGraphqlHandlerEnforcesUploadLimit(t *testing.T) {
+	h := newTestGraphqlHandler(t, 4<<10)
+
+	// 未超过限制的上传到达解析器，因没有访问者而被拒绝
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, uploadAvatarRequest(t, 1<<10))
+	if errs := graphqlErrors(t, rec.Body); len(errs) == 0 || strings.Contains(strings.Join(errs, ";"), "too large") {
+		t.Fatalf("small upload: errors = %v, want the resolver to run", errs)
+	}
+
+	// 超过配置的限制，在读取请求体前拒绝
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, uploadAvatarRequest(t, 8<<10))
+	if errs := graphqlErrors(t, rec.Body); len(errs) != 1 || !strings.Contains(errs[0], "too large") {
+		t.Fatalf("large upload: errors = %v, want request body too large", errs)
+	}
+
+	// 长度未知的请求体在读取超过限制时中止
+	req := uploadAvatarRequest(t, 8<<10)
+	req.ContentLength = -1
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code == http.StatusOK {
+		t.Fatalf("large upload without content length: status = %d, body = %s", rec.Code, rec.Body)
+	}
+}
@@ -2,12 +2,29 @@ package resolvers
 
 // This file will be automatically regenerated based on the schema, any resolver implementations
 // will be copied through when generating and any unknown code will be moved to the end.
-// Code generated by github.com/99designs/gqlgen version v0.17.27
+// Code generated by github.com/99designs/gqlgen version v0.17.40
 
 import (
+	"context"
+	"fmt"
+	"go-web/ent"
 	graph1 "go-web/graph/generated"
+	goWebErrors "go-web/pkg/errors"
 )
 
+// Node is the resolver for the node field.
+func (r *queryResolver) Node(ctx context.Context, id uint64) (ent.Noder, error) {
+	return r.client.Noder(ctx, id, ent.WithNodeType(r.nodeType))
+}
+
+// Nodes is the resolver for the nodes field.
+func (r *queryResolver) Nodes(ctx context.Context, ids []uint64) ([]ent.Noder, error) {
+	if len(ids) > maxNodes {
+		return nil, goWebErrors.New(goWebErrors.ErrInvalidParam, "invalid_param", fmt.Sprintf("at most %d ids are allowed", maxNodes))
+	}
+	return r.client.Noders(ctx, ids, ent.WithNodeType(r.nodeType))
+}
+
 // Mutation returns graph1.MutationResolver implementation.
 func (r *Resolver) Mutation() graph1.MutationResolver { return &mutationResolver{r} }
 
@@ -0,0 +1,26 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.40
+
+import (
+	"context"
+	"fmt"
+	"go-web/ent"
+	goWebErrors "go-web/pkg/errors"
+	"go-web/pkg/tenancy"
+)
+
+// CurrentTenant is the resolver for the currentTenant field.
+func (r *queryResolver) CurrentTenant(ctx context.Context) (*ent.Tenant, error) {
+	id, ok := tenancy.FromContext(ctx)
+	if !ok {
+		return nil, goWebErrors.New(goWebErrors.ErrForbidden, "forbidden", "tenant is missing")
+	}
+	t, err := r.client.Tenant.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query tenant: %w", err)
+	}
+	return t, nil
+}
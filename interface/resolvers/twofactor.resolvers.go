@@ -0,0 +1,47 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.40
+
+import (
+	"context"
+	"go-web/graph/model"
+)
+
+// EnrollTotp is the resolver for the enrollTotp field.
+func (r *mutationResolver) EnrollTotp(ctx context.Context) (*model.TotpEnrollment, error) {
+	v, err := requireUserViewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	e, err := r.twoFactor.Enroll(ctx, v.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &model.TotpEnrollment{Secret: e.Secret, URI: e.URI}, nil
+}
+
+// ConfirmTotp is the resolver for the confirmTotp field.
+func (r *mutationResolver) ConfirmTotp(ctx context.Context, code string) ([]string, error) {
+	v, err := requireUserViewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.twoFactor.Confirm(ctx, v.ID, code)
+}
+
+// DisableTotp is the resolver for the disableTotp field.
+func (r *mutationResolver) DisableTotp(ctx context.Context, code string) (bool, error) {
+	v, err := requireUserViewer(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if err := r.twoFactor.Disable(ctx, v.ID, code); err != nil {
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,60 @@
+package resolvers
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"go-web/ent"
+	"go-web/ent/privacy"
+	goWebErrors "go-web/pkg/errors"
+)
+
+// userUniqueIndexes 用户在租户内唯一的字段，按违反的唯一索引返回错误信息
+var userUniqueIndexes = []struct {
+	// ent 生成的索引名，MySQL 与 PostgreSQL 的错误信息中包含索引名
+	name string
+	// 索引列，SQLite 的错误信息中只包含列名
+	columns string
+	message string
+}{
+	{"user_tenant_id_account", "user.tenant_id, user.account", "account is already in use"},
+	{"user_tenant_id_email", "user.tenant_id, user.email", "email is already in use"},
+}
+
+// userMutationError 将用户变更返回的 ent 错误映射为业务错误码，action 用于描述未知错误
+func userMutationError(err error, action string) error {
+	var e *goWebErrors.Error
+	switch {
+	case errors.As(err, &e):
+		return e
+	case ent.IsNotFound(err):
+		return goWebErrors.New(goWebErrors.ErrNotFound, "not_found", "user not found")
+	case ent.IsValidationError(err):
+		return goWebErrors.New(goWebErrors.ErrInvalidParam, "invalid_param", err.Error())
+	case ent.IsConstraintError(err):
+		// 外键等其他约束错误按未知错误处理
+		if message, ok := userUniqueViolation(err.Error()); ok {
+			return goWebErrors.New(goWebErrors.ErrAlreadyExist, "already_exist", message)
+		}
+	case errors.Is(err, privacy.Deny):
+		return goWebErrors.New(goWebErrors.ErrForbidden, "forbidden", err.Error())
+	}
+	return fmt.Errorf("failed to %s user: %w", action, err)
+}
+
+// userUniqueViolation 根据数据库错误信息判断违反的用户唯一索引，错误信息的格式：
+//   - MySQL: Duplicate entry '1-alice' for key 'user.user_tenant_id_account'，8.0 以前没有表名前缀
+//   - PostgreSQL: duplicate key value violates unique constraint "user_tenant_id_account"
+//   - SQLite: UNIQUE constraint failed: user.tenant_id, user.account
+func userUniqueViolation(msg string) (string, bool) {
+	for _, idx := range userUniqueIndexes {
+		if strings.Contains(msg, "'"+idx.name+"'") ||
+			strings.Contains(msg, "."+idx.name+"'") ||
+			strings.Contains(msg, `"`+idx.name+`"`) ||
+			strings.HasSuffix(msg, "UNIQUE constraint failed: "+idx.columns) {
+			return idx.message, true
+		}
+	}
+	return "", false
+}
@@ -2,39 +2,166 @@ package resolvers
 
 // This file will be automatically regenerated based on the schema, any resolver implementations
 // will be copied through when generating and any unknown code will be moved to the end.
-// Code generated by github.com/99designs/gqlgen version v0.17.27
+// Code generated by github.com/99designs/gqlgen version v0.17.40
 
 import (
 	"context"
 	"errors"
 	"fmt"
-
 	"go-web/ent"
+	"go-web/ent/schema"
 	"go-web/ent/user"
+	"go-web/pkg/auth"
+	goWebErrors "go-web/pkg/errors"
+
+	"entgo.io/contrib/entgql"
 )
 
-// UpdatePasswordByAccount is the resolver for the updatePasswordByAccount field.
-func (r *mutationResolver) UpdatePasswordByAccount(ctx context.Context, account string, password string) (bool, error) {
-	// 验证账号是否存在
-	_, err := r.client.User.Query().Where(user.Account(account)).First(ctx)
+// RequestPasswordReset is the resolver for the requestPasswordReset field.
+func (r *mutationResolver) RequestPasswordReset(ctx context.Context, account string) (bool, error) {
+	if account == "" {
+		return false, goWebErrors.New(goWebErrors.ErrMissingParam, "missing_param", "account is required")
+	}
+
+	if err := r.passwords.RequestReset(ctx, account); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ResetPassword is the resolver for the resetPassword field.
+func (r *mutationResolver) ResetPassword(ctx context.Context, token string, newPassword string) (bool, error) {
+	if err := r.passwords.Reset(ctx, token, newPassword); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ChangePassword is the resolver for the changePassword field.
+func (r *mutationResolver) ChangePassword(ctx context.Context, currentPassword string, newPassword string) (bool, error) {
+	v, err := requireUserViewer(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if err := r.passwords.Change(ctx, v.ID, currentPassword, newPassword); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// UpdateEmail is the resolver for the updateEmail field.
+func (r *mutationResolver) UpdateEmail(ctx context.Context, email string) (*ent.User, error) {
+	v, err := requireUserViewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if email == "" {
+		return nil, goWebErrors.New(goWebErrors.ErrMissingParam, "missing_param", "email is required")
+	}
+
+	return r.emails.Change(ctx, v.ID, email)
+}
+
+// SendVerificationEmail is the resolver for the sendVerificationEmail field.
+func (r *mutationResolver) SendVerificationEmail(ctx context.Context) (bool, error) {
+	v, err := requireUserViewer(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if err := r.emails.SendVerification(ctx, v.ID); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// VerifyEmail is the resolver for the verifyEmail field.
+func (r *mutationResolver) VerifyEmail(ctx context.Context, token string) (*ent.User, error) {
+	if token == "" {
+		return nil, goWebErrors.New(goWebErrors.ErrMissingParam, "missing_param", "token is required")
+	}
+
+	return r.emails.Verify(ctx, token)
+}
+
+// CreateUser is the resolver for the createUser field.
+func (r *mutationResolver) CreateUser(ctx context.Context, input ent.CreateUserInput) (*ent.User, error) {
+	if err := auth.ValidatePassword(input.Password); err != nil {
+		return nil, err
+	}
+
+	u, err := r.client.User.Create().SetInput(input).Save(ctx)
+	if err != nil {
+		return nil, userMutationError(err, "create")
+	}
+	return u, nil
+}
+
+// UpdateUser is the resolver for the updateUser field.
+func (r *mutationResolver) UpdateUser(ctx context.Context, id uint64, expectedVersion int, input ent.UpdateUserInput) (*ent.User, error) {
+	// 版本号作为更新条件，用户在读取后被他人修改时返回版本冲突
+	u, err := r.client.User.UpdateOneID(id).
+		SetInput(input).
+		SetVersion(expectedVersion).
+		Save(ctx)
+	if err != nil {
+		return nil, userMutationError(err, "update")
+	}
+	return u, nil
+}
+
+// DeleteUser is the resolver for the deleteUser field.
+func (r *mutationResolver) DeleteUser(ctx context.Context, id uint64) (bool, error) {
+	// 删除由 SoftDeleteMixin 改写为写入 deleted_at
+	if err := r.client.User.DeleteOneID(id).Exec(ctx); err != nil {
+		return false, userMutationError(err, "delete")
+	}
+	return true, nil
+}
+
+// RestoreUser is the resolver for the restoreUser field.
+func (r *mutationResolver) RestoreUser(ctx context.Context, id uint64) (*ent.User, error) {
+	// 查询和更新都需要包含已软删除的用户
+	ctx = schema.IncludeDeleted(ctx)
+
+	u, err := r.client.User.Get(ctx, id)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return false, fmt.Errorf("user with account %s not found", account)
+			return nil, goWebErrors.New(goWebErrors.ErrNotFound, "not_found", "user not found")
 		}
-		return false, fmt.Errorf("failed to query user: %w", err)
+		return nil, fmt.Errorf("failed to query user: %w", err)
+	}
+	if u.DeletedAt == nil {
+		return nil, goWebErrors.New(goWebErrors.ErrInvalidState, "invalid_state", "user is not deleted")
 	}
 
-	// 验证密码是否为空
-	if password == "" {
-		return false, errors.New("password cannot be empty")
+	u, err = r.client.User.UpdateOne(u).ClearDeletedAt().Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore user: %w", err)
 	}
+	return u, nil
+}
 
-	// 更新密码
-	if err := r.client.User.Update().Where(user.Account(account)).SetPassword(password).Exec(ctx); err != nil {
-		return false, fmt.Errorf("failed to update password: %w", err)
+// Users is the resolver for the users field.
+func (r *queryResolver) Users(ctx context.Context, after *entgql.Cursor[uint64], first *int, before *entgql.Cursor[uint64], last *int, orderBy []*ent.UserOrder, where *ent.UserWhereInput) (*ent.UserConnection, error) {
+	first, last, err := pageBounds(first, last)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCursors(len(orderBy), after, before); err != nil {
+		return nil, err
 	}
 
-	return true, nil
+	conn, err := r.client.User.Query().
+		Paginate(ctx, after, first, before, last,
+			ent.WithUserOrder(orderBy),
+			ent.WithUserFilter(where.Filter),
+		)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query users: %w", err)
+	}
+	return conn, nil
 }
 
 // UserByAccount is the resolver for the userByAccount field.
@@ -0,0 +1,130 @@
+package resolvers
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"go-web/ent"
+	goWebErrors "go-web/pkg/errors"
+	"go-web/pkg/tenancy"
+	"go-web/pkg/testutil"
+	"go-web/pkg/viewer"
+)
+
+// businessError 返回业务错误，不是业务错误时返回 nil
+func businessError(err error) *goWebErrors.Error {
+	var e *goWebErrors.Error
+	if errors.As(err, &e) {
+		return e
+	}
+	return nil
+}
+
+// newUserMutationFixture 返回租户内具有用户读写权限的访问者 context
+func newUserMutationFixture(t *testing.T) (*Resolver, context.Context, *ent.Client) {
+	t.Helper()
+	client := testutil.NewClient(t)
+	tn, _ := testutil.NewTenant(t, client, "acme")
+	ctx := viewer.NewContext(context.Background(), &viewer.Viewer{
+		ID:          1,
+		Permissions: []string{"user:read", "user:write"},
+	})
+	return &Resolver{client: client}, tenancy.NewContext(ctx, tn.ID), client
+}
+
+func userInput(account, email string) ent.CreateUserInput {
+	return ent.CreateUserInput{
+		Name:     account,
+		Age:      30,
+		Account:  account,
+		Email:    &email,
+		Password: "correct horse",
+	}
+}
+
+func TestCreateUserErrors(t *testing.T) {
+	r, ctx, _ := newUserMutationFixture(t)
+	if _, err := r.Mutation().CreateUser(ctx, userInput("alice", "alice@example.com")); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		input   ent.CreateUserInput
+		code    goWebErrors.ErrorCode
+		details string
+	}{
+		{"duplicate account", userInput("alice", "other@example.com"), goWebErrors.ErrAlreadyExist, "account is already in use"},
+		{"duplicate email", userInput("account", "alice@example.com"), goWebErrors.ErrAlreadyExist, "email is already in use"},
+		{"invalid email", userInput("bob", "not-an-email"), goWebErrors.ErrInvalidParam, ""},
+		{"account too long", userInput(strings.Repeat("a", 21), "bob@example.com"), goWebErrors.ErrInvalidParam, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := r.Mutation().CreateUser(ctx, tt.input)
+			e := businessError(err)
+			if e == nil || e.Code != tt.code || (tt.details != "" && e.Details != tt.details) {
+				t.Fatalf("err = %v, want [%d] %s", err, tt.code, tt.details)
+			}
+		})
+	}
+}
+
+func TestUserMutationErrorForeignKey(t *testing.T) {
+	r, ctx, client := newUserMutationFixture(t)
+	u, err := r.Mutation().CreateUser(ctx, userInput("alice", "alice@example.com"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 外键错误不是重复的账号或邮箱
+	err = client.User.UpdateOne(u).SetAvatarID(u.ID + 1000).Exec(ctx)
+	if !ent.IsConstraintError(err) {
+		t.Fatalf("setting a missing avatar: err = %v, want a constraint error", err)
+	}
+	mapped := userMutationError(err, "update")
+	if businessError(mapped) != nil || !strings.HasPrefix(mapped.Error(), "failed to update user") {
+		t.Fatalf("foreign key violation mapped to %v, want the wrapped error", mapped)
+	}
+}
+
+func TestUserMutationErrorNotFoundAndForbidden(t *testing.T) {
+	r, ctx, _ := newUserMutationFixture(t)
+
+	if _, err := r.Mutation().DeleteUser(ctx, 12345); businessError(err) == nil || businessError(err).Code != goWebErrors.ErrNotFound {
+		t.Fatalf("deleting a missing user: err = %v, want ErrNotFound", err)
+	}
+
+	tn, _ := tenancy.FromContext(ctx)
+	reader := tenancy.NewContext(viewer.NewContext(context.Background(), &viewer.Viewer{
+		ID:          2,
+		Permissions: []string{"user:read"},
+	}), tn)
+	if _, err := r.Mutation().CreateUser(reader, userInput("bob", "bob@example.com")); businessError(err) == nil || businessError(err).Code != goWebErrors.ErrForbidden {
+		t.Fatalf("creating without user:write: err = %v, want ErrForbidden", err)
+	}
+}
+
+func TestUserUniqueViolation(t *testing.T) {
+	tests := []struct {
+		msg  string
+		want string
+	}{
+		{"UNIQUE constraint failed: user.tenant_id, user.account", "account is already in use"},
+		{"UNIQUE constraint failed: user.tenant_id, user.email", "email is already in use"},
+		{"Error 1062 (23000): Duplicate entry '1-alice' for key 'user.user_tenant_id_account'", "account is already in use"},
+		{"Error 1062: Duplicate entry '1-account@example.com' for key 'user_tenant_id_email'", "email is already in use"},
+		{`pq: duplicate key value violates unique constraint "user_tenant_id_email"`, "email is already in use"},
+		{"Error 1062: Duplicate entry '1-x' for key 'user.user_tenant_id_account_v2'", ""},
+		{"Error 1452: Cannot add or update a child row: a foreign key constraint fails (`user`, CONSTRAINT `user_files_avatar` FOREIGN KEY (`avatar_id`) REFERENCES `files` (`id`)) account", ""},
+		{"FOREIGN KEY constraint failed", ""},
+	}
+	for _, tt := range tests {
+		got, ok := userUniqueViolation(tt.msg)
+		if got != tt.want || ok != (tt.want != "") {
+			t.Fatalf("userUniqueViolation(%q) = (%q, %v), want %q", tt.msg, got, ok, tt.want)
+		}
+	}
+}
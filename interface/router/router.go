@@ -2,21 +2,26 @@ package router
 
 import (
 	"go-web/interface/http"
+	"go-web/interface/http/handler"
 	"go-web/interface/resolvers"
 
-	"github.com/99designs/gqlgen/graphql/handler"
+	gqlhandler "github.com/99designs/gqlgen/graphql/handler"
 	"github.com/gin-gonic/gin"
 	"github.com/google/wire"
 )
 
-var ProviderSet = wire.NewSet(CreateInitRoutesFunc, resolvers.NewConfig, resolvers.NewGraphqlHandler)
+var ProviderSet = wire.NewSet(CreateInitRoutesFunc, resolvers.NewConfig, resolvers.NewGraphqlHandler, handler.ProviderSet)
 
-func CreateInitRoutesFunc(gql *handler.Server) http.InitRoutersFunc {
+func CreateInitRoutesFunc(gql *gqlhandler.Server, oidc *handler.OIDCHandler, exports *handler.ExportHandler, files *handler.FileHandler) http.InitRoutersFunc {
 	return func(r *gin.Engine) {
 		r.POST("/query", func() gin.HandlerFunc {
 			return func(context *gin.Context) {
 				gql.ServeHTTP(context.Writer, context.Request)
 			}
 		}())
+
+		oidc.Register(r)
+		exports.Register(r)
+		files.Register(r)
 	}
 }
@@ -20,7 +20,7 @@ var fs embed.FS
 
 // version defines the current migration version, this ensures the app
 // is always compatible with the version of the database.
-const version = 202303301713
+const version = 202610181200
 
 // Migrate migrates the database schema to the current version.
 func Migrate(cfg *config.Config) error {
@@ -0,0 +1,3 @@
+-- "user"."password" has been varchar(255) since the init migration; nothing to revert.
+-- The statement is kept so the migration is not empty, and restates the original definition instead of narrowing the column.
+ALTER TABLE `user` MODIFY COLUMN `password` varchar(255) NOT NULL;
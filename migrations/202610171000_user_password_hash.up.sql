@@ -0,0 +1,3 @@
+-- "user"."password" has been varchar(255) since the init migration, which is long enough for argon2id and bcrypt hashes.
+-- Restating the definition is a no-op; the version is kept because existing databases have already recorded it.
+ALTER TABLE `user` MODIFY COLUMN `password` varchar(255) NOT NULL;
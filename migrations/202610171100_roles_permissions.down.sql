@@ -0,0 +1,8 @@
+-- reverse: create "user_roles" table
+DROP TABLE `user_roles`;
+-- reverse: create "role_permissions" table
+DROP TABLE `role_permissions`;
+-- reverse: create "permissions" table
+DROP TABLE `permissions`;
+-- reverse: create "roles" table
+DROP TABLE `roles`;
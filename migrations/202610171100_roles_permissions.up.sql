@@ -0,0 +1,12 @@
+-- create "roles" table
+CREATE TABLE `roles` (`id` bigint unsigned NOT NULL, `name` varchar(50) NOT NULL, `description` varchar(255) NOT NULL DEFAULT "", PRIMARY KEY (`id`), UNIQUE INDEX `name` (`name`)) CHARSET utf8mb4;
+-- create "permissions" table
+CREATE TABLE `permissions` (`id` bigint unsigned NOT NULL, `name` varchar(100) NOT NULL, `description` varchar(255) NOT NULL DEFAULT "", PRIMARY KEY (`id`), UNIQUE INDEX `name` (`name`)) CHARSET utf8mb4;
+-- create "role_permissions" table
+CREATE TABLE `role_permissions` (`role_id` bigint unsigned NOT NULL, `permission_id` bigint unsigned NOT NULL, PRIMARY KEY (`role_id`, `permission_id`), INDEX `role_permissions_permission_id` (`permission_id`), CONSTRAINT `role_permissions_permission_id` FOREIGN KEY (`permission_id`) REFERENCES `permissions` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT `role_permissions_role_id` FOREIGN KEY (`role_id`) REFERENCES `roles` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE) CHARSET utf8mb4;
+-- create "user_roles" table
+CREATE TABLE `user_roles` (`user_id` bigint unsigned NOT NULL, `role_id` bigint unsigned NOT NULL, PRIMARY KEY (`user_id`, `role_id`), INDEX `user_roles_role_id` (`role_id`), CONSTRAINT `user_roles_role_id` FOREIGN KEY (`role_id`) REFERENCES `roles` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT `user_roles_user_id` FOREIGN KEY (`user_id`) REFERENCES `user` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE) CHARSET utf8mb4;
+-- seed built-in roles and permissions
+INSERT INTO `roles` (`id`, `name`, `description`) VALUES (1, "admin", "Administrator with every permission");
+INSERT INTO `permissions` (`id`, `name`, `description`) VALUES (1, "user:read", "Read any user"), (2, "user:write", "Modify any user");
+INSERT INTO `role_permissions` (`role_id`, `permission_id`) VALUES (1, 1), (1, 2);
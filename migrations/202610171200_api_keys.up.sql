@@ -0,0 +1,2 @@
+-- create "api_keys" table
+CREATE TABLE `api_keys` (`id` bigint unsigned NOT NULL, `name` varchar(100) NOT NULL, `prefix` varchar(16) NOT NULL, `secret_hash` varchar(64) NOT NULL, `scopes` json NOT NULL, `expires_at` timestamp NULL, `last_used_at` timestamp NULL, `revoked_at` timestamp NULL, `created_at` timestamp NOT NULL, `owner_id` bigint unsigned NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `prefix` (`prefix`), INDEX `apikey_owner_id` (`owner_id`), CONSTRAINT `api_keys_user_api_keys` FOREIGN KEY (`owner_id`) REFERENCES `user` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE) CHARSET utf8mb4;
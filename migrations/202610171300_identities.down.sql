@@ -0,0 +1,2 @@
+-- reverse: create "identities" table
+DROP TABLE `identities`;
@@ -0,0 +1,2 @@
+-- create "identities" table
+CREATE TABLE `identities` (`id` bigint unsigned NOT NULL, `provider` varchar(50) NOT NULL, `issuer` varchar(255) NOT NULL, `subject` varchar(255) NOT NULL, `email` varchar(255) NULL, `created_at` timestamp NOT NULL, `last_login_at` timestamp NULL, `user_id` bigint unsigned NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `identity_issuer_subject` (`issuer`, `subject`), INDEX `identity_user_id` (`user_id`), CONSTRAINT `identities_user_identities` FOREIGN KEY (`user_id`) REFERENCES `user` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE) CHARSET utf8mb4;
@@ -0,0 +1,2 @@
+-- reverse: modify "user" table
+ALTER TABLE `user` DROP COLUMN `recovery_codes`, DROP COLUMN `totp_enabled`, DROP COLUMN `totp_secret`;
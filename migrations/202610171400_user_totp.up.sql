@@ -0,0 +1,2 @@
+-- modify "user" table
+ALTER TABLE `user` ADD COLUMN `totp_secret` varchar(64) NULL, ADD COLUMN `totp_enabled` bool NOT NULL DEFAULT 0, ADD COLUMN `recovery_codes` json NULL;
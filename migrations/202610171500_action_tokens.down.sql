@@ -0,0 +1,2 @@
+-- reverse: create "action_tokens" table
+DROP TABLE `action_tokens`;
@@ -0,0 +1,2 @@
+-- create "action_tokens" table
+CREATE TABLE `action_tokens` (`id` bigint unsigned NOT NULL, `kind` enum('password_reset') NOT NULL, `token_hash` varchar(64) NOT NULL, `expires_at` timestamp NOT NULL, `used_at` timestamp NULL, `created_at` timestamp NOT NULL, `user_id` bigint unsigned NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `token_hash` (`token_hash`), INDEX `actiontoken_user_id_kind` (`user_id`, `kind`), CONSTRAINT `action_tokens_user_action_tokens` FOREIGN KEY (`user_id`) REFERENCES `user` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE) CHARSET utf8mb4;
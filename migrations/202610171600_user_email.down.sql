@@ -0,0 +1,5 @@
+-- reverse: modify "action_tokens" table
+DELETE FROM `action_tokens` WHERE `kind` = 'email_verification';
+ALTER TABLE `action_tokens` MODIFY COLUMN `kind` enum('password_reset') NOT NULL;
+-- reverse: modify "user" table
+ALTER TABLE `user` DROP INDEX `email`, DROP COLUMN `email_verified_at`, DROP COLUMN `email`;
@@ -0,0 +1,4 @@
+-- modify "user" table
+ALTER TABLE `user` ADD COLUMN `email` varchar(255) NULL, ADD COLUMN `email_verified_at` timestamp NULL, ADD UNIQUE INDEX `email` (`email`);
+-- modify "action_tokens" table
+ALTER TABLE `action_tokens` MODIFY COLUMN `kind` enum('password_reset','email_verification') NOT NULL;
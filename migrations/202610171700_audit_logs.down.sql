@@ -0,0 +1,2 @@
+-- reverse: create "audit_logs" table
+DROP TABLE `audit_logs`;
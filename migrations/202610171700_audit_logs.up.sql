@@ -0,0 +1,2 @@
+-- create "audit_logs" table
+CREATE TABLE `audit_logs` (`id` bigint unsigned NOT NULL, `actor_type` enum('user','system','anonymous') NOT NULL, `actor_id` bigint unsigned NULL, `request_id` varchar(64) NULL, `entity_type` varchar(64) NOT NULL, `entity_id` bigint unsigned NOT NULL, `operation` enum('create','update','delete') NOT NULL, `before` json NULL, `after` json NULL, `created_at` timestamp NOT NULL, PRIMARY KEY (`id`), INDEX `auditlog_entity_type_entity_id` (`entity_type`, `entity_id`), INDEX `auditlog_actor_id` (`actor_id`), INDEX `auditlog_created_at` (`created_at`)) CHARSET utf8mb4;
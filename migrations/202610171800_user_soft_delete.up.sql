@@ -0,0 +1,2 @@
+-- modify "user" table
+ALTER TABLE `user` ADD COLUMN `deleted_at` timestamp NULL;
@@ -0,0 +1,4 @@
+-- reverse: modify "user" table
+SET FOREIGN_KEY_CHECKS = 0;
+ALTER TABLE `user` DROP COLUMN `updated_by`, DROP COLUMN `created_by`, DROP COLUMN `updated_at`, DROP COLUMN `created_at`, MODIFY COLUMN `id` bigint unsigned NOT NULL AUTO_INCREMENT;
+SET FOREIGN_KEY_CHECKS = 1;
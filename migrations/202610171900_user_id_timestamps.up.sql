@@ -0,0 +1,7 @@
+-- modify "user" table
+-- user.id is referenced by foreign keys, which block changing the column while checks are on
+SET FOREIGN_KEY_CHECKS = 0;
+ALTER TABLE `user` MODIFY COLUMN `id` bigint unsigned NOT NULL, ADD COLUMN `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP, ADD COLUMN `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP, ADD COLUMN `created_by` bigint unsigned NULL, ADD COLUMN `updated_by` bigint unsigned NULL;
+SET FOREIGN_KEY_CHECKS = 1;
+-- existing rows are backfilled with the migration time, new rows get timestamps from ent
+ALTER TABLE `user` ALTER COLUMN `created_at` DROP DEFAULT, ALTER COLUMN `updated_at` DROP DEFAULT;
@@ -0,0 +1,2 @@
+-- reverse: modify "user" table
+ALTER TABLE `user` DROP COLUMN `version`;
@@ -0,0 +1,2 @@
+-- modify "user" table
+ALTER TABLE `user` ADD COLUMN `version` bigint NOT NULL DEFAULT 1;
@@ -0,0 +1,10 @@
+-- reverse: modify "audit_logs" table
+ALTER TABLE `audit_logs` DROP INDEX `auditlog_tenant_id`, DROP COLUMN `tenant_id`;
+-- reverse: modify "identities" table
+ALTER TABLE `identities` DROP INDEX `identity_tenant_id_issuer_subject`, DROP INDEX `identity_tenant_id`, DROP COLUMN `tenant_id`, ADD UNIQUE INDEX `identity_issuer_subject` (`issuer`, `subject`);
+-- reverse: modify "api_keys" table
+ALTER TABLE `api_keys` DROP INDEX `apikey_tenant_id`, DROP COLUMN `tenant_id`;
+-- reverse: modify "user" table
+ALTER TABLE `user` DROP INDEX `user_tenant_id_email`, DROP INDEX `user_tenant_id_account`, DROP INDEX `user_tenant_id`, DROP COLUMN `tenant_id`, ADD UNIQUE INDEX `account` (`account`), ADD UNIQUE INDEX `email` (`email`);
+-- reverse: create "tenants" table
+DROP TABLE `tenants`;
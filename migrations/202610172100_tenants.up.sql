@@ -0,0 +1,16 @@
+-- create "tenants" table
+CREATE TABLE `tenants` (`id` bigint unsigned NOT NULL, `name` varchar(100) NOT NULL, `slug` varchar(63) NOT NULL, `created_at` timestamp NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `slug` (`slug`)) CHARSET utf8mb4;
+-- existing data belongs to the default tenant
+INSERT INTO `tenants` (`id`, `name`, `slug`, `created_at`) VALUES (1, 'Default', 'default', CURRENT_TIMESTAMP);
+-- modify "user" table, account and email become unique per tenant
+ALTER TABLE `user` ADD COLUMN `tenant_id` bigint unsigned NOT NULL DEFAULT 1, DROP INDEX `account`, DROP INDEX `email`, ADD INDEX `user_tenant_id` (`tenant_id`), ADD UNIQUE INDEX `user_tenant_id_account` (`tenant_id`, `account`), ADD UNIQUE INDEX `user_tenant_id_email` (`tenant_id`, `email`);
+ALTER TABLE `user` ALTER COLUMN `tenant_id` DROP DEFAULT;
+-- modify "api_keys" table
+ALTER TABLE `api_keys` ADD COLUMN `tenant_id` bigint unsigned NOT NULL DEFAULT 1, ADD INDEX `apikey_tenant_id` (`tenant_id`);
+ALTER TABLE `api_keys` ALTER COLUMN `tenant_id` DROP DEFAULT;
+-- modify "identities" table, external identities become unique per tenant
+ALTER TABLE `identities` ADD COLUMN `tenant_id` bigint unsigned NOT NULL DEFAULT 1, DROP INDEX `identity_issuer_subject`, ADD INDEX `identity_tenant_id` (`tenant_id`), ADD UNIQUE INDEX `identity_tenant_id_issuer_subject` (`tenant_id`, `issuer`, `subject`);
+ALTER TABLE `identities` ALTER COLUMN `tenant_id` DROP DEFAULT;
+-- modify "audit_logs" table, records of tenant-owned entities move to the default tenant
+ALTER TABLE `audit_logs` ADD COLUMN `tenant_id` bigint unsigned NULL, ADD INDEX `auditlog_tenant_id` (`tenant_id`);
+UPDATE `audit_logs` SET `tenant_id` = 1 WHERE `entity_type` IN ('User', 'APIKey', 'Identity');
@@ -0,0 +1,2 @@
+-- reverse: create "data_exports" table
+DROP TABLE `data_exports`;
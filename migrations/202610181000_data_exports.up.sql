@@ -0,0 +1,2 @@
+-- create "data_exports" table
+CREATE TABLE `data_exports` (`id` bigint unsigned NOT NULL, `tenant_id` bigint unsigned NOT NULL, `requested_by` bigint unsigned NULL, `status` enum('pending','running','completed','failed') NOT NULL DEFAULT 'pending', `error` varchar(255) NULL, `size` bigint NULL, `created_at` timestamp NOT NULL, `completed_at` timestamp NULL, `expires_at` timestamp NULL, `user_id` bigint unsigned NOT NULL, PRIMARY KEY (`id`), INDEX `dataexport_tenant_id` (`tenant_id`), INDEX `dataexport_user_id_status` (`user_id`, `status`), INDEX `dataexport_expires_at` (`expires_at`), CONSTRAINT `data_exports_user_data_exports` FOREIGN KEY (`user_id`) REFERENCES `user` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE) CHARSET utf8mb4;
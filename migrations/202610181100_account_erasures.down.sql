@@ -0,0 +1,2 @@
+-- reverse: create "account_erasures" table
+DROP TABLE `account_erasures`;
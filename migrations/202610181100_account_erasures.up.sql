@@ -0,0 +1,2 @@
+-- create "account_erasures" table
+CREATE TABLE `account_erasures` (`id` bigint unsigned NOT NULL, `tenant_id` bigint unsigned NOT NULL, `user_id` bigint unsigned NOT NULL, `status` enum('scheduled','running','completed','cancelled') NOT NULL DEFAULT 'scheduled', `scheduled_for` timestamp NOT NULL, `started_at` timestamp NULL, `completed_at` timestamp NULL, `cancelled_at` timestamp NULL, `results` json NULL, `created_at` timestamp NOT NULL, PRIMARY KEY (`id`), INDEX `accounterasure_tenant_id` (`tenant_id`), INDEX `accounterasure_user_id` (`user_id`), INDEX `accounterasure_status_scheduled_for` (`status`, `scheduled_for`)) CHARSET utf8mb4;
@@ -0,0 +1,4 @@
+-- reverse: modify "user" table
+ALTER TABLE `user` DROP FOREIGN KEY `user_files_avatar`, DROP INDEX `user_files_avatar`, DROP COLUMN `avatar_id`;
+-- reverse: create "files" table
+DROP TABLE `files`;
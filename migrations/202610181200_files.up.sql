@@ -0,0 +1,4 @@
+-- create "files" table
+CREATE TABLE `files` (`id` bigint unsigned NOT NULL, `tenant_id` bigint unsigned NOT NULL, `name` varchar(255) NOT NULL, `size` bigint NOT NULL, `content_type` varchar(100) NOT NULL, `checksum` varchar(64) NOT NULL, `created_at` timestamp NOT NULL, `owner_id` bigint unsigned NOT NULL, PRIMARY KEY (`id`), INDEX `file_tenant_id` (`tenant_id`), INDEX `file_owner_id` (`owner_id`), CONSTRAINT `files_user_files` FOREIGN KEY (`owner_id`) REFERENCES `user` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE) CHARSET utf8mb4;
+-- modify "user" table
+ALTER TABLE `user` ADD COLUMN `avatar_id` bigint unsigned NULL, ADD INDEX `user_files_avatar` (`avatar_id`), ADD CONSTRAINT `user_files_avatar` FOREIGN KEY (`avatar_id`) REFERENCES `files` (`id`) ON UPDATE NO ACTION ON DELETE SET NULL;
@@ -0,0 +1,250 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"go-web/ent"
+	"go-web/ent/apikey"
+	"go-web/ent/permission"
+	goWebErrors "go-web/pkg/errors"
+	"go-web/pkg/viewer"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// APIKeyHeader API 密钥请求头
+	APIKeyHeader = "X-API-Key"
+
+	// apiKeyMarker 密钥明文的固定前缀，便于密钥扫描工具识别
+	apiKeyMarker = "gwk_"
+	// apiKeyTouchInterval last_used_at 的最小更新间隔，避免每个请求都写库
+	apiKeyTouchInterval = time.Minute
+)
+
+// APIKeyService 管理服务间调用使用的 API 密钥。
+// 密钥明文格式为 gwk_<prefix>_<secret>，只保存前缀和 secret 的 SHA-256 哈希，明文只在创建时返回一次。
+type APIKeyService struct {
+	client *ent.Client
+	logger *zap.Logger
+}
+
+// NewAPIKeyService 创建 API 密钥服务
+func NewAPIKeyService(client *ent.Client, logger *zap.Logger) *APIKeyService {
+	return &APIKeyService{
+		client: client,
+		logger: logger.With(zap.String("component", "api_key_service")),
+	}
+}
+
+// Create 为访问者创建 API 密钥，返回密钥记录和明文。
+// 授权范围必须是已定义的权限，且访问者本人拥有该权限。
+func (s *APIKeyService) Create(ctx context.Context, name string, scopes []string, expiresAt *time.Time) (*ent.APIKey, string, error) {
+	v := viewer.FromContext(ctx)
+	if v == nil {
+		return nil, "", goWebErrors.New(goWebErrors.ErrUnauthorized, "unauthorized")
+	}
+	if APIKeyFromContext(ctx) != nil {
+		return nil, "", goWebErrors.New(goWebErrors.ErrForbidden, "forbidden", "api keys cannot create api keys")
+	}
+	if expiresAt != nil && !expiresAt.After(time.Now()) {
+		return nil, "", goWebErrors.New(goWebErrors.ErrInvalidParam, "invalid_param", "expiresAt must be in the future")
+	}
+	scopes = uniqueStrings(scopes)
+	if err := s.checkScopes(ctx, v, scopes); err != nil {
+		return nil, "", err
+	}
+
+	prefix, secret, err := newAPIKeySecret()
+	if err != nil {
+		return nil, "", err
+	}
+
+	key, err := s.client.APIKey.Create().
+		SetName(name).
+		SetPrefix(prefix).
+		SetSecretHash(hashAPIKeySecret(secret)).
+		SetScopes(scopes).
+		SetOwnerID(v.ID).
+		SetNillableExpiresAt(expiresAt).
+		Save(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create api key: %w", err)
+	}
+
+	s.logger.Info("api key created",
+		zap.Uint64("id", key.ID),
+		zap.String("prefix", prefix),
+		zap.Uint64("owner_id", v.ID),
+		zap.Strings("scopes", scopes),
+	)
+	return key, apiKeyMarker + prefix + "_" + secret, nil
+}
+
+// Authenticate 校验密钥明文，返回有效的密钥记录及其访问者。
+// 所属用户已删除时密钥失效；访问者的权限为授权范围与所属用户当前权限的交集，
+// 用户被收回的权限不再能通过密钥使用。
+func (s *APIKeyService) Authenticate(ctx context.Context, raw string) (*ent.APIKey, *viewer.Viewer, error) {
+	prefix, secret, ok := parseAPIKey(raw)
+	if !ok {
+		return nil, nil, goWebErrors.New(goWebErrors.ErrUnauthorized, "unauthorized", "malformed api key")
+	}
+
+	// 认证时访问者尚未建立，以系统身份查询
+	ctx = viewer.NewSystemContext(ctx)
+	key, err := s.client.APIKey.Query().Where(apikey.Prefix(prefix)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, goWebErrors.New(goWebErrors.ErrUnauthorized, "unauthorized", "invalid api key")
+		}
+		return nil, nil, fmt.Errorf("failed to query api key: %w", err)
+	}
+	if subtle.ConstantTimeCompare([]byte(key.SecretHash), []byte(hashAPIKeySecret(secret))) != 1 {
+		return nil, nil, goWebErrors.New(goWebErrors.ErrUnauthorized, "unauthorized", "invalid api key")
+	}
+
+	now := time.Now()
+	if key.RevokedAt != nil {
+		return nil, nil, goWebErrors.New(goWebErrors.ErrUnauthorized, "unauthorized", "api key revoked")
+	}
+	if key.ExpiresAt != nil && !key.ExpiresAt.After(now) {
+		return nil, nil, goWebErrors.New(goWebErrors.ErrUnauthorized, "unauthorized", "api key expired")
+	}
+	grants, err := LoadGrants(ctx, s.client, key.OwnerID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= apiKeyTouchInterval {
+		if err := s.client.APIKey.UpdateOneID(key.ID).SetLastUsedAt(now).Exec(ctx); err != nil {
+			s.logger.Warn("failed to update api key last_used_at", zap.Error(err), zap.Uint64("id", key.ID))
+		}
+	}
+
+	return key, apiKeyViewer(key, grants), nil
+}
+
+// List 返回访问者可见的 API 密钥，管理员可以看到全部密钥
+func (s *APIKeyService) List(ctx context.Context) ([]*ent.APIKey, error) {
+	if APIKeyFromContext(ctx) != nil {
+		return nil, goWebErrors.New(goWebErrors.ErrForbidden, "forbidden", "api keys cannot list api keys")
+	}
+	keys, err := s.client.APIKey.Query().
+		Order(ent.Desc(apikey.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query api keys: %w", err)
+	}
+	return keys, nil
+}
+
+// Revoke 吊销 API 密钥，密钥不存在或不属于访问者时返回 ErrNotFound
+func (s *APIKeyService) Revoke(ctx context.Context, id uint64) error {
+	if APIKeyFromContext(ctx) != nil {
+		return goWebErrors.New(goWebErrors.ErrForbidden, "forbidden", "api keys cannot revoke api keys")
+	}
+	n, err := s.client.APIKey.Update().
+		Where(apikey.ID(id), apikey.RevokedAtIsNil()).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to revoke api key: %w", err)
+	}
+	if n == 0 {
+		return goWebErrors.New(goWebErrors.ErrNotFound, "not_found", "api key not found")
+	}
+
+	s.logger.Info("api key revoked", zap.Uint64("id", id))
+	return nil
+}
+
+// apiKeyViewer 返回密钥对应的访问者。授权范围中所属用户仍拥有的权限参与权限校验，不继承所属用户的角色
+func apiKeyViewer(key *ent.APIKey, grants *Grants) *viewer.Viewer {
+	owner := &viewer.Viewer{Roles: grants.Roles, Permissions: grants.Permissions}
+	permissions := make([]string, 0, len(key.Scopes))
+	for _, scope := range key.Scopes {
+		if owner.HasPermission(scope) {
+			permissions = append(permissions, scope)
+		}
+	}
+	return &viewer.Viewer{
+		ID:          key.OwnerID,
+		Permissions: permissions,
+	}
+}
+
+// checkScopes 校验授权范围均为已定义的权限，且访问者本人拥有
+func (s *APIKeyService) checkScopes(ctx context.Context, v *viewer.Viewer, scopes []string) error {
+	if len(scopes) == 0 {
+		return goWebErrors.New(goWebErrors.ErrInvalidParam, "invalid_param", "at least one scope is required")
+	}
+	known, err := s.client.Permission.Query().
+		Where(permission.NameIn(scopes...)).
+		Count(viewer.NewSystemContext(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to query permissions: %w", err)
+	}
+	if known != len(scopes) {
+		return goWebErrors.New(goWebErrors.ErrInvalidParam, "invalid_param", "unknown scope")
+	}
+	for _, scope := range scopes {
+		if !v.HasPermission(scope) {
+			return goWebErrors.New(goWebErrors.ErrForbidden, "forbidden", "scope "+scope+" exceeds viewer permissions")
+		}
+	}
+	return nil
+}
+
+// newAPIKeySecret 生成随机的密钥前缀与 secret
+func newAPIKeySecret() (string, string, error) {
+	p := make([]byte, 6)
+	if _, err := rand.Read(p); err != nil {
+		return "", "", fmt.Errorf("failed to generate api key: %w", err)
+	}
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", fmt.Errorf("failed to generate api key: %w", err)
+	}
+	return hex.EncodeToString(p), base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// parseAPIKey 将密钥明文拆分为前缀与 secret
+func parseAPIKey(raw string) (string, string, bool) {
+	rest, ok := strings.CutPrefix(raw, apiKeyMarker)
+	if !ok {
+		return "", "", false
+	}
+	prefix, secret, ok := strings.Cut(rest, "_")
+	if !ok || prefix == "" || secret == "" {
+		return "", "", false
+	}
+	return prefix, secret, true
+}
+
+// hashAPIKeySecret 计算 secret 的哈希。secret 为 256 位随机值，无需慢哈希
+func hashAPIKeySecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// uniqueStrings 返回去重后的字符串列表
+func uniqueStrings(ss []string) []string {
+	seen := make(map[string]struct{}, len(ss))
+	out := make([]string, 0, len(ss))
+	for _, s := range ss {
+		if _, ok := seen[s]; ok {
+			continue
+		}
+		seen[s] = struct{}{}
+		out = append(out, s)
+	}
+	return out
+}
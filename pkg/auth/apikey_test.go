@@ -0,0 +1,151 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"go-web/ent"
+	"go-web/ent/permission"
+	goWebErrors "go-web/pkg/errors"
+	"go-web/pkg/testutil"
+	"go-web/pkg/viewer"
+
+	"go.uber.org/zap"
+)
+
+// apiKeyFixture 拥有 editor 角色的用户及其 API 密钥
+type apiKeyFixture struct {
+	svc    *APIKeyService
+	client *ent.Client
+	ctx    context.Context
+	owner  *ent.User
+	role   *ent.Role
+	raw    string
+}
+
+func newAPIKeyFixture(t *testing.T) *apiKeyFixture {
+	t.Helper()
+	client := testutil.NewClient(t)
+	_, ctx := testutil.NewTenant(t, client, "acme")
+
+	read := client.Permission.Create().SetName("user:read").SaveX(ctx)
+	write := client.Permission.Create().SetName("user:write").SaveX(ctx)
+	role := client.Role.Create().SetName("editor").AddPermissions(read, write).SaveX(ctx)
+	owner, err := client.User.Create().
+		SetName("alice").
+		SetSex(false).
+		SetAge(30).
+		SetAccount("alice").
+		SetPassword("correct horse").
+		AddRoles(role).
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	svc := NewAPIKeyService(client, zap.NewNop())
+	grants, err := LoadGrants(ctx, client, owner.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ownerCtx := viewer.NewContext(ctx, &viewer.Viewer{ID: owner.ID, Roles: grants.Roles, Permissions: grants.Permissions})
+	_, raw, err := svc.Create(ownerCtx, "ci", []string{"user:read", "user:write"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &apiKeyFixture{svc: svc, client: client, ctx: ctx, owner: owner, role: role, raw: raw}
+}
+
+func TestAPIKeyAuthenticate(t *testing.T) {
+	f := newAPIKeyFixture(t)
+
+	key, v, err := f.svc.Authenticate(f.ctx, f.raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key.OwnerID != f.owner.ID || v.ID != f.owner.ID {
+		t.Fatalf("key owner = %d, viewer = %d, want %d", key.OwnerID, v.ID, f.owner.ID)
+	}
+	if !v.HasPermission("user:read") || !v.HasPermission("user:write") || len(v.Roles) != 0 {
+		t.Fatalf("viewer = %+v, want the key scopes without roles", v)
+	}
+
+	if _, _, err := f.svc.Authenticate(f.ctx, f.raw+"x"); errorCode(err) != goWebErrors.ErrUnauthorized {
+		t.Fatalf("wrong secret: err = %v, want ErrUnauthorized", err)
+	}
+}
+
+func TestAPIKeyScopesFollowOwnerGrants(t *testing.T) {
+	f := newAPIKeyFixture(t)
+
+	// 收回角色的写权限后，密钥的同名授权范围不再生效
+	write := f.client.Permission.Query().Where(permission.Name("user:write")).OnlyX(f.ctx)
+	f.client.Role.UpdateOne(f.role).RemovePermissions(write).ExecX(f.ctx)
+
+	_, v, err := f.svc.Authenticate(f.ctx, f.raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !v.HasPermission("user:read") || v.HasPermission("user:write") {
+		t.Fatalf("viewer permissions = %v, want only user:read", v.Permissions)
+	}
+
+	// 移除角色后密钥没有任何权限
+	f.client.User.UpdateOne(f.owner).RemoveRoles(f.role).ExecX(f.ctx)
+	if _, v, err = f.svc.Authenticate(f.ctx, f.raw); err != nil {
+		t.Fatal(err)
+	}
+	if len(v.Permissions) != 0 {
+		t.Fatalf("viewer permissions = %v, want none", v.Permissions)
+	}
+}
+
+func TestAPIKeyAdminOwnerKeepsScopes(t *testing.T) {
+	f := newAPIKeyFixture(t)
+
+	admin := f.client.Role.Create().SetName(viewer.RoleAdmin).SaveX(f.ctx)
+	f.client.User.UpdateOne(f.owner).RemoveRoles(f.role).AddRoles(admin).ExecX(f.ctx)
+
+	_, v, err := f.svc.Authenticate(f.ctx, f.raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 管理员拥有全部权限，密钥仍只有授权范围内的权限
+	if !v.HasPermission("user:write") || v.HasRole(viewer.RoleAdmin) {
+		t.Fatalf("viewer = %+v, want the key scopes without the admin role", v)
+	}
+}
+
+func TestAPIKeyRejectsDeletedOwner(t *testing.T) {
+	f := newAPIKeyFixture(t)
+
+	if err := f.client.User.DeleteOneID(f.owner.ID).Exec(f.ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := f.svc.Authenticate(f.ctx, f.raw); errorCode(err) != goWebErrors.ErrUnauthorized {
+		t.Fatalf("key of a deleted owner: err = %v, want ErrUnauthorized", err)
+	}
+}
+
+func TestAPIKeyCannotManageKeys(t *testing.T) {
+	f := newAPIKeyFixture(t)
+
+	key, v, err := f.svc.Authenticate(f.ctx, f.raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := viewer.NewContext(NewAPIKeyContext(f.ctx, key), v)
+
+	if _, err := f.svc.List(ctx); errorCode(err) != goWebErrors.ErrForbidden {
+		t.Fatalf("List with an api key: err = %v, want ErrForbidden", err)
+	}
+	if err := f.svc.Revoke(ctx, key.ID); errorCode(err) != goWebErrors.ErrForbidden {
+		t.Fatalf("Revoke with an api key: err = %v, want ErrForbidden", err)
+	}
+	if _, _, err := f.svc.Create(ctx, "nested", []string{"user:read"}, nil); errorCode(err) != goWebErrors.ErrForbidden {
+		t.Fatalf("Create with an api key: err = %v, want ErrForbidden", err)
+	}
+	if _, _, err := f.svc.Authenticate(f.ctx, f.raw); err != nil {
+		t.Fatalf("key after rejected revoke: %v", err)
+	}
+}
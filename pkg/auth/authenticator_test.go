@@ -0,0 +1,71 @@
+package auth
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"go-web/ent/actiontoken"
+	"go-web/ent/schema"
+	"go-web/pkg/password"
+	"go-web/pkg/testutil"
+
+	"go.uber.org/zap"
+)
+
+func TestAuthenticateUpgradesHashWithoutSideEffects(t *testing.T) {
+	client := testutil.NewClient(t)
+	_, ctx := testutil.NewTenant(t, client, "acme")
+
+	// 存量 bcrypt 哈希在登录后升级为当前算法
+	legacy, err := password.NewBcryptHasher(4).Hash("correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, err := client.User.Create().
+		SetName("alice").
+		SetSex(false).
+		SetAge(30).
+		SetAccount("alice").
+		SetPassword(legacy).
+		Save(schema.PasswordHashed(ctx))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := issueActionToken(ctx, client, actiontoken.KindPasswordReset, u.ID, time.Hour); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := NewAuthenticator(client, zap.NewNop()).Authenticate(ctx, "alice", "correct horse"); err != nil {
+		t.Fatal(err)
+	}
+
+	got := client.User.GetX(ctx, u.ID)
+	if ok, rehash, err := password.Default().Verify("correct horse", got.Password); err != nil || !ok || rehash {
+		t.Fatalf("stored hash after login: ok=%v rehash=%v err=%v, want an up-to-date hash", ok, rehash, err)
+	}
+	if got.Version != u.Version || !got.UpdatedAt.Equal(u.UpdatedAt) {
+		t.Fatalf("version/updated_at = %d/%v, want unchanged %d/%v", got.Version, got.UpdatedAt, u.Version, u.UpdatedAt)
+	}
+	// 升级哈希不是密码变更，尚未使用的重置链接仍然有效
+	pending := client.ActionToken.Query().Where(actiontoken.UserID(u.ID), actiontoken.UsedAtIsNil()).CountX(ctx)
+	if pending != 1 {
+		t.Fatalf("unused password reset tokens = %d, want 1", pending)
+	}
+}
+
+func TestDummyHashUsesConfiguredHasher(t *testing.T) {
+	bcrypt := password.NewBcryptHasher(5)
+	prev := password.Default()
+	password.SetDefault(password.NewService(bcrypt))
+	dummyHashOnce = sync.Once{}
+	t.Cleanup(func() {
+		password.SetDefault(prev)
+		dummyHashOnce = sync.Once{}
+	})
+
+	h := dummyPasswordHash()
+	if !bcrypt.Match(h) || bcrypt.NeedsRehash(h) {
+		t.Fatalf("dummy hash %q was not generated by the configured hasher", h)
+	}
+}
@@ -0,0 +1,83 @@
+package auth
+
+import (
+	"context"
+
+	"go-web/ent"
+	goWebErrors "go-web/pkg/errors"
+	"go-web/pkg/viewer"
+)
+
+type (
+	authErrorKey     struct{}
+	claimsKey        struct{}
+	sessionKey       struct{}
+	sessionCookieKey struct{}
+	apiKeyKey        struct{}
+)
+
+// NewContext 返回携带访问令牌声明的 context
+func NewContext(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, claimsKey{}, claims)
+}
+
+// ClaimsFromContext 获取当前请求的访问令牌声明
+func ClaimsFromContext(ctx context.Context) *Claims {
+	claims, _ := ctx.Value(claimsKey{}).(*Claims)
+	return claims
+}
+
+// NewSessionContext 返回携带当前会话的 context
+func NewSessionContext(ctx context.Context, sess *Session) context.Context {
+	return context.WithValue(ctx, sessionKey{}, sess)
+}
+
+// SessionFromContext 获取当前请求的会话
+func SessionFromContext(ctx context.Context) *Session {
+	sess, _ := ctx.Value(sessionKey{}).(*Session)
+	return sess
+}
+
+// WithSessionCookie 返回携带会话 cookie 写入器的 context
+func WithSessionCookie(ctx context.Context, w SessionCookie) context.Context {
+	return context.WithValue(ctx, sessionCookieKey{}, w)
+}
+
+// SessionCookieFromContext 获取会话 cookie 写入器
+func SessionCookieFromContext(ctx context.Context) SessionCookie {
+	w, _ := ctx.Value(sessionCookieKey{}).(SessionCookie)
+	return w
+}
+
+// NewAPIKeyContext 返回携带当前 API 密钥的 context
+func NewAPIKeyContext(ctx context.Context, key *ent.APIKey) context.Context {
+	return context.WithValue(ctx, apiKeyKey{}, key)
+}
+
+// APIKeyFromContext 获取当前请求使用的 API 密钥，未使用密钥认证时返回 nil
+func APIKeyFromContext(ctx context.Context) *ent.APIKey {
+	key, _ := ctx.Value(apiKeyKey{}).(*ent.APIKey)
+	return key
+}
+
+// WithError 记录请求认证失败的原因，由需要登录的 resolver 返回给客户端
+func WithError(ctx context.Context, err error) context.Context {
+	return context.WithValue(ctx, authErrorKey{}, err)
+}
+
+// ErrorFromContext 获取请求认证失败的原因
+func ErrorFromContext(ctx context.Context) error {
+	err, _ := ctx.Value(authErrorKey{}).(error)
+	return err
+}
+
+// RequireViewer 返回当前登录的访问者，未登录时返回认证失败原因或 ErrUnauthorized
+func RequireViewer(ctx context.Context) (*viewer.Viewer, error) {
+	if v := viewer.FromContext(ctx); v != nil {
+		return v, nil
+	}
+	if err := ErrorFromContext(ctx); err != nil {
+		return nil, err
+	}
+	return nil, goWebErrors.New(goWebErrors.ErrUnauthorized, "unauthorized")
+}
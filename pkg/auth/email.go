@@ -0,0 +1,165 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"go-web/ent"
+	"go-web/ent/actiontoken"
+	"go-web/pkg/config"
+	goWebErrors "go-web/pkg/errors"
+	"go-web/pkg/notify"
+	"go-web/pkg/util"
+	"go-web/pkg/viewer"
+
+	"go.uber.org/zap"
+)
+
+// verificationRequestInterval 同一用户两次发送验证邮件的最小间隔
+const verificationRequestInterval = time.Minute
+
+// EmailService 修改邮箱与通过一次性令牌验证邮箱。
+// 邮箱变更后验证时间被清空，尚未使用的验证令牌由 User 的 hook 作废。
+type EmailService struct {
+	client    *ent.Client
+	notifier  notify.Notifier
+	ttl       time.Duration
+	verifyURL string
+	logger    *zap.Logger
+}
+
+// NewEmailService 创建邮箱服务
+func NewEmailService(cfg *config.Config, client *ent.Client, notifier notify.Notifier, logger *zap.Logger) *EmailService {
+	return &EmailService{
+		client:    client,
+		notifier:  notifier,
+		ttl:       cfg.Auth.EmailVerification.TokenTTL,
+		verifyURL: cfg.Auth.EmailVerification.URL,
+		logger:    logger.With(zap.String("component", "email_service")),
+	}
+}
+
+// Change 修改用户邮箱并向新邮箱发送验证邮件，邮箱已被其他用户使用时返回 ErrAlreadyExist。
+// 更新以调用方的 context 执行，由 User 的隐私策略校验访问者是否可以修改该用户。
+func (s *EmailService) Change(ctx context.Context, userID uint64, email string) (*ent.User, error) {
+	email = util.NormalizeEmail(email)
+	if err := util.ValidateEmail(email); err != nil {
+		return nil, goWebErrors.New(goWebErrors.ErrInvalidParam, "invalid_param", err.Error())
+	}
+
+	u, err := s.client.User.Get(ctx, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, goWebErrors.New(goWebErrors.ErrNotFound, "not_found", "user not found")
+		}
+		return nil, fmt.Errorf("failed to query user: %w", err)
+	}
+	if u.Email != nil && *u.Email == email {
+		return u, nil
+	}
+
+	u, err = s.client.User.UpdateOne(u).SetEmail(email).Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, goWebErrors.New(goWebErrors.ErrAlreadyExist, "already_exist", "email is already in use")
+		}
+		return nil, fmt.Errorf("failed to update email: %w", err)
+	}
+	s.logger.Info("email changed", zap.Uint64("user_id", userID))
+
+	if err := s.send(ctx, u); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// SendVerification 向用户邮箱重新发送验证邮件。
+// 未设置邮箱或已验证时返回 ErrInvalidState，发送过于频繁时返回 ErrTooManyAttempts。
+func (s *EmailService) SendVerification(ctx context.Context, userID uint64) error {
+	sysCtx := viewer.NewSystemContext(ctx)
+	u, err := s.client.User.Get(sysCtx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to query user: %w", err)
+	}
+	if u.Email == nil {
+		return goWebErrors.New(goWebErrors.ErrInvalidState, "invalid_state", "email is not set")
+	}
+	if u.EmailVerifiedAt != nil {
+		return goWebErrors.New(goWebErrors.ErrInvalidState, "invalid_state", "email is already verified")
+	}
+
+	recent, err := s.client.ActionToken.Query().
+		Where(
+			actiontoken.UserID(u.ID),
+			actiontoken.KindEQ(actiontoken.KindEmailVerification),
+			actiontoken.UsedAtIsNil(),
+			actiontoken.CreatedAtGT(time.Now().Add(-verificationRequestInterval)),
+		).
+		Exist(sysCtx)
+	if err != nil {
+		return fmt.Errorf("failed to query action tokens: %w", err)
+	}
+	if recent {
+		return goWebErrors.New(goWebErrors.ErrTooManyAttempts, "too_many_attempts", "retry after "+verificationRequestInterval.String())
+	}
+
+	return s.send(ctx, u)
+}
+
+// Verify 使用验证令牌将用户邮箱标记为已验证。
+// 已验证的用户在下次登录或刷新令牌后获得 viewer.PermissionEmailVerified 权限。
+func (s *EmailService) Verify(ctx context.Context, token string) (*ent.User, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	t, err := consumeActionToken(ctx, tx.Client(), actiontoken.KindEmailVerification, token)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	u, err := tx.User.UpdateOneID(t.UserID).
+		SetEmailVerifiedAt(time.Now()).
+		Save(viewer.NewSystemContext(ctx))
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("failed to verify email: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	s.logger.Info("email verified", zap.Uint64("user_id", u.ID))
+	return u.Unwrap(), nil
+}
+
+// send 签发验证令牌并发送到用户当前邮箱
+func (s *EmailService) send(ctx context.Context, u *ent.User) error {
+	token, err := issueActionToken(ctx, s.client, actiontoken.KindEmailVerification, u.ID, s.ttl)
+	if err != nil {
+		return err
+	}
+
+	link, err := url.Parse(s.verifyURL)
+	if err != nil {
+		return fmt.Errorf("invalid email verification url: %w", err)
+	}
+	q := link.Query()
+	q.Set("token", token)
+	link.RawQuery = q.Encode()
+
+	if err := s.notifier.Send(ctx, &notify.Message{
+		Kind:    notify.KindEmailVerification,
+		UserID:  u.ID,
+		To:      *u.Email,
+		Subject: "Verify your email address",
+		Body:    fmt.Sprintf("Open %s to verify your email address. The link expires in %s.", link.String(), s.ttl),
+	}); err != nil {
+		return fmt.Errorf("failed to send email verification notification: %w", err)
+	}
+
+	s.logger.Info("email verification sent", zap.Uint64("user_id", u.ID))
+	return nil
+}
@@ -0,0 +1,72 @@
+package auth
+
+import (
+	"testing"
+
+	goWebErrors "go-web/pkg/errors"
+)
+
+// changeEmail 修改邮箱并返回发往新邮箱的验证令牌
+func (f *actionTokenFixture) changeEmail(t *testing.T, email string) string {
+	t.Helper()
+	if _, err := f.emails.Change(f.ctx, f.user.ID, email); err != nil {
+		t.Fatal(err)
+	}
+	if to := f.notifier.messages[len(f.notifier.messages)-1].To; to != email {
+		t.Fatalf("verification sent to %s, want %s", to, email)
+	}
+	return f.notifier.lastToken(t)
+}
+
+func TestEmailVerificationTokenIsSingleUse(t *testing.T) {
+	f := newActionTokenFixture(t)
+	token := f.changeEmail(t, "alice@example.com")
+
+	u, err := f.emails.Verify(f.ctx, token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.EmailVerifiedAt == nil {
+		t.Fatal("email not marked as verified")
+	}
+	if _, err := f.emails.Verify(f.ctx, token); errorCode(err) != goWebErrors.ErrInvalidParam {
+		t.Fatalf("second use of the verification token: err = %v, want ErrInvalidParam", err)
+	}
+}
+
+func TestEmailVerificationTokenExpires(t *testing.T) {
+	f := newActionTokenFixture(t)
+	token := f.changeEmail(t, "alice@example.com")
+
+	f.expireActionTokens(t)
+	if _, err := f.emails.Verify(f.ctx, token); errorCode(err) != goWebErrors.ErrInvalidParam {
+		t.Fatalf("expired verification token: err = %v, want ErrInvalidParam", err)
+	}
+	if u := f.client.User.GetX(f.ctx, f.user.ID); u.EmailVerifiedAt != nil {
+		t.Fatal("email verified by an expired token")
+	}
+}
+
+func TestEmailVerificationTokenRevokedByEmailChange(t *testing.T) {
+	f := newActionTokenFixture(t)
+
+	// 发往旧邮箱的链接不能验证新邮箱
+	token := f.changeEmail(t, "alice@example.com")
+	f.client.User.UpdateOneID(f.user.ID).SetEmail("mallory@example.com").ExecX(f.ctx)
+	if _, err := f.emails.Verify(f.ctx, token); errorCode(err) != goWebErrors.ErrInvalidParam {
+		t.Fatalf("verification token after an email change: err = %v, want ErrInvalidParam", err)
+	}
+
+	token = f.changeEmail(t, "alice@example.org")
+	f.client.User.UpdateOneID(f.user.ID).ClearEmail().ExecX(f.ctx)
+	if _, err := f.emails.Verify(f.ctx, token); errorCode(err) != goWebErrors.ErrInvalidParam {
+		t.Fatalf("verification token after clearing the email: err = %v, want ErrInvalidParam", err)
+	}
+
+	// 修改其他字段不影响验证链接
+	token = f.changeEmail(t, "alice@example.net")
+	f.client.User.UpdateOneID(f.user.ID).SetName("alice liddell").ExecX(f.ctx)
+	if _, err := f.emails.Verify(f.ctx, token); err != nil {
+		t.Fatalf("verification token after an unrelated update: %v", err)
+	}
+}
@@ -0,0 +1,61 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"go-web/ent"
+	"go-web/ent/user"
+	goWebErrors "go-web/pkg/errors"
+	"go-web/pkg/viewer"
+)
+
+// Grants 用户所属租户及其角色与权限，签发令牌时写入声明
+type Grants struct {
+	// 所属租户 ID
+	TenantID uint64
+	// 角色列表
+	Roles []string
+	// 权限列表
+	Permissions []string
+}
+
+// LoadGrants 读取用户所属租户、角色及其权限，用户不存在时返回 ErrUnauthorized。
+// 邮箱已验证的用户额外获得隐式权限 viewer.PermissionEmailVerified。
+func LoadGrants(ctx context.Context, client *ent.Client, userID uint64) (*Grants, error) {
+	// 签发令牌时访问者尚未建立，以系统身份读取授权
+	ctx = viewer.NewSystemContext(ctx)
+	u, err := client.User.Query().
+		Where(user.ID(userID)).
+		WithRoles(func(q *ent.RoleQuery) {
+			q.WithPermissions()
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, goWebErrors.New(goWebErrors.ErrUnauthorized, "unauthorized", "user no longer exists")
+		}
+		return nil, fmt.Errorf("failed to query user roles: %w", err)
+	}
+
+	grants := &Grants{TenantID: u.TenantID}
+	seen := make(map[string]struct{})
+	for _, r := range u.Edges.Roles {
+		grants.Roles = append(grants.Roles, r.Name)
+		for _, p := range r.Edges.Permissions {
+			if _, ok := seen[p.Name]; ok {
+				continue
+			}
+			seen[p.Name] = struct{}{}
+			grants.Permissions = append(grants.Permissions, p.Name)
+		}
+	}
+	if _, ok := seen[viewer.PermissionEmailVerified]; !ok && u.EmailVerifiedAt != nil {
+		grants.Permissions = append(grants.Permissions, viewer.PermissionEmailVerified)
+	}
+	sort.Strings(grants.Roles)
+	sort.Strings(grants.Permissions)
+
+	return grants, nil
+}
@@ -0,0 +1,220 @@
+package auth
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"go-web/pkg/cache"
+	"go-web/pkg/config"
+	goWebErrors "go-web/pkg/errors"
+	"go-web/pkg/tenancy"
+	"go-web/pkg/viewer"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// guardKeyPrefix 登录失败保护
+	guardKeyPrefix = "auth:guard:"
+
+	// GuardScopeAccount 按账号统计
+	GuardScopeAccount = "account"
+	// GuardScopeIP 按客户端 IP 统计
+	GuardScopeIP = "ip"
+)
+
+// GuardMetrics 登录失败保护的监控指标，由 HTTP 层实现并导出到 Prometheus
+type GuardMetrics interface {
+	// LoginFailed 记录一次登录失败
+	LoginFailed(scope string)
+	// LockedOut 记录一次锁定
+	LockedOut(scope string)
+}
+
+// LoginGuard 基于 Redis 的登录失败保护。
+// 按账号和客户端 IP 分别统计窗口内的失败次数：每次失败后需等待指数增长的时间才能再次尝试，
+// 失败次数达到阈值后在锁定时长内拒绝登录。
+type LoginGuard struct {
+	redis   *cache.RedisClient
+	metrics GuardMetrics
+	logger  *zap.Logger
+
+	window             time.Duration
+	maxAccountFailures int
+	maxIPFailures      int
+	lockout            time.Duration
+	baseDelay          time.Duration
+	maxDelay           time.Duration
+}
+
+// NewLoginGuard 创建登录失败保护
+func NewLoginGuard(cfg *config.Config, redis *cache.RedisClient, metrics GuardMetrics, logger *zap.Logger) *LoginGuard {
+	lc := cfg.Auth.Lockout
+	return &LoginGuard{
+		redis:              redis,
+		metrics:            metrics,
+		logger:             logger.With(zap.String("component", "login_guard")),
+		window:             lc.Window,
+		maxAccountFailures: lc.MaxAccountFailures,
+		maxIPFailures:      lc.MaxIPFailures,
+		lockout:            lc.Duration,
+		baseDelay:          lc.BaseDelay,
+		maxDelay:           lc.MaxDelay,
+	}
+}
+
+// Check 登录前检查账号与 IP 是否被锁定或仍在等待期内
+func (g *LoginGuard) Check(ctx context.Context, account, ip string) error {
+	for _, t := range g.targets(ctx, account, ip) {
+		ttl, err := g.redis.TTL(ctx, g.key("lock", t.scope, t.id))
+		if err != nil {
+			return err
+		}
+		if ttl > 0 {
+			return goWebErrors.New(goWebErrors.ErrAccountLocked, "account_locked", "retry after "+ttl.Round(time.Second).String())
+		}
+	}
+	for _, t := range g.targets(ctx, account, ip) {
+		ttl, err := g.redis.TTL(ctx, g.key("delay", t.scope, t.id))
+		if err != nil {
+			return err
+		}
+		if ttl > 0 {
+			return goWebErrors.New(goWebErrors.ErrTooManyAttempts, "too_many_attempts", "retry after "+ttl.Round(time.Second).String())
+		}
+	}
+	return nil
+}
+
+// Failure 记录一次登录失败，设置下一次尝试前的等待时间，达到阈值时锁定
+func (g *LoginGuard) Failure(ctx context.Context, account, ip string) error {
+	for _, t := range g.targets(ctx, account, ip) {
+		countKey := g.key("fail", t.scope, t.id)
+		n, err := g.redis.Incr(ctx, countKey)
+		if err != nil {
+			return err
+		}
+		if n == 1 {
+			if err := g.redis.Expire(ctx, countKey, g.window); err != nil {
+				return err
+			}
+		}
+		g.metrics.LoginFailed(t.scope)
+		g.logger.Warn("login failed",
+			zap.String("event", "login_failed"),
+			zap.String("scope", t.scope),
+			zap.String("account", account),
+			zap.String("ip", ip),
+			zap.Int64("failures", n),
+		)
+
+		if n >= int64(t.max) {
+			if err := g.redis.Set(ctx, g.key("lock", t.scope, t.id), n, g.lockout); err != nil {
+				return err
+			}
+			if err := g.redis.Delete(ctx, countKey); err != nil {
+				return err
+			}
+			g.metrics.LockedOut(t.scope)
+			g.logger.Warn("login locked out",
+				zap.String("event", t.scope+"_locked"),
+				zap.String("scope", t.scope),
+				zap.String("account", account),
+				zap.String("ip", ip),
+				zap.Int64("failures", n),
+				zap.Duration("duration", g.lockout),
+			)
+			continue
+		}
+
+		if err := g.redis.Set(ctx, g.key("delay", t.scope, t.id), n, g.delay(n)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Success 登录成功后清除账号的失败记录。IP 的失败记录保留，防止用一个有效账号掩护撞库。
+func (g *LoginGuard) Success(ctx context.Context, account string) error {
+	return g.reset(ctx, GuardScopeAccount, accountID(ctx, account), false)
+}
+
+// Unlock 解除账号锁定并清除失败记录
+func (g *LoginGuard) Unlock(ctx context.Context, account string) error {
+	if err := g.reset(ctx, GuardScopeAccount, accountID(ctx, account), true); err != nil {
+		return err
+	}
+	var by uint64
+	if v := viewer.FromContext(ctx); v != nil {
+		by = v.ID
+	}
+	g.logger.Warn("account unlocked",
+		zap.String("event", "account_unlocked"),
+		zap.String("scope", GuardScopeAccount),
+		zap.String("account", account),
+		zap.Uint64("by", by),
+	)
+	return nil
+}
+
+// reset 清除失败次数与等待时间，unlock 为 true 时同时解除锁定
+func (g *LoginGuard) reset(ctx context.Context, scope, id string, unlock bool) error {
+	kinds := []string{"fail", "delay"}
+	if unlock {
+		kinds = append(kinds, "lock")
+	}
+	for _, kind := range kinds {
+		if err := g.redis.Delete(ctx, g.key(kind, scope, id)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// delay 第 n 次失败后的等待时间：baseDelay * 2^(n-1)，不超过 maxDelay
+func (g *LoginGuard) delay(n int64) time.Duration {
+	d := g.baseDelay
+	for i := int64(1); i < n && d < g.maxDelay; i++ {
+		d *= 2
+	}
+	if d > g.maxDelay {
+		d = g.maxDelay
+	}
+	return d
+}
+
+// guardTarget 统计维度
+type guardTarget struct {
+	scope string
+	id    string
+	max   int
+}
+
+// targets 返回需要统计的维度，IP 未知时只统计账号
+func (g *LoginGuard) targets(ctx context.Context, account, ip string) []guardTarget {
+	targets := []guardTarget{{scope: GuardScopeAccount, id: accountID(ctx, account), max: g.maxAccountFailures}}
+	if ip != "" {
+		targets = append(targets, guardTarget{scope: GuardScopeIP, id: ip, max: g.maxIPFailures})
+	}
+	return targets
+}
+
+// key 生成 Redis 键，例如 auth:guard:lock:account:1:alice
+func (g *LoginGuard) key(kind, scope, id string) string {
+	return guardKeyPrefix + kind + ":" + scope + ":" + id
+}
+
+// accountID 账号统计维度的标识。账号只在租户内唯一，存在租户时以租户 ID 作为前缀
+func accountID(ctx context.Context, account string) string {
+	if id, ok := tenancy.FromContext(ctx); ok {
+		return strconv.FormatUint(id, 10) + ":" + normalizeAccount(account)
+	}
+	return normalizeAccount(account)
+}
+
+// normalizeAccount 账号不区分大小写与首尾空白，避免通过变换大小写绕过统计
+func normalizeAccount(account string) string {
+	return strings.ToLower(strings.TrimSpace(account))
+}
@@ -0,0 +1,204 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go-web/pkg/config"
+	goWebErrors "go-web/pkg/errors"
+	"go-web/pkg/tenancy"
+	"go-web/pkg/testutil"
+
+	"github.com/alicebob/miniredis/v2"
+	"go.uber.org/zap"
+)
+
+// guardMetrics 记录指标调用次数
+type guardMetrics struct {
+	failed map[string]int
+	locked map[string]int
+}
+
+func (m *guardMetrics) LoginFailed(scope string) { m.failed[scope]++ }
+func (m *guardMetrics) LockedOut(scope string)   { m.locked[scope]++ }
+
+func newLoginGuard(t *testing.T) (*LoginGuard, *miniredis.Miniredis, *guardMetrics) {
+	t.Helper()
+	cfg := &config.Config{}
+	cfg.Auth.Lockout.Window = 15 * time.Minute
+	cfg.Auth.Lockout.MaxAccountFailures = 3
+	cfg.Auth.Lockout.MaxIPFailures = 5
+	cfg.Auth.Lockout.Duration = 15 * time.Minute
+	cfg.Auth.Lockout.BaseDelay = time.Second
+	cfg.Auth.Lockout.MaxDelay = 30 * time.Second
+
+	rdb, mr := testutil.NewRedis(t)
+	metrics := &guardMetrics{failed: map[string]int{}, locked: map[string]int{}}
+	return NewLoginGuard(cfg, rdb, metrics, zap.NewNop()), mr, metrics
+}
+
+func TestLoginGuardDelay(t *testing.T) {
+	g, _, _ := newLoginGuard(t)
+	tests := []struct {
+		n    int64
+		want time.Duration
+	}{
+		{1, time.Second},
+		{2, 2 * time.Second},
+		{3, 4 * time.Second},
+		{5, 16 * time.Second},
+		{6, 30 * time.Second},
+		{64, 30 * time.Second},
+		{1 << 40, 30 * time.Second},
+	}
+	for _, tt := range tests {
+		if got := g.delay(tt.n); got != tt.want {
+			t.Fatalf("delay(%d) = %s, want %s", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestLoginGuardBackoff(t *testing.T) {
+	g, mr, _ := newLoginGuard(t)
+	ctx := context.Background()
+
+	if err := g.Failure(ctx, "alice", "10.0.0.1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Check(ctx, "alice", "10.0.0.1"); errorCode(err) != goWebErrors.ErrTooManyAttempts {
+		t.Fatalf("Check during delay: err = %v, want ErrTooManyAttempts", err)
+	}
+	if ttl := mr.TTL(g.key("delay", GuardScopeAccount, "alice")); ttl != time.Second {
+		t.Fatalf("first delay = %s, want 1s", ttl)
+	}
+
+	mr.FastForward(time.Second)
+	if err := g.Check(ctx, "alice", "10.0.0.1"); err != nil {
+		t.Fatalf("Check after delay: %v", err)
+	}
+	if err := g.Failure(ctx, "alice", "10.0.0.1"); err != nil {
+		t.Fatal(err)
+	}
+	if ttl := mr.TTL(g.key("delay", GuardScopeAccount, "alice")); ttl != 2*time.Second {
+		t.Fatalf("second delay = %s, want 2s", ttl)
+	}
+}
+
+func TestLoginGuardLocksAccount(t *testing.T) {
+	g, mr, metrics := newLoginGuard(t)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		if err := g.Failure(ctx, "alice", "10.0.0.1"); err != nil {
+			t.Fatal(err)
+		}
+		mr.FastForward(30 * time.Second)
+	}
+	// 账号不区分大小写，IP 变化也不能绕过账号锁定
+	if err := g.Check(ctx, " Alice ", "10.0.0.2"); errorCode(err) != goWebErrors.ErrAccountLocked {
+		t.Fatalf("Check locked account: err = %v, want ErrAccountLocked", err)
+	}
+	if metrics.locked[GuardScopeAccount] != 1 || metrics.failed[GuardScopeAccount] != 3 {
+		t.Fatalf("metrics = %+v", metrics)
+	}
+
+	mr.FastForward(15 * time.Minute)
+	if err := g.Check(ctx, "alice", "10.0.0.1"); err != nil {
+		t.Fatalf("Check after lockout: %v", err)
+	}
+	// 锁定结束后重新计数
+	if err := g.Failure(ctx, "alice", "10.0.0.1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Check(ctx, "alice", "10.0.0.1"); errorCode(err) != goWebErrors.ErrTooManyAttempts {
+		t.Fatalf("Check after new failure: err = %v, want ErrTooManyAttempts", err)
+	}
+}
+
+func TestLoginGuardWindowExpires(t *testing.T) {
+	g, mr, _ := newLoginGuard(t)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if err := g.Failure(ctx, "alice", ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+	mr.FastForward(15 * time.Minute)
+	if err := g.Failure(ctx, "alice", ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Check(ctx, "alice", ""); errorCode(err) == goWebErrors.ErrAccountLocked {
+		t.Fatal("failures outside the window counted towards the lockout")
+	}
+}
+
+func TestLoginGuardLocksIP(t *testing.T) {
+	g, mr, metrics := newLoginGuard(t)
+	ctx := context.Background()
+
+	// 同一 IP 对不同账号的失败累计到 IP 维度
+	for _, account := range []string{"a", "b", "c", "d", "e"} {
+		if err := g.Failure(ctx, account, "10.0.0.1"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	mr.FastForward(30 * time.Second)
+	if err := g.Check(ctx, "f", "10.0.0.1"); errorCode(err) != goWebErrors.ErrAccountLocked {
+		t.Fatalf("Check from locked IP: err = %v, want ErrAccountLocked", err)
+	}
+	if err := g.Check(ctx, "f", "10.0.0.2"); err != nil {
+		t.Fatalf("Check from another IP: %v", err)
+	}
+	if metrics.locked[GuardScopeIP] != 1 {
+		t.Fatalf("metrics = %+v", metrics)
+	}
+}
+
+func TestLoginGuardSuccessKeepsIPFailures(t *testing.T) {
+	g, _, _ := newLoginGuard(t)
+	ctx := context.Background()
+
+	if err := g.Failure(ctx, "alice", "10.0.0.1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Success(ctx, "alice"); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Check(ctx, "alice", ""); err != nil {
+		t.Fatalf("account after success: %v", err)
+	}
+	if err := g.Check(ctx, "alice", "10.0.0.1"); errorCode(err) != goWebErrors.ErrTooManyAttempts {
+		t.Fatalf("IP after success: err = %v, want ErrTooManyAttempts", err)
+	}
+}
+
+func TestLoginGuardUnlock(t *testing.T) {
+	g, _, _ := newLoginGuard(t)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		if err := g.Failure(ctx, "alice", ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := g.Check(ctx, "alice", ""); errorCode(err) != goWebErrors.ErrAccountLocked {
+		t.Fatalf("Check: err = %v, want ErrAccountLocked", err)
+	}
+	if err := g.Unlock(ctx, "alice"); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Check(ctx, "alice", ""); err != nil {
+		t.Fatalf("Check after unlock: %v", err)
+	}
+}
+
+func TestLoginGuardScopesAccountsByTenant(t *testing.T) {
+	if got, want := accountID(context.Background(), " Alice "), "alice"; got != want {
+		t.Fatalf("accountID without tenant = %q, want %q", got, want)
+	}
+	if got, want := accountID(tenancy.NewContext(context.Background(), 7), "Alice"), "7:alice"; got != want {
+		t.Fatalf("accountID with tenant = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,283 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"go-web/ent"
+	"go-web/ent/identity"
+	"go-web/pkg/cache"
+	"go-web/pkg/config"
+	goWebErrors "go-web/pkg/errors"
+	"go-web/pkg/oidc"
+	"go-web/pkg/viewer"
+
+	"go.uber.org/zap"
+)
+
+// oidcStateKeyPrefix 授权请求状态
+const oidcStateKeyPrefix = "auth:oidc:state:"
+
+// oidcState 授权请求期间保存在服务端的状态
+type oidcState struct {
+	Provider string `json:"provider"`
+	Nonce    string `json:"nonce"`
+	Verifier string `json:"verifier"`
+	// 已登录用户发起的请求用于关联外部身份
+	LinkUserID uint64 `json:"link_user_id,omitempty"`
+}
+
+// OIDCService OpenID Connect 登录与外部身份关联
+type OIDCService struct {
+	registry *oidc.Registry
+	redis    *cache.RedisClient
+	client   *ent.Client
+	stateTTL time.Duration
+	logger   *zap.Logger
+}
+
+// NewOIDCService 创建 OIDC 登录服务
+func NewOIDCService(cfg *config.Config, registry *oidc.Registry, redis *cache.RedisClient, client *ent.Client, logger *zap.Logger) *OIDCService {
+	return &OIDCService{
+		registry: registry,
+		redis:    redis,
+		client:   client,
+		stateTTL: cfg.Auth.OIDC.StateTTL,
+		logger:   logger.With(zap.String("component", "oidc_service")),
+	}
+}
+
+// Provider 获取身份提供方，未配置时返回 ErrNotFound
+func (s *OIDCService) Provider(name string) (*oidc.Provider, error) {
+	p, err := s.registry.Get(name)
+	if err != nil {
+		return nil, goWebErrors.New(goWebErrors.ErrNotFound, "not_found", err.Error())
+	}
+	return p, nil
+}
+
+// Begin 发起授权请求，返回身份提供方的授权地址与 state 的绑定值。linkUserID 不为 0 时，回调成功后外部身份关联到该用户。
+// 绑定值由调用方保存在发起请求的浏览器中（cookie），回调时交给 Complete 校验，防止将他人发起的授权回调用于当前浏览器。
+func (s *OIDCService) Begin(ctx context.Context, providerName string, linkUserID uint64) (authURL, binding string, err error) {
+	p, err := s.Provider(providerName)
+	if err != nil {
+		return "", "", err
+	}
+
+	req := oidc.AuthRequest{}
+	if req.State, err = oidc.NewState(); err != nil {
+		return "", "", err
+	}
+	if req.Nonce, err = oidc.NewNonce(); err != nil {
+		return "", "", err
+	}
+	if req.Verifier, err = oidc.NewCodeVerifier(); err != nil {
+		return "", "", err
+	}
+
+	authURL, err = p.AuthCodeURL(ctx, req)
+	if err != nil {
+		return "", "", err
+	}
+
+	state := oidcState{
+		Provider:   providerName,
+		Nonce:      req.Nonce,
+		Verifier:   req.Verifier,
+		LinkUserID: linkUserID,
+	}
+	if err := s.redis.Set(ctx, oidcStateKeyPrefix+req.State, state, s.stateTTL); err != nil {
+		return "", "", err
+	}
+	return authURL, stateBinding(req.State), nil
+}
+
+// Complete 处理授权回调：校验 state 及其与浏览器的绑定，用授权码换取并校验 ID 令牌，返回外部身份关联的用户
+func (s *OIDCService) Complete(ctx context.Context, providerName, stateParam, binding, code string) (*ent.User, error) {
+	p, err := s.Provider(providerName)
+	if err != nil {
+		return nil, err
+	}
+
+	// 先校验绑定，不属于当前浏览器的回调不消耗 state
+	if subtle.ConstantTimeCompare([]byte(binding), []byte(stateBinding(stateParam))) != 1 {
+		return nil, goWebErrors.New(goWebErrors.ErrUnauthorized, "unauthorized", "state does not belong to this browser")
+	}
+
+	// state 只能使用一次
+	data, err := s.redis.GetDel(ctx, oidcStateKeyPrefix+stateParam)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, goWebErrors.New(goWebErrors.ErrUnauthorized, "unauthorized", "invalid or expired state")
+	}
+	state := &oidcState{}
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal oidc state: %w", err)
+	}
+	if state.Provider != providerName {
+		return nil, goWebErrors.New(goWebErrors.ErrUnauthorized, "unauthorized", "state does not belong to provider")
+	}
+
+	token, err := p.Exchange(ctx, code, state.Verifier)
+	if err != nil {
+		s.logger.Warn("oidc code exchange failed", zap.String("provider", providerName), zap.Error(err))
+		return nil, goWebErrors.New(goWebErrors.ErrUnauthorized, "unauthorized", "code exchange failed")
+	}
+	claims, err := p.VerifyIDToken(ctx, token.IDToken, state.Nonce)
+	if err != nil {
+		s.logger.Warn("oidc id token rejected", zap.String("provider", providerName), zap.Error(err))
+		return nil, goWebErrors.New(goWebErrors.ErrUnauthorized, "unauthorized", "invalid id token")
+	}
+
+	return s.resolveUser(viewer.NewSystemContext(ctx), p, claims, state.LinkUserID)
+}
+
+// resolveUser 根据外部身份查找、关联或创建用户
+func (s *OIDCService) resolveUser(ctx context.Context, p *oidc.Provider, claims *oidc.IDTokenClaims, linkUserID uint64) (*ent.User, error) {
+	fields := []zap.Field{
+		zap.String("provider", p.Name()),
+		zap.String("issuer", claims.Issuer),
+		zap.String("subject", claims.Subject),
+	}
+
+	ident, err := s.client.Identity.Query().
+		Where(identity.Issuer(claims.Issuer), identity.Subject(claims.Subject)).
+		WithUser().
+		Only(ctx)
+	switch {
+	case err == nil:
+		// 用户已删除时，软删除过滤使关联的用户为空
+		if ident.Edges.User == nil {
+			return nil, goWebErrors.New(goWebErrors.ErrUnauthorized, "unauthorized", "user is deleted")
+		}
+		if linkUserID != 0 && ident.UserID != linkUserID {
+			return nil, goWebErrors.New(goWebErrors.ErrAlreadyExist, "already_exist", "identity is linked to another user")
+		}
+		update := s.client.Identity.UpdateOne(ident).SetLastLoginAt(time.Now())
+		if claims.Email != "" {
+			update.SetEmail(claims.Email)
+		}
+		if err := update.Exec(ctx); err != nil {
+			return nil, fmt.Errorf("failed to update identity: %w", err)
+		}
+		s.logger.Info("oidc login", append(fields, zap.Uint64("user_id", ident.UserID))...)
+		return ident.Edges.User, nil
+	case !ent.IsNotFound(err):
+		return nil, fmt.Errorf("failed to query identity: %w", err)
+	}
+
+	if linkUserID != 0 {
+		u, err := s.client.User.Get(ctx, linkUserID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query user: %w", err)
+		}
+		if err := s.createIdentity(ctx, s.client, p, claims, u.ID); err != nil {
+			return nil, err
+		}
+		s.logger.Info("oidc identity linked", append(fields, zap.Uint64("user_id", u.ID))...)
+		return u, nil
+	}
+
+	if !p.Config().AutoRegister {
+		return nil, goWebErrors.New(goWebErrors.ErrUnauthorized, "unauthorized", "identity is not linked to any user")
+	}
+
+	u, err := s.register(ctx, p, claims)
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Info("oidc user registered", append(fields, zap.Uint64("user_id", u.ID))...)
+	return u, nil
+}
+
+// register 为未关联的外部身份创建用户，用户只能通过外部身份登录
+func (s *OIDCService) register(ctx context.Context, p *oidc.Provider, claims *oidc.IDTokenClaims) (*ent.User, error) {
+	secret, err := oidc.NewState()
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	u, err := tx.User.Create().
+		SetName(displayName(claims)).
+		SetSex(false).
+		SetAge(0).
+		SetAccount(externalAccount(claims)).
+		// 随机密码，无法用于账号密码登录
+		SetPassword(secret).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		if ent.IsConstraintError(err) {
+			return nil, goWebErrors.New(goWebErrors.ErrAlreadyExist, "already_exist", "user already exists")
+		}
+		return nil, fmt.Errorf("failed to create user: %w", err)
+	}
+	if err := s.createIdentity(ctx, tx.Client(), p, claims, u.ID); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return u.Unwrap(), nil
+}
+
+// createIdentity 创建外部身份，并发关联同一身份时返回 ErrAlreadyExist
+func (s *OIDCService) createIdentity(ctx context.Context, client *ent.Client, p *oidc.Provider, claims *oidc.IDTokenClaims, userID uint64) error {
+	create := client.Identity.Create().
+		SetProvider(p.Name()).
+		SetIssuer(claims.Issuer).
+		SetSubject(claims.Subject).
+		SetUserID(userID).
+		SetLastLoginAt(time.Now())
+	if claims.Email != "" {
+		create.SetEmail(claims.Email)
+	}
+	if err := create.Exec(ctx); err != nil {
+		if ent.IsConstraintError(err) {
+			return goWebErrors.New(goWebErrors.ErrAlreadyExist, "already_exist", "identity is already linked")
+		}
+		return fmt.Errorf("failed to create identity: %w", err)
+	}
+	return nil
+}
+
+// stateBinding 返回 state 的哈希，作为保存在浏览器中的绑定值，cookie 中不出现 state 本身
+func stateBinding(state string) string {
+	sum := sha256.Sum256([]byte("oidc-state\x00" + state))
+	return hex.EncodeToString(sum[:])
+}
+
+// externalAccount 由签发者与 subject 生成稳定且唯一的账号
+func externalAccount(claims *oidc.IDTokenClaims) string {
+	sum := sha256.Sum256([]byte(claims.Issuer + "\x00" + claims.Subject))
+	return "oidc_" + hex.EncodeToString(sum[:])[:15]
+}
+
+// displayName 从声明中选取用户姓名，长度不超过 50 个字符
+func displayName(claims *oidc.IDTokenClaims) string {
+	name := claims.Name
+	if name == "" {
+		name = claims.PreferredUsername
+	}
+	if name == "" {
+		name = "OIDC User"
+	}
+	for utf8.RuneCountInString(name) > 50 {
+		_, size := utf8.DecodeLastRuneInString(name)
+		name = name[:len(name)-size]
+	}
+	return name
+}
@@ -0,0 +1,153 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go-web/ent"
+	"go-web/pkg/config"
+	goWebErrors "go-web/pkg/errors"
+	"go-web/pkg/oidc"
+	"go-web/pkg/oidc/oidctest"
+	"go-web/pkg/testutil"
+
+	"go.uber.org/zap"
+)
+
+// oidcFixture 连接到模拟身份提供方的 OIDC 登录服务
+type oidcFixture struct {
+	svc    *OIDCService
+	mock   *oidctest.Provider
+	client *ent.Client
+	ctx    context.Context
+}
+
+func newOIDCFixture(t *testing.T) *oidcFixture {
+	t.Helper()
+	mock := oidctest.NewProvider("client-id", "client-secret")
+	t.Cleanup(mock.Close)
+
+	cfg := &config.Config{}
+	cfg.Auth.OIDC.StateTTL = time.Minute
+	pc := mock.Config("http://localhost/auth/oidc/mock/callback")
+	pc.AutoRegister = true
+	cfg.Auth.OIDC.Providers = map[string]config.OIDCProvider{"mock": pc}
+
+	client := testutil.NewClient(t)
+	rdb, _ := testutil.NewRedis(t)
+	_, ctx := testutil.NewTenant(t, client, "acme")
+	return &oidcFixture{
+		svc:    NewOIDCService(cfg, oidc.NewRegistry(cfg), rdb, client, zap.NewNop()),
+		mock:   mock,
+		client: client,
+		ctx:    ctx,
+	}
+}
+
+// login 完成一次授权流程
+func (f *oidcFixture) login(t *testing.T) (*ent.User, error) {
+	t.Helper()
+	authURL, binding, err := f.svc.Begin(f.ctx, "mock", 0)
+	if err != nil {
+		t.Fatalf("Begin: %v", err)
+	}
+	callback, err := f.mock.Authorize(authURL)
+	if err != nil {
+		t.Fatalf("Authorize: %v", err)
+	}
+	q := callback.Query()
+	return f.svc.Complete(f.ctx, "mock", q.Get("state"), binding, q.Get("code"))
+}
+
+// errorCode 返回业务错误码，不是业务错误时返回 0
+func errorCode(err error) goWebErrors.ErrorCode {
+	var e *goWebErrors.Error
+	if errors.As(err, &e) {
+		return e.Code
+	}
+	return 0
+}
+
+func TestOIDCLoginRegistersAndReusesUser(t *testing.T) {
+	f := newOIDCFixture(t)
+
+	first, err := f.login(t)
+	if err != nil {
+		t.Fatalf("first login: %v", err)
+	}
+	second, err := f.login(t)
+	if err != nil {
+		t.Fatalf("second login: %v", err)
+	}
+	if first.ID != second.ID {
+		t.Fatalf("second login resolved user %d, want %d", second.ID, first.ID)
+	}
+}
+
+func TestOIDCLoginRejectsDeletedUser(t *testing.T) {
+	f := newOIDCFixture(t)
+
+	u, err := f.login(t)
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+	if err := f.client.User.DeleteOneID(u.ID).Exec(f.ctx); err != nil {
+		t.Fatalf("delete user: %v", err)
+	}
+
+	u, err = f.login(t)
+	if errorCode(err) != goWebErrors.ErrUnauthorized {
+		t.Fatalf("login after deletion = (%v, %v), want ErrUnauthorized", u, err)
+	}
+}
+
+func TestOIDCStateIsSingleUse(t *testing.T) {
+	f := newOIDCFixture(t)
+
+	authURL, binding, err := f.svc.Begin(f.ctx, "mock", 0)
+	if err != nil {
+		t.Fatalf("Begin: %v", err)
+	}
+	callback, err := f.mock.Authorize(authURL)
+	if err != nil {
+		t.Fatalf("Authorize: %v", err)
+	}
+	q := callback.Query()
+	if _, err := f.svc.Complete(f.ctx, "mock", q.Get("state"), binding, q.Get("code")); err != nil {
+		t.Fatalf("Complete: %v", err)
+	}
+	if _, err := f.svc.Complete(f.ctx, "mock", q.Get("state"), binding, q.Get("code")); errorCode(err) != goWebErrors.ErrUnauthorized {
+		t.Fatalf("replayed state error = %v, want ErrUnauthorized", err)
+	}
+}
+
+func TestOIDCStateMustMatchBrowserBinding(t *testing.T) {
+	f := newOIDCFixture(t)
+
+	// 攻击者发起授权，将回调地址交给受害者的浏览器，受害者的浏览器没有对应的绑定值
+	authURL, binding, err := f.svc.Begin(f.ctx, "mock", 0)
+	if err != nil {
+		t.Fatalf("Begin: %v", err)
+	}
+	callback, err := f.mock.Authorize(authURL)
+	if err != nil {
+		t.Fatalf("Authorize: %v", err)
+	}
+	q := callback.Query()
+	_, otherBinding, err := f.svc.Begin(f.ctx, "mock", 0)
+	if err != nil {
+		t.Fatalf("Begin: %v", err)
+	}
+	for _, b := range []string{"", otherBinding, q.Get("state")} {
+		if _, err := f.svc.Complete(f.ctx, "mock", q.Get("state"), b, q.Get("code")); errorCode(err) != goWebErrors.ErrUnauthorized {
+			t.Fatalf("Complete with binding %q error = %v, want ErrUnauthorized", b, err)
+		}
+	}
+
+	// 不匹配的回调不消耗 state，发起授权的浏览器仍然可以完成登录
+	if _, err := f.svc.Complete(f.ctx, "mock", q.Get("state"), binding, q.Get("code")); err != nil {
+		t.Fatalf("Complete with matching binding: %v", err)
+	}
+}
@@ -0,0 +1,164 @@
+package auth
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"go-web/ent"
+	"go-web/ent/actiontoken"
+	"go-web/pkg/config"
+	goWebErrors "go-web/pkg/errors"
+	"go-web/pkg/notify"
+	"go-web/pkg/testutil"
+
+	"entgo.io/ent/dialect/sql"
+	"go.uber.org/zap"
+)
+
+// recordingNotifier 记录发送的通知
+type recordingNotifier struct {
+	messages []*notify.Message
+}
+
+func (n *recordingNotifier) Send(_ context.Context, msg *notify.Message) error {
+	n.messages = append(n.messages, msg)
+	return nil
+}
+
+// lastToken 返回最后一条通知中链接携带的令牌
+func (n *recordingNotifier) lastToken(t *testing.T) string {
+	t.Helper()
+	if len(n.messages) == 0 {
+		t.Fatal("no notification was sent")
+	}
+	for _, field := range strings.Fields(n.messages[len(n.messages)-1].Body) {
+		if u, err := url.Parse(field); err == nil && u.Query().Has("token") {
+			return u.Query().Get("token")
+		}
+	}
+	t.Fatalf("notification has no token link: %s", n.messages[len(n.messages)-1].Body)
+	return ""
+}
+
+// actionTokenFixture 用户及签发一次性令牌的密码与邮箱服务
+type actionTokenFixture struct {
+	client    *ent.Client
+	ctx       context.Context
+	user      *ent.User
+	notifier  *recordingNotifier
+	passwords *PasswordService
+	emails    *EmailService
+}
+
+func newActionTokenFixture(t *testing.T) *actionTokenFixture {
+	t.Helper()
+	client := testutil.NewClient(t)
+	_, ctx := testutil.NewTenant(t, client, "acme")
+	rdb, _ := testutil.NewRedis(t)
+
+	cfg := &config.Config{}
+	cfg.Auth.JWT.Secret = "k3J9vQ2xT7mR4wZ8pL1nB6cF5hD0sG3y"
+	cfg.Auth.JWT.AccessTTL = time.Minute
+	cfg.Auth.JWT.RefreshTTL = time.Hour
+	cfg.Auth.Session.TTL = time.Hour
+	cfg.Auth.PasswordReset.TokenTTL = time.Hour
+	cfg.Auth.PasswordReset.URL = "https://example.com/reset-password"
+	cfg.Auth.EmailVerification.TokenTTL = time.Hour
+	cfg.Auth.EmailVerification.URL = "https://example.com/verify-email"
+
+	u, err := client.User.Create().
+		SetName("alice").
+		SetSex(false).
+		SetAge(30).
+		SetAccount("alice").
+		SetPassword("correct horse").
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	notifier := &recordingNotifier{}
+	tokens := NewTokenService(NewTokenManager(cfg), rdb, client, zap.NewNop())
+	sessions := NewSessionStore(cfg, rdb, client, zap.NewNop())
+	return &actionTokenFixture{
+		client:    client,
+		ctx:       ctx,
+		user:      u,
+		notifier:  notifier,
+		passwords: NewPasswordService(cfg, client, notifier, tokens, sessions, zap.NewNop()),
+		emails:    NewEmailService(cfg, client, notifier, zap.NewNop()),
+	}
+}
+
+// expireActionTokens 将用户尚未使用的令牌改为已过期，过期时间不可修改，直接修改底层的列
+func (f *actionTokenFixture) expireActionTokens(t *testing.T) {
+	t.Helper()
+	f.client.ActionToken.Update().
+		Where(actiontoken.UserID(f.user.ID), actiontoken.UsedAtIsNil()).
+		Modify(func(u *sql.UpdateBuilder) {
+			u.Set(actiontoken.FieldExpiresAt, time.Now().Add(-time.Second))
+		}).
+		ExecX(f.ctx)
+}
+
+// requestReset 申请密码重置并返回通知中的令牌
+func (f *actionTokenFixture) requestReset(t *testing.T) string {
+	t.Helper()
+	if err := f.passwords.RequestReset(f.ctx, f.user.Account); err != nil {
+		t.Fatal(err)
+	}
+	return f.notifier.lastToken(t)
+}
+
+func TestPasswordResetTokenIsSingleUse(t *testing.T) {
+	f := newActionTokenFixture(t)
+	token := f.requestReset(t)
+
+	if err := f.passwords.Reset(f.ctx, token, "new password"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewAuthenticator(f.client, zap.NewNop()).Authenticate(f.ctx, "alice", "new password"); err != nil {
+		t.Fatalf("login with the new password: %v", err)
+	}
+	if err := f.passwords.Reset(f.ctx, token, "another password"); errorCode(err) != goWebErrors.ErrInvalidParam {
+		t.Fatalf("second use of the reset token: err = %v, want ErrInvalidParam", err)
+	}
+}
+
+func TestPasswordResetTokenExpires(t *testing.T) {
+	f := newActionTokenFixture(t)
+	token := f.requestReset(t)
+
+	f.expireActionTokens(t)
+	if err := f.passwords.Reset(f.ctx, token, "new password"); errorCode(err) != goWebErrors.ErrInvalidParam {
+		t.Fatalf("expired reset token: err = %v, want ErrInvalidParam", err)
+	}
+	if _, err := NewAuthenticator(f.client, zap.NewNop()).Authenticate(f.ctx, "alice", "correct horse"); err != nil {
+		t.Fatalf("password changed by an expired token: %v", err)
+	}
+}
+
+func TestPasswordResetTokenRevokedByPasswordChange(t *testing.T) {
+	f := newActionTokenFixture(t)
+	token := f.requestReset(t)
+
+	if err := f.passwords.Change(f.ctx, f.user.ID, "correct horse", "changed password"); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.passwords.Reset(f.ctx, token, "new password"); errorCode(err) != goWebErrors.ErrInvalidParam {
+		t.Fatalf("reset token after a password change: err = %v, want ErrInvalidParam", err)
+	}
+
+	// 其他途径修改密码同样作废重置令牌
+	next := f.requestReset(t)
+	if next == token {
+		t.Fatal("no new reset token was issued")
+	}
+	f.client.User.UpdateOneID(f.user.ID).SetPassword("admin password").ExecX(f.ctx)
+	if err := f.passwords.Reset(f.ctx, next, "new password"); errorCode(err) != goWebErrors.ErrInvalidParam {
+		t.Fatalf("reset token after an update of the password: err = %v, want ErrInvalidParam", err)
+	}
+}
@@ -0,0 +1,197 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"go-web/ent"
+	"go-web/pkg/cache"
+	"go-web/pkg/config"
+	goWebErrors "go-web/pkg/errors"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// ModeJWT 使用 Bearer 令牌认证
+	ModeJWT = "jwt"
+	// ModeSession 使用服务端会话认证
+	ModeSession = "session"
+
+	// sessionKeyPrefix 会话数据
+	sessionKeyPrefix = "auth:session:"
+	// userSessionsKeySuffix 用户全部会话 ID 集合
+	userSessionsKeySuffix = ":sessions"
+)
+
+// Session 服务端会话数据
+type Session struct {
+	// 不透明的会话 ID，仅保存在 cookie 中
+	ID string `json:"-"`
+	// 用户 ID
+	UserID uint64 `json:"user_id"`
+	// 所属租户 ID
+	TenantID uint64 `json:"tenant_id"`
+	// 角色列表
+	Roles []string `json:"roles,omitempty"`
+	// 权限列表
+	Permissions []string `json:"perms,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at"`
+	// 过期时间，每次访问后顺延
+	ExpiresAt time.Time `json:"-"`
+}
+
+// SessionCookie 会话 cookie 写入器，由会话中间件注入请求 context
+type SessionCookie interface {
+	// Set 写入会话 cookie
+	Set(s *Session)
+	// Clear 清除会话 cookie
+	Clear()
+}
+
+// SessionStore 基于 Redis 的会话存储，会话在每次访问后滑动续期
+type SessionStore struct {
+	redis  *cache.RedisClient
+	client *ent.Client
+	ttl    time.Duration
+	logger *zap.Logger
+}
+
+// NewSessionStore 创建会话存储
+func NewSessionStore(cfg *config.Config, redis *cache.RedisClient, client *ent.Client, logger *zap.Logger) *SessionStore {
+	return &SessionStore{
+		redis:  redis,
+		client: client,
+		ttl:    cfg.Auth.Session.TTL,
+		logger: logger.With(zap.String("component", "session_store")),
+	}
+}
+
+// Create 为用户创建新会话，总是生成新的会话 ID
+func (s *SessionStore) Create(ctx context.Context, userID uint64) (*Session, error) {
+	grants, err := LoadGrants(ctx, s.client, userID)
+	if err != nil {
+		return nil, err
+	}
+	id, err := newSessionID()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	sess := &Session{
+		ID:          id,
+		UserID:      userID,
+		TenantID:    grants.TenantID,
+		Roles:       grants.Roles,
+		Permissions: grants.Permissions,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(s.ttl),
+	}
+	if err := s.redis.Set(ctx, sessionKeyPrefix+id, sess, s.ttl); err != nil {
+		return nil, err
+	}
+	if err := s.redis.SAdd(ctx, userSessionsKey(userID), id); err != nil {
+		return nil, err
+	}
+	if err := s.redis.Expire(ctx, userSessionsKey(userID), s.ttl); err != nil {
+		return nil, err
+	}
+
+	return sess, nil
+}
+
+// Login 登录时创建新会话并写入 cookie。
+// 请求携带的旧会话会先被销毁，防止会话固定攻击。
+func (s *SessionStore) Login(ctx context.Context, userID uint64) (*Session, error) {
+	cookie := SessionCookieFromContext(ctx)
+	if cookie == nil {
+		return nil, goWebErrors.New(goWebErrors.ErrSystem, "system_error", "session cookie writer is missing")
+	}
+
+	if old := SessionFromContext(ctx); old != nil {
+		if err := s.Destroy(ctx, old); err != nil {
+			return nil, err
+		}
+	}
+
+	sess, err := s.Create(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	cookie.Set(sess)
+
+	return sess, nil
+}
+
+// Get 读取会话并顺延过期时间，会话不存在或已过期时返回 nil
+func (s *SessionStore) Get(ctx context.Context, id string) (*Session, error) {
+	if id == "" {
+		return nil, nil
+	}
+	data, err := s.redis.Get(ctx, sessionKeyPrefix+id)
+	if err != nil || data == nil {
+		return nil, err
+	}
+	sess := &Session{}
+	if err := json.Unmarshal(data, sess); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal session: %w", err)
+	}
+	sess.ID = id
+	sess.ExpiresAt = time.Now().Add(s.ttl)
+
+	if err := s.redis.Expire(ctx, sessionKeyPrefix+id, s.ttl); err != nil {
+		return nil, err
+	}
+	if err := s.redis.Expire(ctx, userSessionsKey(sess.UserID), s.ttl); err != nil {
+		return nil, err
+	}
+	return sess, nil
+}
+
+// Destroy 删除会话
+func (s *SessionStore) Destroy(ctx context.Context, sess *Session) error {
+	if err := s.redis.Delete(ctx, sessionKeyPrefix+sess.ID); err != nil {
+		return err
+	}
+	return s.redis.SRem(ctx, userSessionsKey(sess.UserID), sess.ID)
+}
+
+// DestroyAll 删除用户的全部会话
+func (s *SessionStore) DestroyAll(ctx context.Context, userID uint64) error {
+	ids, err := s.redis.SMembers(ctx, userSessionsKey(userID))
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if err := s.redis.Delete(ctx, sessionKeyPrefix+id); err != nil {
+			return err
+		}
+	}
+	if err := s.redis.Delete(ctx, userSessionsKey(userID)); err != nil {
+		return err
+	}
+
+	s.logger.Info("all sessions destroyed", zap.Uint64("user_id", userID))
+	return nil
+}
+
+// newSessionID 生成 256 位随机会话 ID
+func newSessionID() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate session id: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// userSessionsKey 用户会话集合的键
+func userSessionsKey(userID uint64) string {
+	return sessionKeyPrefix + "user:" + strconv.FormatUint(userID, 10) + userSessionsKeySuffix
+}
@@ -0,0 +1,172 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"go-web/pkg/config"
+	goWebErrors "go-web/pkg/errors"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// TokenType 令牌类型
+type TokenType string
+
+const (
+	// TokenTypeAccess 访问令牌
+	TokenTypeAccess TokenType = "access"
+	// TokenTypeRefresh 刷新令牌
+	TokenTypeRefresh TokenType = "refresh"
+)
+
+// Claims JWT 声明
+type Claims struct {
+	jwt.RegisteredClaims
+	// 令牌类型
+	Type TokenType `json:"typ"`
+	// 令牌族 ID，同一次登录轮换出的令牌属于同一族
+	Family string `json:"fam"`
+	// 所属租户 ID
+	TenantID uint64 `json:"tid,string"`
+	// 角色列表
+	Roles []string `json:"roles,omitempty"`
+	// 权限列表
+	Permissions []string `json:"perms,omitempty"`
+}
+
+// UserID 返回令牌所属用户 ID
+func (c *Claims) UserID() (uint64, error) {
+	return strconv.ParseUint(c.Subject, 10, 64)
+}
+
+// TokenPair 登录签发的令牌对
+type TokenPair struct {
+	// 访问令牌
+	AccessToken string
+	// 刷新令牌
+	RefreshToken string
+	// 访问令牌过期时间
+	ExpiresAt time.Time
+	// 访问令牌声明
+	Access *Claims
+	// 刷新令牌声明
+	Refresh *Claims
+}
+
+// TokenManager 签发与校验 JWT
+type TokenManager struct {
+	secret     []byte
+	issuer     string
+	accessTTL  time.Duration
+	refreshTTL time.Duration
+	now        func() time.Time
+}
+
+// NewTokenManager 根据配置创建令牌管理器
+func NewTokenManager(cfg *config.Config) *TokenManager {
+	return &TokenManager{
+		secret:     []byte(cfg.Auth.JWT.Secret),
+		issuer:     cfg.Auth.JWT.Issuer,
+		accessTTL:  cfg.Auth.JWT.AccessTTL,
+		refreshTTL: cfg.Auth.JWT.RefreshTTL,
+		now:        time.Now,
+	}
+}
+
+// Issue 为用户签发属于 family 令牌族的访问令牌和刷新令牌，family 为空时创建新令牌族
+func (m *TokenManager) Issue(userID uint64, grants *Grants, family string) (*TokenPair, error) {
+	if family == "" {
+		family = uuid.New().String()
+	}
+	now := m.now()
+
+	access, accessClaims, err := m.sign(userID, grants, family, TokenTypeAccess, now, m.accessTTL)
+	if err != nil {
+		return nil, err
+	}
+	refresh, refreshClaims, err := m.sign(userID, grants, family, TokenTypeRefresh, now, m.refreshTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TokenPair{
+		AccessToken:  access,
+		RefreshToken: refresh,
+		ExpiresAt:    accessClaims.ExpiresAt.Time,
+		Access:       accessClaims,
+		Refresh:      refreshClaims,
+	}, nil
+}
+
+// RefreshTTL 返回刷新令牌有效期
+func (m *TokenManager) RefreshTTL() time.Duration {
+	return m.refreshTTL
+}
+
+// Remaining 返回令牌剩余有效期
+func (m *TokenManager) Remaining(claims *Claims) time.Duration {
+	if claims.ExpiresAt == nil {
+		return 0
+	}
+	return claims.ExpiresAt.Time.Sub(m.now())
+}
+
+// Parse 校验令牌签名、有效期和类型，失败时返回 ErrUnauthorized 或 ErrTokenExpired
+func (m *TokenManager) Parse(token string, typ TokenType) (*Claims, error) {
+	claims := &Claims{}
+	_, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		return m.secret, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(m.issuer),
+		jwt.WithTimeFunc(m.now),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return nil, goWebErrors.New(goWebErrors.ErrTokenExpired, "token_expired")
+		}
+		return nil, goWebErrors.New(goWebErrors.ErrUnauthorized, "unauthorized", "invalid token")
+	}
+
+	if claims.Type != typ {
+		return nil, goWebErrors.New(goWebErrors.ErrUnauthorized, "unauthorized", "unexpected token type")
+	}
+	if claims.ID == "" || claims.Family == "" {
+		return nil, goWebErrors.New(goWebErrors.ErrUnauthorized, "unauthorized", "invalid token id")
+	}
+	if _, err := claims.UserID(); err != nil {
+		return nil, goWebErrors.New(goWebErrors.ErrUnauthorized, "unauthorized", "invalid token subject")
+	}
+
+	return claims, nil
+}
+
+// sign 签发单个令牌
+func (m *TokenManager) sign(userID uint64, grants *Grants, family string, typ TokenType, now time.Time, ttl time.Duration) (string, *Claims, error) {
+	claims := &Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.New().String(),
+			Issuer:    m.issuer,
+			Subject:   strconv.FormatUint(userID, 10),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+		},
+		Type:        typ,
+		Family:      family,
+		TenantID:    grants.TenantID,
+		Roles:       grants.Roles,
+		Permissions: grants.Permissions,
+	}
+
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(m.secret)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to sign %s token: %w", typ, err)
+	}
+	return signed, claims, nil
+}
@@ -0,0 +1,246 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"go-web/ent"
+	"go-web/pkg/cache"
+	goWebErrors "go-web/pkg/errors"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// denylistKeyPrefix 已吊销令牌 ID
+	denylistKeyPrefix = "auth:denylist:"
+	// usedRefreshKeyPrefix 已使用的刷新令牌 ID
+	usedRefreshKeyPrefix = "auth:refresh:used:"
+	// familyKeyPrefix 令牌族当前状态
+	familyKeyPrefix = "auth:family:"
+	// userFamiliesKeyPrefix 用户的全部令牌族
+	userFamiliesKeyPrefix = "auth:user:"
+)
+
+// family 令牌族当前状态，只有最新签发的刷新令牌可以使用
+type family struct {
+	UserID     uint64    `json:"user_id"`
+	RefreshJTI string    `json:"refresh_jti"`
+	AccessJTI  string    `json:"access_jti"`
+	AccessExp  time.Time `json:"access_exp"`
+	RefreshExp time.Time `json:"refresh_exp"`
+}
+
+// TokenService 基于 Redis 管理令牌轮换与吊销
+type TokenService struct {
+	tokens *TokenManager
+	redis  *cache.RedisClient
+	client *ent.Client
+	logger *zap.Logger
+}
+
+// NewTokenService 创建令牌服务
+func NewTokenService(tokens *TokenManager, redis *cache.RedisClient, client *ent.Client, logger *zap.Logger) *TokenService {
+	return &TokenService{
+		tokens: tokens,
+		redis:  redis,
+		client: client,
+		logger: logger.With(zap.String("component", "token_service")),
+	}
+}
+
+// Login 为用户创建新的令牌族并签发带有当前角色与权限的令牌对
+func (s *TokenService) Login(ctx context.Context, userID uint64) (*TokenPair, error) {
+	grants, err := LoadGrants(ctx, s.client, userID)
+	if err != nil {
+		return nil, err
+	}
+	pair, err := s.tokens.Issue(userID, grants, "")
+	if err != nil {
+		return nil, err
+	}
+	if err := s.saveFamily(ctx, pair); err != nil {
+		return nil, err
+	}
+	return pair, nil
+}
+
+// Refresh 使用刷新令牌轮换出新的令牌对。刷新令牌只能使用一次，
+// 重复使用已轮换的刷新令牌视为令牌泄露，整个令牌族会被吊销。
+func (s *TokenService) Refresh(ctx context.Context, refreshToken string) (*TokenPair, error) {
+	claims, err := s.tokens.Parse(refreshToken, TokenTypeRefresh)
+	if err != nil {
+		return nil, err
+	}
+
+	fam, err := s.loadFamily(ctx, claims.Family)
+	if err != nil {
+		return nil, err
+	}
+	if fam == nil {
+		return nil, goWebErrors.New(goWebErrors.ErrUnauthorized, "unauthorized", "token revoked")
+	}
+
+	// 标记刷新令牌已使用，并发请求中只有一个能成功
+	first, err := s.redis.SetNX(ctx, usedRefreshKeyPrefix+claims.ID, "1", s.ttl(claims))
+	if err != nil {
+		return nil, err
+	}
+	if !first || fam.RefreshJTI != claims.ID {
+		s.logger.Warn("refresh token reuse detected, revoking token family",
+			zap.String("family", claims.Family),
+			zap.String("jti", claims.ID),
+			zap.String("user_id", claims.Subject),
+		)
+		if err := s.RevokeFamily(ctx, claims.Family); err != nil {
+			return nil, err
+		}
+		return nil, goWebErrors.New(goWebErrors.ErrUnauthorized, "unauthorized", "token reused")
+	}
+
+	// 重新读取角色与权限，使授权变更在下一次刷新时生效
+	userID, _ := claims.UserID()
+	grants, err := LoadGrants(ctx, s.client, userID)
+	if err != nil {
+		if revokeErr := s.RevokeFamily(ctx, claims.Family); revokeErr != nil {
+			return nil, revokeErr
+		}
+		return nil, err
+	}
+	pair, err := s.tokens.Issue(userID, grants, claims.Family)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.saveFamily(ctx, pair); err != nil {
+		return nil, err
+	}
+	return pair, nil
+}
+
+// Validate 校验访问令牌并检查是否已被吊销
+func (s *TokenService) Validate(ctx context.Context, accessToken string) (*Claims, error) {
+	claims, err := s.tokens.Parse(accessToken, TokenTypeAccess)
+	if err != nil {
+		return nil, err
+	}
+
+	revoked, err := s.redis.Exists(ctx, denylistKeyPrefix+claims.ID)
+	if err != nil {
+		return nil, err
+	}
+	if revoked {
+		return nil, goWebErrors.New(goWebErrors.ErrUnauthorized, "unauthorized", "token revoked")
+	}
+
+	return claims, nil
+}
+
+// Logout 吊销访问令牌及其所属的令牌族
+func (s *TokenService) Logout(ctx context.Context, access *Claims) error {
+	if err := s.deny(ctx, access.ID, s.ttl(access)); err != nil {
+		return err
+	}
+	return s.RevokeFamily(ctx, access.Family)
+}
+
+// LogoutAll 吊销用户的全部令牌族
+func (s *TokenService) LogoutAll(ctx context.Context, userID uint64) error {
+	families, err := s.redis.SMembers(ctx, userFamiliesKey(userID))
+	if err != nil {
+		return err
+	}
+	for _, id := range families {
+		if err := s.RevokeFamily(ctx, id); err != nil {
+			return err
+		}
+	}
+	return s.redis.Delete(ctx, userFamiliesKey(userID))
+}
+
+// RevokeFamily 吊销令牌族：最新的访问令牌和刷新令牌加入黑名单，令牌族不可再刷新
+func (s *TokenService) RevokeFamily(ctx context.Context, id string) error {
+	fam, err := s.loadFamily(ctx, id)
+	if err != nil {
+		return err
+	}
+	if fam == nil {
+		return nil
+	}
+
+	now := time.Now()
+	if err := s.deny(ctx, fam.AccessJTI, fam.AccessExp.Sub(now)); err != nil {
+		return err
+	}
+	if err := s.deny(ctx, fam.RefreshJTI, fam.RefreshExp.Sub(now)); err != nil {
+		return err
+	}
+	if err := s.redis.Delete(ctx, familyKeyPrefix+id); err != nil {
+		return err
+	}
+	if err := s.redis.SRem(ctx, userFamiliesKey(fam.UserID), id); err != nil {
+		return err
+	}
+
+	s.logger.Info("token family revoked",
+		zap.String("family", id),
+		zap.Uint64("user_id", fam.UserID),
+	)
+	return nil
+}
+
+// saveFamily 记录令牌族最新签发的令牌
+func (s *TokenService) saveFamily(ctx context.Context, pair *TokenPair) error {
+	userID, _ := pair.Refresh.UserID()
+	fam := family{
+		UserID:     userID,
+		RefreshJTI: pair.Refresh.ID,
+		AccessJTI:  pair.Access.ID,
+		AccessExp:  pair.Access.ExpiresAt.Time,
+		RefreshExp: pair.Refresh.ExpiresAt.Time,
+	}
+	ttl := s.ttl(pair.Refresh)
+	if err := s.redis.Set(ctx, familyKeyPrefix+pair.Refresh.Family, fam, ttl); err != nil {
+		return err
+	}
+	if err := s.redis.SAdd(ctx, userFamiliesKey(userID), pair.Refresh.Family); err != nil {
+		return err
+	}
+	return s.redis.Expire(ctx, userFamiliesKey(userID), s.tokens.RefreshTTL())
+}
+
+// loadFamily 读取令牌族，不存在或已吊销时返回 nil
+func (s *TokenService) loadFamily(ctx context.Context, id string) (*family, error) {
+	data, err := s.redis.Get(ctx, familyKeyPrefix+id)
+	if err != nil || data == nil {
+		return nil, err
+	}
+	fam := &family{}
+	if err := json.Unmarshal(data, fam); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal token family: %w", err)
+	}
+	return fam, nil
+}
+
+// deny 将令牌 ID 加入黑名单，过期时间与令牌剩余有效期一致
+func (s *TokenService) deny(ctx context.Context, jti string, ttl time.Duration) error {
+	if jti == "" || ttl <= 0 {
+		return nil
+	}
+	return s.redis.Set(ctx, denylistKeyPrefix+jti, "1", ttl)
+}
+
+// ttl 返回令牌剩余有效期，至少为 1 秒
+func (s *TokenService) ttl(claims *Claims) time.Duration {
+	if d := s.tokens.Remaining(claims); d > time.Second {
+		return d
+	}
+	return time.Second
+}
+
+// userFamiliesKey 用户令牌族集合的键
+func userFamiliesKey(userID uint64) string {
+	return userFamiliesKeyPrefix + strconv.FormatUint(userID, 10) + ":families"
+}
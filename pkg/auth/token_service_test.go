@@ -0,0 +1,198 @@
+package auth
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"go-web/pkg/config"
+	goWebErrors "go-web/pkg/errors"
+	"go-web/pkg/testutil"
+
+	"go.uber.org/zap"
+)
+
+// newTokenService 创建连接到内存数据库与 Redis 的令牌服务，并返回一个用户 ID
+func newTokenService(t *testing.T) (*TokenService, context.Context, uint64) {
+	t.Helper()
+	cfg := &config.Config{}
+	cfg.Auth.JWT.Secret = "k3J9vQ2xT7mR4wZ8pL1nB6cF5hD0sG3y"
+	cfg.Auth.JWT.Issuer = "go-web"
+	cfg.Auth.JWT.AccessTTL = 15 * time.Minute
+	cfg.Auth.JWT.RefreshTTL = time.Hour
+
+	client := testutil.NewClient(t)
+	rdb, _ := testutil.NewRedis(t)
+	_, ctx := testutil.NewTenant(t, client, "acme")
+	u, err := client.User.Create().
+		SetName("alice").
+		SetSex(false).
+		SetAge(30).
+		SetAccount("alice").
+		SetPassword("correct horse").
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewTokenService(NewTokenManager(cfg), rdb, client, zap.NewNop()), ctx, u.ID
+}
+
+func TestRefreshRotatesTokens(t *testing.T) {
+	s, ctx, userID := newTokenService(t)
+
+	first, err := s.Login(ctx, userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := s.Refresh(ctx, first.RefreshToken)
+	if err != nil {
+		t.Fatalf("Refresh: %v", err)
+	}
+	if second.Refresh.ID == first.Refresh.ID || second.Access.ID == first.Access.ID {
+		t.Fatal("refresh did not issue new token ids")
+	}
+	if second.Refresh.Family != first.Refresh.Family {
+		t.Fatalf("family = %s, want %s", second.Refresh.Family, first.Refresh.Family)
+	}
+	if _, err := s.Validate(ctx, second.AccessToken); err != nil {
+		t.Fatalf("Validate rotated access token: %v", err)
+	}
+
+	// 轮换后的刷新令牌可以继续轮换
+	if _, err := s.Refresh(ctx, second.RefreshToken); err != nil {
+		t.Fatalf("Refresh rotated token: %v", err)
+	}
+}
+
+func TestRefreshReuseRevokesFamily(t *testing.T) {
+	s, ctx, userID := newTokenService(t)
+
+	first, err := s.Login(ctx, userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := s.Refresh(ctx, first.RefreshToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := s.Refresh(ctx, first.RefreshToken); errorCode(err) != goWebErrors.ErrUnauthorized {
+		t.Fatalf("reused refresh token: err = %v, want ErrUnauthorized", err)
+	}
+	// 令牌族被吊销，攻击者与合法用户持有的最新令牌都失效
+	if _, err := s.Refresh(ctx, second.RefreshToken); errorCode(err) != goWebErrors.ErrUnauthorized {
+		t.Fatalf("refresh after reuse: err = %v, want ErrUnauthorized", err)
+	}
+	if _, err := s.Validate(ctx, second.AccessToken); errorCode(err) != goWebErrors.ErrUnauthorized {
+		t.Fatalf("access token after reuse: err = %v, want ErrUnauthorized", err)
+	}
+}
+
+func TestRefreshReuseLeavesOtherFamilies(t *testing.T) {
+	s, ctx, userID := newTokenService(t)
+
+	victim, err := s.Login(ctx, userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := s.Login(ctx, userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Refresh(ctx, victim.RefreshToken); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Refresh(ctx, victim.RefreshToken); err == nil {
+		t.Fatal("reused refresh token accepted")
+	}
+
+	if _, err := s.Validate(ctx, other.AccessToken); err != nil {
+		t.Fatalf("other session access token: %v", err)
+	}
+	if _, err := s.Refresh(ctx, other.RefreshToken); err != nil {
+		t.Fatalf("other session refresh: %v", err)
+	}
+}
+
+func TestRefreshConcurrentUseSucceedsOnce(t *testing.T) {
+	s, ctx, userID := newTokenService(t)
+
+	pair, err := s.Login(ctx, userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const n = 8
+	var wg sync.WaitGroup
+	errs := make([]error, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = s.Refresh(ctx, pair.RefreshToken)
+		}(i)
+	}
+	wg.Wait()
+
+	succeeded := 0
+	for _, err := range errs {
+		if err == nil {
+			succeeded++
+		}
+	}
+	if succeeded > 1 {
+		t.Fatalf("%d concurrent refreshes succeeded, want at most 1", succeeded)
+	}
+}
+
+func TestRefreshRejectsAccessToken(t *testing.T) {
+	s, ctx, userID := newTokenService(t)
+
+	pair, err := s.Login(ctx, userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Refresh(ctx, pair.AccessToken); errorCode(err) != goWebErrors.ErrUnauthorized {
+		t.Fatalf("refresh with access token: err = %v, want ErrUnauthorized", err)
+	}
+	if _, err := s.Validate(ctx, pair.RefreshToken); errorCode(err) != goWebErrors.ErrUnauthorized {
+		t.Fatalf("validate refresh token: err = %v, want ErrUnauthorized", err)
+	}
+}
+
+func TestLogoutRevokesTokens(t *testing.T) {
+	s, ctx, userID := newTokenService(t)
+
+	current, err := s.Login(ctx, userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := s.Login(ctx, userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.Logout(ctx, current.Access); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Validate(ctx, current.AccessToken); err == nil {
+		t.Fatal("access token valid after logout")
+	}
+	if _, err := s.Refresh(ctx, current.RefreshToken); err == nil {
+		t.Fatal("refresh token valid after logout")
+	}
+	if _, err := s.Validate(ctx, other.AccessToken); err != nil {
+		t.Fatalf("other session after logout: %v", err)
+	}
+
+	if err := s.LogoutAll(ctx, userID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Validate(ctx, other.AccessToken); err == nil {
+		t.Fatal("access token valid after logout all")
+	}
+	if _, err := s.Refresh(ctx, other.RefreshToken); err == nil {
+		t.Fatal("refresh token valid after logout all")
+	}
+}
@@ -0,0 +1,323 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"go-web/ent"
+	"go-web/ent/user"
+	"go-web/pkg/cache"
+	"go-web/pkg/config"
+	goWebErrors "go-web/pkg/errors"
+	"go-web/pkg/totp"
+	"go-web/pkg/viewer"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// twoFactorChallengeKeyPrefix 等待输入验证码的登录
+	twoFactorChallengeKeyPrefix = "auth:2fa:challenge:"
+	// totpUsedKeyPrefix 已使用的时间步，防止验证码重放
+	totpUsedKeyPrefix = "auth:2fa:used:"
+
+	// recoveryCodeCount 每次生成的恢复码数量
+	recoveryCodeCount = 10
+	// recoveryCodeAttempts 作废恢复码时因并发修改重新读取用户的最大次数
+	recoveryCodeAttempts = 3
+)
+
+// Enrollment 两步验证注册信息，用户用验证器应用扫描 URI 后输入验证码确认
+type Enrollment struct {
+	Secret string
+	URI    string
+}
+
+// twoFactorChallenge 密码校验通过、等待验证码的登录
+type twoFactorChallenge struct {
+	UserID   uint64 `json:"user_id"`
+	Attempts int    `json:"attempts"`
+}
+
+// TwoFactorService TOTP 两步验证的注册、校验与恢复码管理
+type TwoFactorService struct {
+	client       *ent.Client
+	redis        *cache.RedisClient
+	guard        *LoginGuard
+	issuer       string
+	challengeTTL time.Duration
+	maxAttempts  int
+	logger       *zap.Logger
+}
+
+// NewTwoFactorService 创建两步验证服务
+func NewTwoFactorService(cfg *config.Config, client *ent.Client, redis *cache.RedisClient, guard *LoginGuard, logger *zap.Logger) *TwoFactorService {
+	return &TwoFactorService{
+		client:       client,
+		redis:        redis,
+		guard:        guard,
+		issuer:       cfg.Auth.TOTP.Issuer,
+		challengeTTL: cfg.Auth.TOTP.ChallengeTTL,
+		maxAttempts:  cfg.Auth.TOTP.MaxAttempts,
+		logger:       logger.With(zap.String("component", "two_factor_service")),
+	}
+}
+
+// Enroll 为用户生成待确认的 TOTP 密钥，已启用两步验证时返回 ErrInvalidState
+func (s *TwoFactorService) Enroll(ctx context.Context, userID uint64) (*Enrollment, error) {
+	ctx = viewer.NewSystemContext(ctx)
+	u, err := s.client.User.Get(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query user: %w", err)
+	}
+	if u.TotpEnabled {
+		return nil, goWebErrors.New(goWebErrors.ErrInvalidState, "invalid_state", "two-factor authentication is already enabled")
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.User.UpdateOne(u).SetTotpSecret(secret).Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to save totp secret: %w", err)
+	}
+
+	return &Enrollment{
+		Secret: secret,
+		URI:    totp.URI(s.issuer, u.Account, secret),
+	}, nil
+}
+
+// Confirm 使用验证码确认注册并启用两步验证，返回一次性展示的恢复码
+func (s *TwoFactorService) Confirm(ctx context.Context, userID uint64, code string) ([]string, error) {
+	ctx = viewer.NewSystemContext(ctx)
+	u, err := s.client.User.Get(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query user: %w", err)
+	}
+	if u.TotpEnabled || u.TotpSecret == "" {
+		return nil, goWebErrors.New(goWebErrors.ErrInvalidState, "invalid_state", "no pending two-factor enrollment")
+	}
+	if err := s.checkTOTP(ctx, u, code); err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.User.UpdateOne(u).
+		SetTotpEnabled(true).
+		SetRecoveryCodes(hashes).
+		Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to enable two-factor authentication: %w", err)
+	}
+
+	s.logger.Info("two-factor authentication enabled", zap.Uint64("user_id", userID))
+	return codes, nil
+}
+
+// Disable 使用验证码或恢复码关闭两步验证
+func (s *TwoFactorService) Disable(ctx context.Context, userID uint64, code string) error {
+	ctx = viewer.NewSystemContext(ctx)
+	u, err := s.client.User.Get(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to query user: %w", err)
+	}
+	if !u.TotpEnabled {
+		return goWebErrors.New(goWebErrors.ErrInvalidState, "invalid_state", "two-factor authentication is not enabled")
+	}
+	if err := s.verify(ctx, u, code); err != nil {
+		return err
+	}
+
+	if err := s.client.User.UpdateOne(u).
+		SetTotpEnabled(false).
+		ClearTotpSecret().
+		ClearRecoveryCodes().
+		Exec(ctx); err != nil {
+		return fmt.Errorf("failed to disable two-factor authentication: %w", err)
+	}
+
+	s.logger.Info("two-factor authentication disabled", zap.Uint64("user_id", userID))
+	return nil
+}
+
+// Challenge 密码校验通过后创建等待验证码的登录，返回挑战令牌及其过期时间
+func (s *TwoFactorService) Challenge(ctx context.Context, userID uint64) (string, time.Time, error) {
+	token, err := newSessionID()
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	if err := s.redis.Set(ctx, twoFactorChallengeKeyPrefix+token, twoFactorChallenge{UserID: userID}, s.challengeTTL); err != nil {
+		return "", time.Time{}, err
+	}
+	return token, time.Now().Add(s.challengeTTL), nil
+}
+
+// VerifyChallenge 校验挑战令牌对应登录的验证码或恢复码，成功后挑战令牌失效并返回用户 ID。
+// 错误次数达到上限后挑战令牌失效，需要重新输入密码。验证码错误同样计入登录失败保护。
+func (s *TwoFactorService) VerifyChallenge(ctx context.Context, token, code, ip string) (uint64, error) {
+	key := twoFactorChallengeKeyPrefix + token
+	data, err := s.redis.Get(ctx, key)
+	if err != nil {
+		return 0, err
+	}
+	if data == nil {
+		return 0, goWebErrors.New(goWebErrors.ErrUnauthorized, "unauthorized", "two-factor challenge expired")
+	}
+	challenge := &twoFactorChallenge{}
+	if err := json.Unmarshal(data, challenge); err != nil {
+		return 0, fmt.Errorf("failed to unmarshal two-factor challenge: %w", err)
+	}
+
+	sysCtx := viewer.NewSystemContext(ctx)
+	u, err := s.client.User.Get(sysCtx, challenge.UserID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query user: %w", err)
+	}
+
+	if err := s.guard.Check(ctx, u.Account, ip); err != nil {
+		return 0, err
+	}
+
+	if err := s.verify(sysCtx, u, code); err != nil {
+		if guardErr := s.guard.Failure(ctx, u.Account, ip); guardErr != nil {
+			return 0, guardErr
+		}
+		challenge.Attempts++
+		if challenge.Attempts >= s.maxAttempts {
+			s.logger.Warn("two-factor challenge locked after too many attempts", zap.Uint64("user_id", u.ID))
+			if delErr := s.redis.Delete(ctx, key); delErr != nil {
+				return 0, delErr
+			}
+			return 0, goWebErrors.New(goWebErrors.ErrUnauthorized, "unauthorized", "too many invalid two-factor codes")
+		}
+		if setErr := s.redis.Set(ctx, key, challenge, s.challengeTTL); setErr != nil {
+			return 0, setErr
+		}
+		return 0, err
+	}
+
+	// 挑战令牌只能成功使用一次
+	data, err = s.redis.GetDel(ctx, key)
+	if err != nil {
+		return 0, err
+	}
+	if data == nil {
+		return 0, goWebErrors.New(goWebErrors.ErrUnauthorized, "unauthorized", "two-factor challenge expired")
+	}
+	if err := s.guard.Success(ctx, u.Account); err != nil {
+		return 0, err
+	}
+	return u.ID, nil
+}
+
+// verify 校验 TOTP 验证码或恢复码，恢复码使用后作废
+func (s *TwoFactorService) verify(ctx context.Context, u *ent.User, code string) error {
+	if err := s.checkTOTP(ctx, u, code); err == nil {
+		return nil
+	}
+
+	ok, err := s.consumeRecoveryCode(ctx, u.ID, code)
+	if err != nil {
+		return err
+	}
+	if ok {
+		return nil
+	}
+	return goWebErrors.New(goWebErrors.ErrInvalidTwoFactorCode, "invalid_two_factor_code")
+}
+
+// consumeRecoveryCode 校验并作废恢复码。以读取时的版本号为更新条件，
+// 同一恢复码被并发使用时只有一个成功，用户在读取后被修改时重新读取
+func (s *TwoFactorService) consumeRecoveryCode(ctx context.Context, userID uint64, code string) (bool, error) {
+	hash := hashRecoveryCode(code)
+	for attempt := 0; attempt < recoveryCodeAttempts; attempt++ {
+		u, err := s.client.User.Get(ctx, userID)
+		if err != nil {
+			return false, fmt.Errorf("failed to query user: %w", err)
+		}
+
+		matched := -1
+		for i, h := range u.RecoveryCodes {
+			if subtle.ConstantTimeCompare([]byte(h), []byte(hash)) == 1 {
+				matched = i
+			}
+		}
+		if matched < 0 {
+			return false, nil
+		}
+
+		remaining := append(append([]string{}, u.RecoveryCodes[:matched]...), u.RecoveryCodes[matched+1:]...)
+		n, err := s.client.User.Update().
+			Where(user.ID(userID), user.Version(u.Version)).
+			SetRecoveryCodes(remaining).
+			Save(ctx)
+		if err != nil {
+			return false, fmt.Errorf("failed to consume recovery code: %w", err)
+		}
+		if n == 1 {
+			s.logger.Info("recovery code used",
+				zap.Uint64("user_id", userID),
+				zap.Int("remaining", len(remaining)),
+			)
+			return true, nil
+		}
+	}
+	return false, fmt.Errorf("failed to consume recovery code: user %d was modified concurrently", userID)
+}
+
+// checkTOTP 校验 TOTP 验证码，同一时间步的验证码只能使用一次
+func (s *TwoFactorService) checkTOTP(ctx context.Context, u *ent.User, code string) error {
+	if u.TotpSecret == "" {
+		return goWebErrors.New(goWebErrors.ErrInvalidTwoFactorCode, "invalid_two_factor_code")
+	}
+	step, ok := totp.Validate(u.TotpSecret, code, time.Now())
+	if !ok {
+		return goWebErrors.New(goWebErrors.ErrInvalidTwoFactorCode, "invalid_two_factor_code")
+	}
+
+	key := totpUsedKeyPrefix + strconv.FormatUint(u.ID, 10) + ":" + strconv.FormatUint(step, 10)
+	first, err := s.redis.SetNX(ctx, key, "1", time.Duration(2*totp.Skew+1)*totp.Period)
+	if err != nil {
+		return err
+	}
+	if !first {
+		return goWebErrors.New(goWebErrors.ErrInvalidTwoFactorCode, "invalid_two_factor_code", "code already used")
+	}
+	return nil
+}
+
+// newRecoveryCodes 生成恢复码明文及其哈希
+func newRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, recoveryCodeCount)
+	hashes := make([]string, 0, recoveryCodeCount)
+	for i := 0; i < recoveryCodeCount; i++ {
+		b := make([]byte, 10)
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, fmt.Errorf("failed to generate recovery code: %w", err)
+		}
+		raw := base32.StdEncoding.EncodeToString(b)
+		code := raw[0:4] + "-" + raw[4:8] + "-" + raw[8:12] + "-" + raw[12:16]
+		codes = append(codes, code)
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+	return codes, hashes, nil
+}
+
+// hashRecoveryCode 计算恢复码哈希，忽略分隔符与大小写。恢复码为 80 位随机值，无需慢哈希
+func hashRecoveryCode(code string) string {
+	normalized := strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(code))
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
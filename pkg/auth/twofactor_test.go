@@ -0,0 +1,112 @@
+package auth
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"go-web/ent"
+	"go-web/pkg/config"
+	goWebErrors "go-web/pkg/errors"
+	"go-web/pkg/testutil"
+	"go-web/pkg/totp"
+
+	"go.uber.org/zap"
+)
+
+// newTwoFactorFixture 返回两步验证服务与尚未注册的用户
+func newTwoFactorFixture(t *testing.T) (*TwoFactorService, context.Context, *ent.User) {
+	t.Helper()
+	client := testutil.NewClient(t)
+	_, ctx := testutil.NewTenant(t, client, "acme")
+	rdb, _ := testutil.NewRedis(t)
+
+	cfg := &config.Config{}
+	cfg.Auth.TOTP.Issuer = "go-web"
+	cfg.Auth.TOTP.ChallengeTTL = 5 * time.Minute
+	cfg.Auth.TOTP.MaxAttempts = 5
+	guard, _, _ := newLoginGuard(t)
+
+	u, err := client.User.Create().
+		SetName("alice").
+		SetSex(false).
+		SetAge(30).
+		SetAccount("alice").
+		SetPassword("correct horse").
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewTwoFactorService(cfg, client, rdb, guard, zap.NewNop()), ctx, u
+}
+
+// currentCode 返回密钥当前时间步的验证码
+func currentCode(t *testing.T, secret string) string {
+	t.Helper()
+	code, err := totp.Code(secret, totp.Step(time.Now()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return code
+}
+
+func TestTwoFactorRejectsReusedCode(t *testing.T) {
+	s, ctx, u := newTwoFactorFixture(t)
+
+	enrollment, err := s.Enroll(ctx, u.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	code := currentCode(t, enrollment.Secret)
+	if _, err := s.Confirm(ctx, u.ID, code); err != nil {
+		t.Fatal(err)
+	}
+
+	// 同一时间步的验证码只能使用一次
+	if err := s.Disable(ctx, u.ID, code); errorCode(err) != goWebErrors.ErrInvalidTwoFactorCode {
+		t.Fatalf("reused code: err = %v, want ErrInvalidTwoFactorCode", err)
+	}
+	if !s.client.User.GetX(ctx, u.ID).TotpEnabled {
+		t.Fatal("two-factor authentication disabled with a reused code")
+	}
+}
+
+func TestRecoveryCodesAreHashedAndSingleUse(t *testing.T) {
+	s, ctx, u := newTwoFactorFixture(t)
+
+	enrollment, err := s.Enroll(ctx, u.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	codes, err := s.Confirm(ctx, u.ID, currentCode(t, enrollment.Secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(codes) != recoveryCodeCount {
+		t.Fatalf("got %d recovery codes, want %d", len(codes), recoveryCodeCount)
+	}
+
+	// 数据库只保存恢复码的哈希
+	u = s.client.User.GetX(ctx, u.ID)
+	for _, code := range codes {
+		if slices.Contains(u.RecoveryCodes, code) || !slices.Contains(u.RecoveryCodes, hashRecoveryCode(code)) {
+			t.Fatalf("recovery code %s is not stored as its hash", code)
+		}
+	}
+
+	if err := s.verify(ctx, u, codes[0]); err != nil {
+		t.Fatalf("first use of a recovery code: %v", err)
+	}
+	if err := s.verify(ctx, u, codes[0]); errorCode(err) != goWebErrors.ErrInvalidTwoFactorCode {
+		t.Fatalf("second use of a recovery code: err = %v, want ErrInvalidTwoFactorCode", err)
+	}
+	// 输入时忽略分隔符与大小写
+	if err := s.verify(ctx, u, strings.ToLower(strings.ReplaceAll(codes[1], "-", ""))); err != nil {
+		t.Fatalf("recovery code without separators: %v", err)
+	}
+	if got := len(s.client.User.GetX(ctx, u.ID).RecoveryCodes); got != recoveryCodeCount-2 {
+		t.Fatalf("remaining recovery codes = %d, want %d", got, recoveryCodeCount-2)
+	}
+}
@@ -123,6 +123,18 @@ func (c *RedisClient) Set(ctx context.Context, key string, value interface{}, tt
 	return nil
 }
 
+// GetDel 获取并删除缓存，用于只能使用一次的值
+func (c *RedisClient) GetDel(ctx context.Context, key string) ([]byte, error) {
+	data, err := c.client.GetDel(ctx, key).Bytes()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to getdel cache: %w", err)
+	}
+	return data, nil
+}
+
 // Delete 删除缓存
 func (c *RedisClient) Delete(ctx context.Context, key string) error {
 	if err := c.client.Del(ctx, key).Err(); err != nil {
@@ -140,6 +152,66 @@ func (c *RedisClient) Exists(ctx context.Context, key string) (bool, error) {
 	return exists > 0, nil
 }
 
+// SetNX 仅在键不存在时设置缓存，返回是否设置成功
+func (c *RedisClient) SetNX(ctx context.Context, key string, value interface{}, ttl time.Duration) (bool, error) {
+	ok, err := c.client.SetNX(ctx, key, value, ttl).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to setnx cache: %w", err)
+	}
+	return ok, nil
+}
+
+// Incr 将计数器加一并返回新值
+func (c *RedisClient) Incr(ctx context.Context, key string) (int64, error) {
+	n, err := c.client.Incr(ctx, key).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to incr cache: %w", err)
+	}
+	return n, nil
+}
+
+// TTL 获取缓存剩余有效期，键不存在或没有过期时间时返回值小于等于 0
+func (c *RedisClient) TTL(ctx context.Context, key string) (time.Duration, error) {
+	ttl, err := c.client.TTL(ctx, key).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get cache ttl: %w", err)
+	}
+	return ttl, nil
+}
+
+// Expire 设置缓存过期时间
+func (c *RedisClient) Expire(ctx context.Context, key string, ttl time.Duration) error {
+	if err := c.client.Expire(ctx, key, ttl).Err(); err != nil {
+		return fmt.Errorf("failed to set cache expiration: %w", err)
+	}
+	return nil
+}
+
+// SAdd 向集合添加成员
+func (c *RedisClient) SAdd(ctx context.Context, key string, members ...interface{}) error {
+	if err := c.client.SAdd(ctx, key, members...).Err(); err != nil {
+		return fmt.Errorf("failed to add set members: %w", err)
+	}
+	return nil
+}
+
+// SRem 从集合移除成员
+func (c *RedisClient) SRem(ctx context.Context, key string, members ...interface{}) error {
+	if err := c.client.SRem(ctx, key, members...).Err(); err != nil {
+		return fmt.Errorf("failed to remove set members: %w", err)
+	}
+	return nil
+}
+
+// SMembers 获取集合全部成员
+func (c *RedisClient) SMembers(ctx context.Context, key string) ([]string, error) {
+	members, err := c.client.SMembers(ctx, key).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get set members: %w", err)
+	}
+	return members, nil
+}
+
 // Close 关闭 Redis 连接
 func (c *RedisClient) Close() error {
 	if err := c.client.Close(); err != nil {
@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/wire"
@@ -76,6 +77,220 @@ type Config struct {
 		// 写入超时时间
 		WriteTimeout time.Duration `mapstructure:"write_timeout"`
 	} `mapstructure:"redis"`
+
+	// 密码哈希配置
+	Password struct {
+		// 哈希算法 argon2id 或 bcrypt
+		Algorithm string `mapstructure:"algorithm"`
+		// Argon2id 参数
+		Argon2 struct {
+			// 内存开销 (KiB)
+			Memory uint32 `mapstructure:"memory"`
+			// 迭代次数
+			Iterations uint32 `mapstructure:"iterations"`
+			// 并行度
+			Parallelism uint8 `mapstructure:"parallelism"`
+			// 盐长度
+			SaltLength uint32 `mapstructure:"salt_length"`
+			// 哈希长度
+			KeyLength uint32 `mapstructure:"key_length"`
+		} `mapstructure:"argon2"`
+		// Bcrypt 参数
+		Bcrypt struct {
+			// 计算成本
+			Cost int `mapstructure:"cost"`
+		} `mapstructure:"bcrypt"`
+	} `mapstructure:"password"`
+
+	// 认证配置
+	Auth struct {
+		// 认证方式 jwt 或 session
+		Mode string `mapstructure:"mode"`
+		// JWT 配置
+		JWT struct {
+			// 签名密钥
+			Secret string `mapstructure:"secret"`
+			// 签发者
+			Issuer string `mapstructure:"issuer"`
+			// 访问令牌有效期
+			AccessTTL time.Duration `mapstructure:"access_ttl"`
+			// 刷新令牌有效期
+			RefreshTTL time.Duration `mapstructure:"refresh_ttl"`
+		} `mapstructure:"jwt"`
+		// 会话配置
+		Session struct {
+			// 会话有效期，每次访问后顺延
+			TTL time.Duration `mapstructure:"ttl"`
+			// cookie 名称
+			CookieName string `mapstructure:"cookie_name"`
+			// cookie 域名
+			Domain string `mapstructure:"domain"`
+			// cookie 路径
+			Path string `mapstructure:"path"`
+			// 是否只在 HTTPS 连接中发送 cookie
+			Secure bool `mapstructure:"secure"`
+			// 是否禁止脚本读取 cookie
+			HTTPOnly bool `mapstructure:"http_only"`
+			// cookie 的 SameSite 属性 strict、lax 或 none
+			SameSite string `mapstructure:"same_site"`
+		} `mapstructure:"session"`
+		// 登录失败保护配置
+		Lockout struct {
+			// 统计失败次数的时间窗口
+			Window time.Duration `mapstructure:"window"`
+			// 单个账号在窗口内允许的失败次数，达到后锁定账号
+			MaxAccountFailures int `mapstructure:"max_account_failures"`
+			// 单个 IP 在窗口内允许的失败次数，达到后锁定 IP
+			MaxIPFailures int `mapstructure:"max_ip_failures"`
+			// 锁定时长
+			Duration time.Duration `mapstructure:"duration"`
+			// 首次失败后的等待时间，之后每次失败翻倍
+			BaseDelay time.Duration `mapstructure:"base_delay"`
+			// 最长等待时间
+			MaxDelay time.Duration `mapstructure:"max_delay"`
+		} `mapstructure:"lockout"`
+		// 密码重置配置
+		PasswordReset struct {
+			// 重置令牌有效期
+			TokenTTL time.Duration `mapstructure:"token_ttl"`
+			// 重置页面地址，令牌以 token 查询参数附加
+			URL string `mapstructure:"url"`
+		} `mapstructure:"password_reset"`
+		// 邮箱验证配置
+		EmailVerification struct {
+			// 验证令牌有效期
+			TokenTTL time.Duration `mapstructure:"token_ttl"`
+			// 验证页面地址，令牌以 token 查询参数附加
+			URL string `mapstructure:"url"`
+		} `mapstructure:"email_verification"`
+		// 两步验证配置
+		TOTP struct {
+			// 验证器应用中显示的签发者
+			Issuer string `mapstructure:"issuer"`
+			// 密码校验通过后输入验证码的有效期
+			ChallengeTTL time.Duration `mapstructure:"challenge_ttl"`
+			// 每次登录允许的验证码错误次数
+			MaxAttempts int `mapstructure:"max_attempts"`
+		} `mapstructure:"totp"`
+		// OIDC 登录配置
+		OIDC struct {
+			// 授权请求状态有效期
+			StateTTL time.Duration `mapstructure:"state_ttl"`
+			// 身份提供方，键为提供方名称
+			Providers map[string]OIDCProvider `mapstructure:"providers"`
+		} `mapstructure:"oidc"`
+	} `mapstructure:"auth"`
+
+	// 通知配置
+	Notify struct {
+		// 通知方式 log 或 file
+		Driver string `mapstructure:"driver"`
+		// file 方式写入的文件路径
+		File string `mapstructure:"file"`
+	} `mapstructure:"notify"`
+
+	// 多租户配置
+	Tenant struct {
+		// 指定租户标识的请求头
+		Header string `mapstructure:"header"`
+		// 子域名解析的基础域名，例如 example.com 时 acme.example.com 解析为租户 acme，为空时不按子域名解析
+		BaseDomain string `mapstructure:"base_domain"`
+		// 请求未指定租户且未登录时使用的租户标识
+		Default string `mapstructure:"default"`
+		// 租户标识到 ID 的本地缓存时间
+		CacheTTL time.Duration `mapstructure:"cache_ttl"`
+	} `mapstructure:"tenant"`
+
+	// 个人数据导出配置
+	Export struct {
+		// 归档保存目录
+		Dir string `mapstructure:"dir"`
+		// 下载链接的地址前缀，例如 https://api.example.com
+		BaseURL string `mapstructure:"base_url"`
+		// 下载链接的签名密钥，至少 32 个字符
+		SigningKey string `mapstructure:"signing_key"`
+		// 下载链接有效期
+		LinkTTL time.Duration `mapstructure:"link_ttl"`
+		// 归档保留时间，过期后删除
+		Retention time.Duration `mapstructure:"retention"`
+	} `mapstructure:"export"`
+
+	// 账号注销配置
+	Erasure struct {
+		// 申请注销后的冷静期，期间可以撤销
+		GracePeriod time.Duration `mapstructure:"grace_period"`
+		// 检查到期注销的间隔
+		Interval time.Duration `mapstructure:"interval"`
+		// 生成审计记录中化名 ID 的密钥，至少 32 个字符
+		PseudonymKey string `mapstructure:"pseudonym_key"`
+	} `mapstructure:"erasure"`
+
+	// 文件存储配置
+	Storage struct {
+		// 存储后端: local / s3
+		Driver string `mapstructure:"driver"`
+		// 本地目录存储
+		Local struct {
+			// 文件保存目录
+			Dir string `mapstructure:"dir"`
+		} `mapstructure:"local"`
+		// S3 兼容的对象存储
+		S3 struct {
+			// 服务地址，例如 https://s3.us-east-1.amazonaws.com 或 http://localhost:9000
+			Endpoint string `mapstructure:"endpoint"`
+			// 区域
+			Region string `mapstructure:"region"`
+			// 存储桶
+			Bucket string `mapstructure:"bucket"`
+			// 访问密钥 ID
+			AccessKeyID string `mapstructure:"access_key_id"`
+			// 访问密钥
+			SecretAccessKey string `mapstructure:"secret_access_key"`
+			// 使用路径形式的地址（endpoint/bucket/key），MinIO 等自建服务通常需要开启
+			UsePathStyle bool `mapstructure:"use_path_style"`
+			// 单个请求的超时时间，包括读取对象内容
+			Timeout time.Duration `mapstructure:"timeout"`
+		} `mapstructure:"s3"`
+	} `mapstructure:"storage"`
+
+	// 文件上传配置
+	Upload struct {
+		// 单个请求的最大上传大小（字节）
+		MaxSize int64 `mapstructure:"max_size"`
+		// 解析上传请求时保存在内存中的最大字节数，超出部分写入临时文件
+		MaxMemory int64 `mapstructure:"max_memory"`
+		// 文件链接的地址前缀，例如 https://api.example.com
+		BaseURL string `mapstructure:"base_url"`
+		// 文件链接的签名密钥，至少 32 个字符
+		SigningKey string `mapstructure:"signing_key"`
+		// 文件链接有效期
+		LinkTTL time.Duration `mapstructure:"link_ttl"`
+		// 头像
+		Avatar struct {
+			// 最大大小（字节）
+			MaxSize int64 `mapstructure:"max_size"`
+			// 允许的文件类型，按文件内容识别，不信任客户端提供的类型
+			ContentTypes []string `mapstructure:"content_types"`
+		} `mapstructure:"avatar"`
+	} `mapstructure:"upload"`
+}
+
+// OIDCProvider OpenID Connect 身份提供方配置
+type OIDCProvider struct {
+	// 签发者地址，用于服务发现
+	Issuer string `mapstructure:"issuer"`
+	// 客户端 ID
+	ClientID string `mapstructure:"client_id"`
+	// 客户端密钥，公共客户端可以为空
+	ClientSecret string `mapstructure:"client_secret"`
+	// 回调地址
+	RedirectURL string `mapstructure:"redirect_url"`
+	// 额外申请的 scope，openid 总是包含在内
+	Scopes []string `mapstructure:"scopes"`
+	// 未关联的外部身份是否自动创建用户
+	AutoRegister bool `mapstructure:"auto_register"`
+	// 登录成功后跳转的地址，为空时返回 JSON
+	PostLoginRedirect string `mapstructure:"post_login_redirect"`
 }
 
 var (
@@ -91,6 +306,8 @@ func Load(logger *zap.Logger) (*Config, error) {
 	viper.AddConfigPath(".")
 	viper.AddConfigPath("./config")
 	viper.AddConfigPath("/etc/go-web/")
+	// 环境变量按配置路径命名，如 AUTH_JWT_SECRET 对应 auth.jwt.secret
+	viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
 	viper.AutomaticEnv()
 
 	// Set default values
@@ -155,6 +372,74 @@ func setDefaults() {
 	viper.SetDefault("redis.dial_timeout", 5*time.Second)
 	viper.SetDefault("redis.read_timeout", 3*time.Second)
 	viper.SetDefault("redis.write_timeout", 3*time.Second)
+
+	// Password defaults
+	viper.SetDefault("password.algorithm", "argon2id")
+	viper.SetDefault("password.argon2.memory", 64*1024)
+	viper.SetDefault("password.argon2.iterations", 3)
+	viper.SetDefault("password.argon2.parallelism", 2)
+	viper.SetDefault("password.argon2.salt_length", 16)
+	viper.SetDefault("password.argon2.key_length", 32)
+	viper.SetDefault("password.bcrypt.cost", 12)
+
+	// Auth defaults
+	viper.SetDefault("auth.mode", "jwt")
+	viper.SetDefault("auth.jwt.issuer", "go-web")
+	viper.SetDefault("auth.jwt.access_ttl", 15*time.Minute)
+	viper.SetDefault("auth.jwt.refresh_ttl", 7*24*time.Hour)
+	viper.SetDefault("auth.session.ttl", 24*time.Hour)
+	viper.SetDefault("auth.session.cookie_name", "session_id")
+	viper.SetDefault("auth.session.path", "/")
+	viper.SetDefault("auth.session.secure", true)
+	viper.SetDefault("auth.session.http_only", true)
+	viper.SetDefault("auth.session.same_site", "lax")
+	viper.SetDefault("auth.lockout.window", 15*time.Minute)
+	viper.SetDefault("auth.lockout.max_account_failures", 5)
+	viper.SetDefault("auth.lockout.max_ip_failures", 20)
+	viper.SetDefault("auth.lockout.duration", 15*time.Minute)
+	viper.SetDefault("auth.lockout.base_delay", time.Second)
+	viper.SetDefault("auth.lockout.max_delay", 30*time.Second)
+	viper.SetDefault("auth.password_reset.token_ttl", 30*time.Minute)
+	viper.SetDefault("auth.password_reset.url", "http://localhost:8080/reset-password")
+	viper.SetDefault("auth.email_verification.token_ttl", 24*time.Hour)
+	viper.SetDefault("auth.email_verification.url", "http://localhost:8080/verify-email")
+	viper.SetDefault("auth.totp.issuer", "go-web")
+	viper.SetDefault("auth.totp.challenge_ttl", 5*time.Minute)
+	viper.SetDefault("auth.totp.max_attempts", 5)
+	viper.SetDefault("auth.oidc.state_ttl", 10*time.Minute)
+
+	// Notify defaults
+	viper.SetDefault("notify.driver", "log")
+	viper.SetDefault("notify.file", "logs/notifications.log")
+
+	// Tenant defaults
+	viper.SetDefault("tenant.header", "X-Tenant-ID")
+	viper.SetDefault("tenant.default", "default")
+	viper.SetDefault("tenant.cache_ttl", time.Minute)
+
+	// Export defaults
+	viper.SetDefault("export.dir", "data/exports")
+	viper.SetDefault("export.base_url", "http://localhost:8080")
+	viper.SetDefault("export.link_ttl", 24*time.Hour)
+	viper.SetDefault("export.retention", 7*24*time.Hour)
+
+	// Erasure defaults
+	viper.SetDefault("erasure.grace_period", 30*24*time.Hour)
+	viper.SetDefault("erasure.interval", 10*time.Minute)
+
+	// Storage defaults
+	viper.SetDefault("storage.driver", "local")
+	viper.SetDefault("storage.local.dir", "data/files")
+	viper.SetDefault("storage.s3.region", "us-east-1")
+	viper.SetDefault("storage.s3.timeout", "60s")
+
+	// Upload defaults
+	viper.SetDefault("upload.max_size", 10<<20)
+	viper.SetDefault("upload.max_memory", 1<<20)
+	viper.SetDefault("upload.base_url", "http://localhost:8080")
+	viper.SetDefault("upload.link_ttl", time.Hour)
+	viper.SetDefault("upload.avatar.max_size", 2<<20)
+	viper.SetDefault("upload.avatar.content_types", []string{"image/png", "image/jpeg", "image/gif", "image/webp"})
 }
 
 // validateConfig validates the configuration
@@ -183,6 +468,74 @@ func validateConfig(cfg *Config) error {
 		return fmt.Errorf("redis.addr is required")
 	}
 
+	if cfg.Auth.Mode != "jwt" && cfg.Auth.Mode != "session" {
+		return fmt.Errorf("auth.mode must be jwt or session")
+	}
+
+	if cfg.Auth.Mode == "jwt" {
+		if err := validateSecret("auth.jwt.secret", cfg.Auth.JWT.Secret); err != nil {
+			return err
+		}
+	}
+
+	if cfg.Notify.Driver != "log" && cfg.Notify.Driver != "file" {
+		return fmt.Errorf("notify.driver must be log or file")
+	}
+
+	if err := validateSecret("export.signing_key", cfg.Export.SigningKey); err != nil {
+		return err
+	}
+
+	if err := validateSecret("erasure.pseudonym_key", cfg.Erasure.PseudonymKey); err != nil {
+		return err
+	}
+
+	if cfg.Erasure.Interval <= 0 {
+		return fmt.Errorf("erasure.interval must be positive")
+	}
+
+	switch cfg.Storage.Driver {
+	case "local":
+		if cfg.Storage.Local.Dir == "" {
+			return fmt.Errorf("storage.local.dir is required")
+		}
+	case "s3":
+		s3 := cfg.Storage.S3
+		if s3.Endpoint == "" || s3.Region == "" || s3.Bucket == "" || s3.AccessKeyID == "" || s3.SecretAccessKey == "" {
+			return fmt.Errorf("storage.s3 endpoint, region, bucket, access_key_id and secret_access_key are required")
+		}
+	default:
+		return fmt.Errorf("storage.driver must be local or s3")
+	}
+
+	if err := validateSecret("upload.signing_key", cfg.Upload.SigningKey); err != nil {
+		return err
+	}
+
+	if cfg.Upload.Avatar.MaxSize <= 0 || cfg.Upload.Avatar.MaxSize > cfg.Upload.MaxSize {
+		return fmt.Errorf("upload.avatar.max_size must be positive and not exceed upload.max_size")
+	}
+
+	for name, p := range cfg.Auth.OIDC.Providers {
+		if p.Issuer == "" || p.ClientID == "" || p.RedirectURL == "" {
+			return fmt.Errorf("auth.oidc.providers.%s requires issuer, client_id and redirect_url", name)
+		}
+	}
+
+	return nil
+}
+
+// placeholderSecret 示例配置中占位密钥的标记
+const placeholderSecret = "change-me"
+
+// validateSecret 校验签名密钥，拒绝过短或仍为占位值的密钥
+func validateSecret(name, value string) error {
+	if len(value) < 32 {
+		return fmt.Errorf("%s must be at least 32 characters", name)
+	}
+	if strings.Contains(strings.ToLower(value), placeholderSecret) {
+		return fmt.Errorf("%s must be replaced with a random value", name)
+	}
 	return nil
 }
 
@@ -0,0 +1,104 @@
+package config
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+var secretEnvs = map[string]string{
+	"AUTH_JWT_SECRET":       "auth.jwt.secret",
+	"EXPORT_SIGNING_KEY":    "export.signing_key",
+	"ERASURE_PSEUDONYM_KEY": "erasure.pseudonym_key",
+	"UPLOAD_SIGNING_KEY":    "upload.signing_key",
+}
+
+// loadRepoConfig 使用仓库中的 config/config.yaml 加载配置
+func loadRepoConfig(t *testing.T, env map[string]string) (*Config, error) {
+	t.Helper()
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir("../.."); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = os.Chdir(wd)
+		viper.Reset()
+	})
+	viper.Reset()
+
+	for name := range secretEnvs {
+		t.Setenv(name, env[name])
+	}
+	return Load(zap.NewNop())
+}
+
+func randomSecrets() map[string]string {
+	env := make(map[string]string, len(secretEnvs))
+	for name := range secretEnvs {
+		env[name] = strings.Repeat(strings.ToLower(name[:1]), 8) + "Qx7!pL2#vR9$kT4%wZ1^mN6&"
+	}
+	return env
+}
+
+func TestLoadRejectsMissingSecrets(t *testing.T) {
+	_, err := loadRepoConfig(t, nil)
+	if err == nil || !strings.Contains(err.Error(), "auth.jwt.secret") {
+		t.Fatalf("Load error = %v, want missing auth.jwt.secret", err)
+	}
+}
+
+func TestLoadReadsSecretsFromEnv(t *testing.T) {
+	env := randomSecrets()
+	cfg, err := loadRepoConfig(t, env)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := map[string]string{
+		"AUTH_JWT_SECRET":       cfg.Auth.JWT.Secret,
+		"EXPORT_SIGNING_KEY":    cfg.Export.SigningKey,
+		"ERASURE_PSEUDONYM_KEY": cfg.Erasure.PseudonymKey,
+		"UPLOAD_SIGNING_KEY":    cfg.Upload.SigningKey,
+	}
+	for name, value := range got {
+		if value != env[name] {
+			t.Fatalf("%s = %q, want %q", name, value, env[name])
+		}
+	}
+}
+
+func TestLoadRejectsPlaceholderSecrets(t *testing.T) {
+	for name, key := range secretEnvs {
+		t.Run(key, func(t *testing.T) {
+			env := randomSecrets()
+			env[name] = "change-me-to-a-random-secret-of-32-chars"
+			_, err := loadRepoConfig(t, env)
+			if err == nil || !strings.Contains(err.Error(), key) {
+				t.Fatalf("Load error = %v, want %s rejected", err, key)
+			}
+		})
+	}
+}
+
+func TestValidateSecret(t *testing.T) {
+	tests := []struct {
+		value string
+		ok    bool
+	}{
+		{"", false},
+		{"too-short", false},
+		{"change-me-to-a-random-signing-key-32", false},
+		{"CHANGE-ME-TO-A-RANDOM-SIGNING-KEY-32", false},
+		{"k3J9vQ2xT7mR4wZ8pL1nB6cF5hD0sG3y", true},
+	}
+	for _, tt := range tests {
+		if err := validateSecret("export.signing_key", tt.value); (err == nil) != tt.ok {
+			t.Fatalf("validateSecret(%q) = %v, want ok=%v", tt.value, err, tt.ok)
+		}
+	}
+}
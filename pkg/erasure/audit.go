@@ -0,0 +1,98 @@
+package erasure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"go-web/ent"
+	"go-web/ent/auditlog"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// Erased 审计记录中被清除的字段值的占位符
+const Erased = "[ERASED]"
+
+// keptFields 审计记录中保留原值的字段，不包含个人信息
+var keptFields = map[string]bool{
+	"tenant_id":  true,
+	"version":    true,
+	"created_at": true,
+	"updated_at": true,
+	"deleted_at": true,
+}
+
+// scrubAuditLogs 保留审计记录，将其中的用户 ID 替换为化名，并清除被注销实体的字段值。
+// 审计记录不可修改，这里直接修改底层的列，绕过 ent 对不可变字段的限制。
+func scrubAuditLogs(ctx context.Context, client *ent.Client, s Subject, erased map[string][]uint64) (int, error) {
+	n, err := client.AuditLog.Update().
+		Where(auditlog.ActorID(s.UserID)).
+		Modify(func(u *sql.UpdateBuilder) {
+			u.Set(auditlog.FieldActorID, s.Pseudonym)
+		}).
+		Save(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to pseudonymize audit log actors: %w", err)
+	}
+
+	for typ, ids := range erased {
+		if len(ids) == 0 {
+			continue
+		}
+		logs, err := client.AuditLog.Query().
+			Where(auditlog.EntityType(typ), auditlog.EntityIDIn(ids...)).
+			All(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("failed to query audit logs: %w", err)
+		}
+		for _, l := range logs {
+			entityID := l.EntityID
+			if typ == ent.TypeUser && entityID == s.UserID {
+				entityID = s.Pseudonym
+			}
+			before, err := scrub(l.Before)
+			if err != nil {
+				return 0, err
+			}
+			after, err := scrub(l.After)
+			if err != nil {
+				return 0, err
+			}
+			if err := client.AuditLog.UpdateOneID(l.ID).
+				Modify(func(u *sql.UpdateBuilder) {
+					u.Set(auditlog.FieldEntityID, entityID).
+						Set(auditlog.FieldBefore, before).
+						Set(auditlog.FieldAfter, after)
+				}).
+				Exec(ctx); err != nil {
+				return 0, fmt.Errorf("failed to scrub audit log: %w", err)
+			}
+			// 操作者为该用户的记录已在上面计数
+			if l.ActorID == nil || *l.ActorID != s.Pseudonym {
+				n++
+			}
+		}
+	}
+	return n, nil
+}
+
+// scrub 清除字段值，只保留 keptFields 中的字段，返回可直接写入 JSON 列的值
+func scrub(fields map[string]any) (any, error) {
+	if fields == nil {
+		return nil, nil
+	}
+	out := make(map[string]any, len(fields))
+	for k, v := range fields {
+		if keptFields[k] {
+			out[k] = v
+		} else {
+			out[k] = Erased
+		}
+	}
+	b, err := json.Marshal(out)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode audit log: %w", err)
+	}
+	return string(b), nil
+}
@@ -0,0 +1,110 @@
+package erasure
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"go-web/ent"
+	"go-web/ent/actiontoken"
+	"go-web/ent/apikey"
+	"go-web/ent/dataexport"
+	"go-web/ent/file"
+	"go-web/ent/identity"
+)
+
+// erasedName 匿名化后的用户姓名
+const erasedName = "Deleted user"
+
+// 内置实体的注销规则：用户记录保留并匿名化，凭证、外部身份、一次性令牌、数据导出与上传的文件直接删除。
+func init() {
+	Register("user", ent.TypeUser, eraseUser)
+	Register("api_keys", ent.TypeAPIKey, func(ctx context.Context, client *ent.Client, s Subject) ([]uint64, error) {
+		ids, err := client.APIKey.Query().Where(apikey.OwnerID(s.UserID)).IDs(ctx)
+		if err != nil || len(ids) == 0 {
+			return ids, err
+		}
+		_, err = client.APIKey.Delete().Where(apikey.IDIn(ids...)).Exec(ctx)
+		return ids, err
+	})
+	Register("identities", ent.TypeIdentity, func(ctx context.Context, client *ent.Client, s Subject) ([]uint64, error) {
+		ids, err := client.Identity.Query().Where(identity.UserID(s.UserID)).IDs(ctx)
+		if err != nil || len(ids) == 0 {
+			return ids, err
+		}
+		_, err = client.Identity.Delete().Where(identity.IDIn(ids...)).Exec(ctx)
+		return ids, err
+	})
+	Register("action_tokens", ent.TypeActionToken, func(ctx context.Context, client *ent.Client, s Subject) ([]uint64, error) {
+		ids, err := client.ActionToken.Query().Where(actiontoken.UserID(s.UserID)).IDs(ctx)
+		if err != nil || len(ids) == 0 {
+			return ids, err
+		}
+		_, err = client.ActionToken.Delete().Where(actiontoken.IDIn(ids...)).Exec(ctx)
+		return ids, err
+	})
+	// 归档文件由 Service 在事务提交后删除
+	Register("data_exports", ent.TypeDataExport, func(ctx context.Context, client *ent.Client, s Subject) ([]uint64, error) {
+		ids, err := client.DataExport.Query().Where(dataexport.UserID(s.UserID)).IDs(ctx)
+		if err != nil || len(ids) == 0 {
+			return ids, err
+		}
+		_, err = client.DataExport.Delete().Where(dataexport.IDIn(ids...)).Exec(ctx)
+		return ids, err
+	})
+	// 文件内容由 Service 在事务提交后删除
+	Register("files", ent.TypeFile, func(ctx context.Context, client *ent.Client, s Subject) ([]uint64, error) {
+		ids, err := client.File.Query().Where(file.OwnerID(s.UserID)).IDs(ctx)
+		if err != nil || len(ids) == 0 {
+			return ids, err
+		}
+		_, err = client.File.Delete().Where(file.IDIn(ids...)).Exec(ctx)
+		return ids, err
+	})
+}
+
+// eraseUser 清除用户的个人信息与登录凭证并软删除用户，账号改为由化名生成的占位账号。
+// 已匿名化的用户不再更新。
+func eraseUser(ctx context.Context, client *ent.Client, s Subject) ([]uint64, error) {
+	u, err := client.User.Get(ctx, s.UserID)
+	if err != nil {
+		return nil, err
+	}
+	account := erasedAccount(s.Pseudonym)
+	if u.Account == account && u.DeletedAt != nil {
+		return []uint64{u.ID}, nil
+	}
+
+	// 密码替换为无人知晓的随机值，写入前由 hook 转为哈希
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	update := client.User.UpdateOneID(u.ID).
+		SetName(erasedName).
+		SetAccount(account).
+		SetAge(0).
+		SetSex(false).
+		ClearEmail().
+		ClearEmailVerifiedAt().
+		SetPassword(hex.EncodeToString(secret)).
+		ClearTotpSecret().
+		SetTotpEnabled(false).
+		ClearRecoveryCodes().
+		ClearRoles().
+		ClearAvatarID()
+	if u.DeletedAt == nil {
+		update.SetDeletedAt(time.Now())
+	}
+	if err := update.Exec(ctx); err != nil {
+		return nil, err
+	}
+	return []uint64{u.ID}, nil
+}
+
+// erasedAccount 返回匿名化后的账号，取化名的低 48 位，不超过账号的长度限制
+func erasedAccount(pseudonym uint64) string {
+	return fmt.Sprintf("erased-%012x", pseudonym&(1<<48-1))
+}
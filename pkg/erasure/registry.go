@@ -0,0 +1,59 @@
+// Package erasure 在用户注销账号的冷静期结束后匿名化用户并删除其凭证与个人数据。
+// 每类实体通过 Register 注册注销规则；审计记录不删除，最后统一将用户 ID 替换为化名并清除字段值。
+package erasure
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"go-web/ent"
+)
+
+// Subject 被注销的用户
+type Subject struct {
+	// UserID 用户 ID
+	UserID uint64
+	// Pseudonym 用户的化名 ID，用于替换审计记录中的用户 ID
+	Pseudonym uint64
+}
+
+// Rule 删除或匿名化用户在一类实体中的数据，返回受影响实体的 ID，这些实体的审计记录随后被脱敏。
+// ctx 为系统身份，限定在用户所属的租户内，并且包含已软删除的记录；client 属于注销所在的事务。
+// 重复执行时结果必须相同，注销中断后会重新执行全部规则。
+type Rule func(ctx context.Context, client *ent.Client, s Subject) ([]uint64, error)
+
+// rule 已注册的注销规则
+type rule struct {
+	name string
+	typ  string
+	fn   Rule
+}
+
+var (
+	mu    sync.RWMutex
+	rules []rule
+)
+
+// Register 注册一类实体的注销规则。name 为注销结果中的名称，typ 为实体类型，与审计记录的 entity_type 一致。
+// 规则按注册顺序执行，重复注册时 panic。
+func Register(name, typ string, fn Rule) {
+	mu.Lock()
+	defer mu.Unlock()
+	if fn == nil {
+		panic("erasure: rule is nil")
+	}
+	for _, r := range rules {
+		if r.name == name {
+			panic(fmt.Sprintf("erasure: rule %q is already registered", name))
+		}
+	}
+	rules = append(rules, rule{name, typ, fn})
+}
+
+// registered 返回已注册的注销规则
+func registered() []rule {
+	mu.RLock()
+	defer mu.RUnlock()
+	return append([]rule(nil), rules...)
+}
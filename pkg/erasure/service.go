@@ -0,0 +1,305 @@
+package erasure
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"go-web/ent"
+	"go-web/ent/accounterasure"
+	"go-web/ent/schema"
+	"go-web/pkg/auth"
+	"go-web/pkg/config"
+	goWebErrors "go-web/pkg/errors"
+	"go-web/pkg/export"
+	"go-web/pkg/password"
+	"go-web/pkg/tenancy"
+	"go-web/pkg/upload"
+	"go-web/pkg/viewer"
+
+	"github.com/google/wire"
+	"go.uber.org/zap"
+)
+
+var ProviderSet = wire.NewSet(NewService)
+
+const (
+	// staleAfter 超过该时间仍在执行的注销视为已中断，例如服务在执行期间重启，之后重新执行
+	staleAfter = time.Hour
+	// batchSize 每次检查最多执行的注销数量
+	batchSize = 100
+	// auditLogsResult 注销结果中审计记录的名称
+	auditLogsResult = "audit_logs"
+)
+
+// Service 账号注销服务：申请注销后经过冷静期，由 Run 在后台匿名化用户并删除其数据
+type Service struct {
+	client   *ent.Client
+	logger   *zap.Logger
+	tokens   *auth.TokenService
+	sessions *auth.SessionStore
+	exports  *export.Service
+	uploads  *upload.Service
+	key      []byte
+	grace    time.Duration
+	interval time.Duration
+}
+
+// NewService 创建账号注销服务
+func NewService(cfg *config.Config, client *ent.Client, tokens *auth.TokenService, sessions *auth.SessionStore, exports *export.Service, uploads *upload.Service, logger *zap.Logger) *Service {
+	ec := cfg.Erasure
+	return &Service{
+		client:   client,
+		logger:   logger.With(zap.String("component", "account_erasure")),
+		tokens:   tokens,
+		sessions: sessions,
+		exports:  exports,
+		uploads:  uploads,
+		key:      []byte(ec.PseudonymKey),
+		grace:    ec.GracePeriod,
+		interval: ec.Interval,
+	}
+}
+
+// Schedule 校验密码后为用户申请注销，冷静期结束后执行。已申请注销时返回该记录。
+func (s *Service) Schedule(ctx context.Context, userID uint64, plain string) (*ent.AccountErasure, error) {
+	sysCtx := viewer.NewSystemContext(ctx)
+	u, err := s.client.User.Get(sysCtx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query user: %w", err)
+	}
+	ok, _, err := password.Default().Verify(plain, u.Password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify password: %w", err)
+	}
+	if !ok {
+		return nil, goWebErrors.New(goWebErrors.ErrUnauthorized, "unauthorized", "password is incorrect")
+	}
+
+	if e, err := s.Current(sysCtx, userID); err != nil || e != nil {
+		return e, err
+	}
+	e, err := s.client.AccountErasure.Create().
+		SetUserID(userID).
+		SetScheduledFor(time.Now().Add(s.grace)).
+		Save(sysCtx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to schedule account erasure: %w", err)
+	}
+	s.logger.Info("account erasure scheduled", zap.Uint64("user_id", userID), zap.Time("scheduled_for", e.ScheduledFor))
+	return e, nil
+}
+
+// Cancel 在冷静期内撤销注销，没有待执行的注销时返回 ErrNotFound
+func (s *Service) Cancel(ctx context.Context, userID uint64) (*ent.AccountErasure, error) {
+	sysCtx := viewer.NewSystemContext(ctx)
+	e, err := s.client.AccountErasure.Query().
+		Where(accounterasure.UserID(userID), accounterasure.StatusEQ(accounterasure.StatusScheduled)).
+		First(sysCtx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, goWebErrors.New(goWebErrors.ErrNotFound, "not_found", "no account deletion is scheduled")
+		}
+		return nil, fmt.Errorf("failed to query account erasure: %w", err)
+	}
+
+	// 以状态为更新条件，与后台开始执行的注销并发时只有一方成功
+	n, err := s.client.AccountErasure.Update().
+		Where(accounterasure.ID(e.ID), accounterasure.StatusEQ(accounterasure.StatusScheduled)).
+		SetStatus(accounterasure.StatusCancelled).
+		SetCancelledAt(time.Now()).
+		Save(sysCtx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to cancel account erasure: %w", err)
+	}
+	if n == 0 {
+		return nil, goWebErrors.New(goWebErrors.ErrInvalidState, "invalid_state", "account deletion has already started")
+	}
+	s.logger.Info("account erasure cancelled", zap.Uint64("user_id", userID))
+	return s.client.AccountErasure.Get(sysCtx, e.ID)
+}
+
+// Current 返回用户待执行或正在执行的注销，没有时返回 nil
+func (s *Service) Current(ctx context.Context, userID uint64) (*ent.AccountErasure, error) {
+	e, err := s.client.AccountErasure.Query().
+		Where(
+			accounterasure.UserID(userID),
+			accounterasure.StatusIn(accounterasure.StatusScheduled, accounterasure.StatusRunning),
+		).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to query account erasure: %w", err)
+	}
+	return e, nil
+}
+
+// Run 定期执行到期的注销，直到 ctx 取消
+func (s *Service) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		if err := s.RunDue(ctx); err != nil {
+			s.logger.Error("failed to run account erasures", zap.Error(err))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunDue 执行全部租户中到期以及中断的注销，返回执行失败的第一个错误
+func (s *Service) RunDue(ctx context.Context) error {
+	sysCtx := viewer.NewSystemContext(ctx)
+	for {
+		now := time.Now()
+		due, err := s.client.AccountErasure.Query().
+			Where(accounterasure.Or(
+				accounterasure.And(
+					accounterasure.StatusEQ(accounterasure.StatusScheduled),
+					accounterasure.ScheduledForLTE(now),
+				),
+				accounterasure.And(
+					accounterasure.StatusEQ(accounterasure.StatusRunning),
+					accounterasure.StartedAtLT(now.Add(-staleAfter)),
+				),
+			)).
+			Order(ent.Asc(accounterasure.FieldScheduledFor)).
+			Limit(batchSize).
+			All(sysCtx)
+		if err != nil {
+			return fmt.Errorf("failed to query due account erasures: %w", err)
+		}
+		var first error
+		for _, e := range due {
+			if err := s.process(ctx, e); err != nil {
+				s.logger.Error("account erasure failed", zap.Uint64("erasure_id", e.ID), zap.Error(err))
+				if first == nil {
+					first = err
+				}
+			}
+		}
+		if first != nil || len(due) < batchSize {
+			return first
+		}
+	}
+}
+
+// process 占用一条注销并执行。多个实例同时检查时，以状态与开始时间为更新条件保证只有一个实例执行
+func (s *Service) process(ctx context.Context, e *ent.AccountErasure) error {
+	ctx = tenancy.NewContext(viewer.NewSystemContext(ctx), e.TenantID)
+
+	claim := s.client.AccountErasure.Update().
+		Where(accounterasure.ID(e.ID), accounterasure.StatusEQ(e.Status))
+	if e.StartedAt != nil {
+		claim.Where(accounterasure.StartedAt(*e.StartedAt))
+	}
+	n, err := claim.
+		SetStatus(accounterasure.StatusRunning).
+		SetStartedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to claim account erasure: %w", err)
+	}
+	if n == 0 {
+		return nil
+	}
+
+	if err := s.erase(ctx, e); err != nil {
+		// 恢复为待执行，下次检查时重试
+		if rerr := s.client.AccountErasure.UpdateOneID(e.ID).
+			SetStatus(accounterasure.StatusScheduled).
+			Exec(context.WithoutCancel(ctx)); rerr != nil {
+			s.logger.Error("failed to reschedule account erasure", zap.Uint64("erasure_id", e.ID), zap.Error(rerr))
+		}
+		return err
+	}
+	return nil
+}
+
+// erase 注销用户并将注销记录标记为完成，ctx 限定在注销所属的租户内。
+// 全部规则、审计记录的脱敏与完成状态在一个事务中提交，中断时不产生部分注销，重新执行时得到相同的结果。
+func (s *Service) erase(ctx context.Context, e *ent.AccountErasure) error {
+	ctx = schema.IncludeDeleted(viewer.NewSystemContext(ctx))
+	subject := Subject{UserID: e.UserID, Pseudonym: s.Pseudonym(e.TenantID, e.UserID)}
+	logger := s.logger.With(zap.Uint64("erasure_id", e.ID))
+
+	// 先吊销登录状态，吊销失败时不继续注销，之后重试
+	if err := s.tokens.LogoutAll(ctx, e.UserID); err != nil {
+		return fmt.Errorf("failed to revoke tokens: %w", err)
+	}
+	if err := s.sessions.DestroyAll(ctx, e.UserID); err != nil {
+		return fmt.Errorf("failed to destroy sessions: %w", err)
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	client := tx.Client()
+	results := make(map[string]int)
+	erased := make(map[string][]uint64)
+	for _, r := range registered() {
+		ids, err := r.fn(ctx, client, subject)
+		if err != nil {
+			return rollback(tx, fmt.Errorf("failed to erase %s: %w", r.name, err))
+		}
+		results[r.name] = len(ids)
+		erased[r.typ] = append(erased[r.typ], ids...)
+	}
+	// 审计记录最后处理，前面的规则产生的审计记录也一并脱敏
+	n, err := scrubAuditLogs(ctx, client, subject, erased)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	results[auditLogsResult] = n
+
+	if err := client.AccountErasure.UpdateOneID(e.ID).
+		SetStatus(accounterasure.StatusCompleted).
+		SetCompletedAt(time.Now()).
+		SetResults(results).
+		Exec(ctx); err != nil {
+		return rollback(tx, fmt.Errorf("failed to complete account erasure: %w", err))
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	// 归档文件不在事务中，提交后删除；删除失败时归档在保留期结束后被清理
+	if err := s.exports.Remove(erased[ent.TypeDataExport]...); err != nil {
+		logger.Warn("failed to remove data export archives", zap.Error(err))
+	}
+	// 文件内容同样在提交后删除，记录已删除，删除失败时残留的内容不会再被引用
+	if err := s.uploads.Remove(ctx, erased[ent.TypeFile]...); err != nil {
+		logger.Warn("failed to remove uploaded files", zap.Error(err))
+	}
+	logger.Info("account erased", zap.Any("results", results))
+	return nil
+}
+
+// Pseudonym 返回用户在审计记录中的化名 ID。化名由密钥计算，持有密钥可以验证某个用户的注销记录。
+// 数据库驱动不支持最高位为 1 的 uint64，这里将次高位置 1：sonyflake 的时间部分要在起始时间约 87 年后才会用到该位，
+// 化名不会与实体 ID 重复。
+func (s *Service) Pseudonym(tenantID, userID uint64) uint64 {
+	mac := hmac.New(sha256.New, s.key)
+	var buf [16]byte
+	binary.BigEndian.PutUint64(buf[:8], tenantID)
+	binary.BigEndian.PutUint64(buf[8:], userID)
+	mac.Write(buf[:])
+	return binary.BigEndian.Uint64(mac.Sum(nil))&(1<<63-1) | 1<<62
+}
+
+// rollback 回滚事务并返回原始错误
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		err = fmt.Errorf("%w: %v", err, rerr)
+	}
+	return err
+}
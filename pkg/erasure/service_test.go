@@ -0,0 +1,230 @@
+package erasure
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go-web/ent"
+	"go-web/ent/accounterasure"
+	"go-web/ent/actiontoken"
+	"go-web/ent/audit"
+	"go-web/ent/auditlog"
+	"go-web/ent/schema"
+	"go-web/pkg/auth"
+	"go-web/pkg/config"
+	"go-web/pkg/export"
+	"go-web/pkg/storage"
+	"go-web/pkg/testutil"
+	"go-web/pkg/upload"
+	"go-web/pkg/viewer"
+
+	"go.uber.org/zap"
+)
+
+// interrupt 为 true 时测试注册的规则返回错误，模拟注销执行到一半失败
+var interrupt atomic.Bool
+
+func init() {
+	Register("test_interrupt", ent.TypeUser, func(context.Context, *ent.Client, Subject) ([]uint64, error) {
+		if interrupt.Load() {
+			return nil, errors.New("interrupted")
+		}
+		return nil, nil
+	})
+}
+
+// erasureFixture 申请了注销、存在审计记录与一次性令牌的用户
+type erasureFixture struct {
+	svc     *Service
+	client  *ent.Client
+	ctx     context.Context
+	user    *ent.User
+	erasure *ent.AccountErasure
+}
+
+func newErasureFixture(t *testing.T) *erasureFixture {
+	t.Helper()
+	client := testutil.NewClient(t)
+	client.Use(audit.Hook())
+	_, ctx := testutil.NewTenant(t, client, "acme")
+	rdb, _ := testutil.NewRedis(t)
+
+	cfg := &config.Config{}
+	cfg.Auth.JWT.Secret = "k3J9vQ2xT7mR4wZ8pL1nB6cF5hD0sG3y"
+	cfg.Auth.JWT.AccessTTL = time.Minute
+	cfg.Auth.JWT.RefreshTTL = time.Hour
+	cfg.Auth.Session.TTL = time.Hour
+	cfg.Export.Dir = t.TempDir()
+	cfg.Erasure.PseudonymKey = "p8Vn2Lq6Xw3Rt9Ks4Hd7Jf1Mb5Gc0Za2"
+	cfg.Erasure.Interval = time.Minute
+
+	exports, err := export.NewService(cfg, client, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+	store, err := storage.NewLocalStorage(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc := NewService(cfg, client,
+		auth.NewTokenService(auth.NewTokenManager(cfg), rdb, client, zap.NewNop()),
+		auth.NewSessionStore(cfg, rdb, client, zap.NewNop()),
+		exports, upload.NewService(cfg, client, store, zap.NewNop()), zap.NewNop())
+
+	u, err := client.User.Create().
+		SetName("alice").
+		SetSex(false).
+		SetAge(30).
+		SetAccount("alice").
+		SetEmail("alice@example.com").
+		SetPassword("correct horse").
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 用户本人的修改产生以其为操作者的审计记录
+	self := viewer.NewContext(ctx, &viewer.Viewer{ID: u.ID})
+	if u, err = client.User.UpdateOne(u).SetName("alice liddell").Save(self); err != nil {
+		t.Fatal(err)
+	}
+	client.ActionToken.Create().
+		SetKind(actiontoken.KindPasswordReset).
+		SetTokenHash("hash").
+		SetUserID(u.ID).
+		SetExpiresAt(time.Now().Add(time.Hour)).
+		ExecX(ctx)
+
+	e, err := svc.Schedule(ctx, u.ID, "correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &erasureFixture{svc: svc, client: client, ctx: ctx, user: u, erasure: e}
+}
+
+// state 返回注销后用户与其审计记录的快照，用于比较重复执行的结果
+func (f *erasureFixture) state(t *testing.T) string {
+	t.Helper()
+	ctx := schema.IncludeDeleted(f.ctx)
+	u := f.client.User.GetX(ctx, f.user.ID)
+	logs := f.client.AuditLog.Query().
+		Where(auditlog.EntityTypeNEQ(ent.TypeAccountErasure)).
+		Order(ent.Asc(auditlog.FieldID)).
+		AllX(ctx)
+	b, err := json.Marshal(struct {
+		User *ent.User
+		Logs []*ent.AuditLog
+	}{u, logs})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
+
+// status 返回注销记录的当前状态
+func (f *erasureFixture) status(t *testing.T) accounterasure.Status {
+	t.Helper()
+	return f.client.AccountErasure.GetX(f.ctx, f.erasure.ID).Status
+}
+
+func TestEraseScrubsAuditLogs(t *testing.T) {
+	f := newErasureFixture(t)
+	if err := f.svc.RunDue(f.ctx); err != nil {
+		t.Fatal(err)
+	}
+	if s := f.status(t); s != accounterasure.StatusCompleted {
+		t.Fatalf("erasure status = %s, want completed", s)
+	}
+
+	pseudonym := f.svc.Pseudonym(f.user.TenantID, f.user.ID)
+	logs := f.client.AuditLog.Query().Where(auditlog.EntityTypeNEQ(ent.TypeAccountErasure)).AllX(f.ctx)
+	if len(logs) == 0 {
+		t.Fatal("no audit logs were kept")
+	}
+	var pseudonymized int
+	for _, l := range logs {
+		if l.ActorID != nil && *l.ActorID == f.user.ID {
+			t.Fatalf("audit log %d still names the user as actor", l.ID)
+		}
+		if l.EntityType == ent.TypeUser && l.EntityID == f.user.ID {
+			t.Fatalf("audit log %d still references the user", l.ID)
+		}
+		b, _ := json.Marshal([]any{l.Before, l.After})
+		if strings.Contains(string(b), "alice") {
+			t.Fatalf("audit log %d keeps personal data: %s", l.ID, b)
+		}
+		if l.EntityType == ent.TypeUser && l.EntityID == pseudonym {
+			pseudonymized++
+			if l.After != nil && l.After["name"] != Erased {
+				t.Fatalf("audit log %d name = %v, want %s", l.ID, l.After["name"], Erased)
+			}
+		}
+	}
+	// 创建、本人修改与注销各一条
+	if pseudonymized != 3 {
+		t.Fatalf("audit logs of the pseudonymized user = %d, want 3", pseudonymized)
+	}
+
+	u := f.client.User.GetX(schema.IncludeDeleted(f.ctx), f.user.ID)
+	if u.Account != erasedAccount(pseudonym) || u.Name != erasedName || u.Email != nil || u.DeletedAt == nil {
+		t.Fatalf("erased user = %+v, want anonymized and deleted", u)
+	}
+	if n := f.client.ActionToken.Query().CountX(f.ctx); n != 0 {
+		t.Fatalf("action tokens after erasure = %d, want 0", n)
+	}
+}
+
+func TestEraseIsIdempotent(t *testing.T) {
+	f := newErasureFixture(t)
+	if err := f.svc.RunDue(f.ctx); err != nil {
+		t.Fatal(err)
+	}
+	want := f.state(t)
+
+	// 提交后再次执行同一注销，用户与审计记录不再变化
+	e := f.client.AccountErasure.GetX(f.ctx, f.erasure.ID)
+	if err := f.svc.erase(f.ctx, e); err != nil {
+		t.Fatal(err)
+	}
+	if got := f.state(t); got != want {
+		t.Fatalf("state after re-running the erasure:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestEraseResumesAfterInterruption(t *testing.T) {
+	f := newErasureFixture(t)
+
+	// 执行到一半失败时事务回滚，注销恢复为待执行
+	interrupt.Store(true)
+	err := f.svc.RunDue(f.ctx)
+	interrupt.Store(false)
+	if err == nil {
+		t.Fatal("interrupted erasure returned no error")
+	}
+	if s := f.status(t); s != accounterasure.StatusScheduled {
+		t.Fatalf("erasure status after failure = %s, want scheduled", s)
+	}
+	if u := f.client.User.GetX(f.ctx, f.user.ID); u.Account != "alice" {
+		t.Fatalf("user partially erased: account = %s", u.Account)
+	}
+
+	// 服务在执行期间重启，超时仍在执行的注销被重新执行
+	f.client.AccountErasure.UpdateOneID(f.erasure.ID).
+		SetStatus(accounterasure.StatusRunning).
+		SetStartedAt(time.Now().Add(-2 * staleAfter)).
+		ExecX(f.ctx)
+	if err := f.svc.RunDue(f.ctx); err != nil {
+		t.Fatal(err)
+	}
+	if s := f.status(t); s != accounterasure.StatusCompleted {
+		t.Fatalf("erasure status after resuming = %s, want completed", s)
+	}
+	pseudonym := f.svc.Pseudonym(f.user.TenantID, f.user.ID)
+	if u := f.client.User.GetX(schema.IncludeDeleted(f.ctx), f.user.ID); u.Account != erasedAccount(pseudonym) {
+		t.Fatalf("account after resuming = %s, want %s", u.Account, erasedAccount(pseudonym))
+	}
+}
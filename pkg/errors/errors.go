@@ -23,6 +23,12 @@ const (
 	ErrUnauthorized ErrorCode = 2000
 	ErrForbidden    ErrorCode = 2001
 	ErrTokenExpired ErrorCode = 2002
+	// 两步验证码或恢复码无效
+	ErrInvalidTwoFactorCode ErrorCode = 2003
+	// 登录失败次数过多，账号或 IP 被临时锁定
+	ErrAccountLocked ErrorCode = 2004
+	// 登录过于频繁，需要等待后重试
+	ErrTooManyAttempts ErrorCode = 2005
 
 	// 业务逻辑错误码 (3000-3999)
 	ErrNotFound     ErrorCode = 3000
@@ -0,0 +1,60 @@
+package export
+
+import (
+	"context"
+
+	"go-web/ent"
+	"go-web/ent/apikey"
+	"go-web/ent/auditlog"
+	"go-web/ent/dataexport"
+	"go-web/ent/file"
+	"go-web/ent/identity"
+	"go-web/ent/role"
+	"go-web/ent/user"
+)
+
+// 内置实体的导出函数。敏感字段在 ent 生成的结构体中不参与 JSON 编码，不会出现在归档中。
+func init() {
+	Register("user", func(ctx context.Context, client *ent.Client, userID uint64) (any, error) {
+		return client.User.Get(ctx, userID)
+	})
+	Register("roles", func(ctx context.Context, client *ent.Client, userID uint64) (any, error) {
+		names, err := client.Role.Query().
+			Where(role.HasUsersWith(user.ID(userID))).
+			Select(role.FieldName).
+			Strings(ctx)
+		if names == nil {
+			names = []string{}
+		}
+		return names, err
+	})
+	Register("api_keys", func(ctx context.Context, client *ent.Client, userID uint64) (any, error) {
+		return client.APIKey.Query().Where(apikey.OwnerID(userID)).All(ctx)
+	})
+	Register("identities", func(ctx context.Context, client *ent.Client, userID uint64) (any, error) {
+		return client.Identity.Query().Where(identity.UserID(userID)).All(ctx)
+	})
+	// 用户本人的操作以及对用户本人的变更
+	Register("audit_logs", func(ctx context.Context, client *ent.Client, userID uint64) (any, error) {
+		return client.AuditLog.Query().
+			Where(auditlog.Or(
+				auditlog.ActorID(userID),
+				auditlog.And(auditlog.EntityType(ent.TypeUser), auditlog.EntityID(userID)),
+			)).
+			Order(ent.Asc(auditlog.FieldCreatedAt)).
+			All(ctx)
+	})
+	Register("data_exports", func(ctx context.Context, client *ent.Client, userID uint64) (any, error) {
+		return client.DataExport.Query().
+			Where(dataexport.UserID(userID)).
+			Order(ent.Asc(dataexport.FieldCreatedAt)).
+			All(ctx)
+	})
+	// 上传的文件只导出元数据，内容较大，不写入归档
+	Register("files", func(ctx context.Context, client *ent.Client, userID uint64) (any, error) {
+		return client.File.Query().
+			Where(file.OwnerID(userID)).
+			Order(ent.Asc(file.FieldCreatedAt)).
+			All(ctx)
+	})
+}
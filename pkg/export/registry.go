@@ -0,0 +1,52 @@
+// Package export 将用户在各实体中的个人数据导出为 JSON 归档，用于响应数据访问请求。
+// 每类实体通过 Register 注册导出函数，新增的实体注册后即包含在归档中。
+package export
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"go-web/ent"
+)
+
+// Exporter 返回用户在一类实体中的数据，结果编码为 JSON 写入归档。
+// ctx 为系统身份，限定在用户所属的租户内，并且包含已软删除的记录。
+type Exporter func(ctx context.Context, client *ent.Client, userID uint64) (any, error)
+
+var (
+	mu        sync.RWMutex
+	exporters = make(map[string]Exporter)
+)
+
+// Register 注册一类实体的导出函数，name 为该类数据在归档中的字段名，重复注册时 panic
+func Register(name string, fn Exporter) {
+	mu.Lock()
+	defer mu.Unlock()
+	if fn == nil {
+		panic("export: exporter is nil")
+	}
+	if _, ok := exporters[name]; ok {
+		panic(fmt.Sprintf("export: exporter %q is already registered", name))
+	}
+	exporters[name] = fn
+}
+
+// section 归档中的一类数据
+type section struct {
+	name string
+	fn   Exporter
+}
+
+// sections 按名称顺序返回已注册的导出函数，使归档内容的顺序稳定
+func sections() []section {
+	mu.RLock()
+	defer mu.RUnlock()
+	out := make([]section, 0, len(exporters))
+	for name, fn := range exporters {
+		out = append(out, section{name, fn})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].name < out[j].name })
+	return out
+}
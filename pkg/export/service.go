@@ -0,0 +1,281 @@
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"go-web/ent"
+	"go-web/ent/dataexport"
+	"go-web/ent/schema"
+	"go-web/ent/user"
+	"go-web/pkg/config"
+	goWebErrors "go-web/pkg/errors"
+	"go-web/pkg/viewer"
+
+	"github.com/google/wire"
+	"go.uber.org/zap"
+)
+
+var ProviderSet = wire.NewSet(NewService)
+
+const (
+	// maxConcurrentExports 同时生成的归档数量
+	maxConcurrentExports = 2
+	// staleAfter 超过该时间仍未完成的导出视为已中断，例如服务在导出期间重启，再次请求时重新导出
+	staleAfter = time.Hour
+	// failedMessage 导出失败时记录的原因，具体错误只写入日志
+	failedMessage = "failed to export data"
+)
+
+// Service 个人数据导出服务，归档在后台生成并保存在本地目录
+type Service struct {
+	client    *ent.Client
+	logger    *zap.Logger
+	signer    *Signer
+	dir       string
+	baseURL   string
+	linkTTL   time.Duration
+	retention time.Duration
+	slots     chan struct{}
+}
+
+// Archive 归档内容
+type Archive struct {
+	UserID     uint64         `json:"user_id,string"`
+	ExportedAt time.Time      `json:"exported_at"`
+	Data       map[string]any `json:"data"`
+}
+
+// NewService 创建个人数据导出服务，归档目录不存在时自动创建
+func NewService(cfg *config.Config, client *ent.Client, logger *zap.Logger) (*Service, error) {
+	ec := cfg.Export
+	if err := os.MkdirAll(ec.Dir, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create export directory: %w", err)
+	}
+	return &Service{
+		client:    client,
+		logger:    logger.With(zap.String("component", "data_export")),
+		signer:    NewSigner(ec.SigningKey),
+		dir:       ec.Dir,
+		baseURL:   strings.TrimSuffix(ec.BaseURL, "/"),
+		linkTTL:   ec.LinkTTL,
+		retention: ec.Retention,
+		slots:     make(chan struct{}, maxConcurrentExports),
+	}, nil
+}
+
+// Request 为用户发起导出并立即返回，归档在后台生成。用户已有进行中的导出时返回该导出。
+// ctx 必须限定在用户所属的租户内，调用方负责校验访问者是否有权导出该用户的数据。
+func (s *Service) Request(ctx context.Context, userID uint64) (*ent.DataExport, error) {
+	sysCtx := viewer.NewSystemContext(ctx)
+	s.cleanup()
+
+	exists, err := s.client.User.Query().Where(user.ID(userID)).Exist(schema.IncludeDeleted(sysCtx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query user: %w", err)
+	}
+	if !exists {
+		return nil, goWebErrors.New(goWebErrors.ErrNotFound, "not_found", "user not found")
+	}
+
+	running, err := s.client.DataExport.Query().
+		Where(
+			dataexport.UserID(userID),
+			dataexport.StatusIn(dataexport.StatusPending, dataexport.StatusRunning),
+			dataexport.CreatedAtGT(time.Now().Add(-staleAfter)),
+		).
+		First(sysCtx)
+	if err == nil {
+		return running, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to query data exports: %w", err)
+	}
+
+	create := s.client.DataExport.Create().SetUserID(userID)
+	if v := viewer.FromContext(ctx); v != nil {
+		create.SetRequestedBy(v.ID)
+	}
+	e, err := create.Save(sysCtx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create data export: %w", err)
+	}
+
+	// 导出在请求结束后继续进行，保留 context 中的租户与请求信息
+	go s.run(context.WithoutCancel(sysCtx), e.ID, userID)
+	return e, nil
+}
+
+// run 生成归档并记录导出结果
+func (s *Service) run(ctx context.Context, id, userID uint64) {
+	s.slots <- struct{}{}
+	defer func() { <-s.slots }()
+
+	logger := s.logger.With(zap.Uint64("export_id", id), zap.Uint64("user_id", userID))
+	if err := s.client.DataExport.UpdateOneID(id).SetStatus(dataexport.StatusRunning).Exec(ctx); err != nil {
+		logger.Error("failed to start data export", zap.Error(err))
+		return
+	}
+
+	size, err := s.write(ctx, id, userID)
+	if err != nil {
+		logger.Error("failed to export data", zap.Error(err))
+		if err := s.client.DataExport.UpdateOneID(id).
+			SetStatus(dataexport.StatusFailed).
+			SetError(failedMessage).
+			Exec(ctx); err != nil {
+			logger.Error("failed to update data export", zap.Error(err))
+		}
+		return
+	}
+
+	now := time.Now()
+	if err := s.client.DataExport.UpdateOneID(id).
+		SetStatus(dataexport.StatusCompleted).
+		SetSize(size).
+		SetCompletedAt(now).
+		SetExpiresAt(now.Add(s.retention)).
+		Exec(ctx); err != nil {
+		logger.Error("failed to update data export", zap.Error(err))
+		_ = os.Remove(s.path(id))
+		return
+	}
+	logger.Info("data export completed", zap.Int64("size", size))
+}
+
+// write 调用已注册的导出函数并写入归档文件，先写入临时文件，完成后再重命名，下载时不会读到不完整的归档
+func (s *Service) write(ctx context.Context, id, userID uint64) (int64, error) {
+	ctx = schema.IncludeDeleted(ctx)
+	archive := &Archive{
+		UserID:     userID,
+		ExportedAt: time.Now(),
+		Data:       make(map[string]any),
+	}
+	for _, sec := range sections() {
+		v, err := sec.fn(ctx, s.client, userID)
+		if err != nil {
+			return 0, fmt.Errorf("failed to export %s: %w", sec.name, err)
+		}
+		archive.Data[sec.name] = v
+	}
+
+	f, err := os.CreateTemp(s.dir, strconv.FormatUint(id, 10)+".*.tmp")
+	if err != nil {
+		return 0, err
+	}
+	defer os.Remove(f.Name())
+
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(archive); err != nil {
+		f.Close()
+		return 0, fmt.Errorf("failed to write archive: %w", err)
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return 0, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return 0, err
+	}
+	if err := f.Close(); err != nil {
+		return 0, err
+	}
+	if err := os.Rename(f.Name(), s.path(id)); err != nil {
+		return 0, err
+	}
+	return info.Size(), nil
+}
+
+// DownloadURL 返回已完成导出的签名下载链接及其过期时间，链接不晚于归档过期时失效。
+// 导出未完成或归档已过期时返回空字符串。
+func (s *Service) DownloadURL(e *ent.DataExport) (string, *time.Time) {
+	now := time.Now()
+	if e.Status != dataexport.StatusCompleted || e.ExpiresAt == nil || !e.ExpiresAt.After(now) {
+		return "", nil
+	}
+	expires := now.Add(s.linkTTL)
+	if e.ExpiresAt.Before(expires) {
+		expires = *e.ExpiresAt
+	}
+	expires = expires.Truncate(time.Second)
+
+	q := url.Values{}
+	q.Set("expires", strconv.FormatInt(expires.Unix(), 10))
+	q.Set("signature", s.signer.Sign(e.ID, expires))
+	return fmt.Sprintf("%s/exports/%d/download?%s", s.baseURL, e.ID, q.Encode()), &expires
+}
+
+// Open 校验下载链接并打开归档，签名无效、链接或归档已过期时返回 ErrNotFound，不区分具体原因
+func (s *Service) Open(id uint64, expires time.Time, signature string) (*os.File, error) {
+	notFound := goWebErrors.New(goWebErrors.ErrNotFound, "not_found", "export not found or link expired")
+	if !s.signer.Verify(id, expires, signature) {
+		return nil, notFound
+	}
+	f, err := os.Open(s.path(id))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, notFound
+		}
+		return nil, err
+	}
+	if info, err := f.Stat(); err != nil || s.expired(info, time.Now()) {
+		f.Close()
+		return nil, notFound
+	}
+	return f, nil
+}
+
+// Remove 删除导出的归档文件，导出记录被删除时调用，例如注销账号
+func (s *Service) Remove(ids ...uint64) error {
+	for _, id := range ids {
+		if err := os.Remove(s.path(id)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to remove archive: %w", err)
+		}
+	}
+	return nil
+}
+
+// cleanup 删除超过保留时间的归档与中断后遗留的临时文件。
+// 文件修改时间即归档完成时间，无需查询数据库即可判断是否过期。
+func (s *Service) cleanup() {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		s.logger.Warn("failed to read export directory", zap.Error(err))
+		return
+	}
+	now := time.Now()
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if err != nil || info.IsDir() {
+			continue
+		}
+		stale := strings.HasSuffix(info.Name(), ".tmp") && now.Sub(info.ModTime()) > staleAfter
+		if !stale && !s.expired(info, now) {
+			continue
+		}
+		if err := os.Remove(filepath.Join(s.dir, info.Name())); err != nil && !errors.Is(err, os.ErrNotExist) {
+			s.logger.Warn("failed to remove expired archive", zap.String("file", info.Name()), zap.Error(err))
+		}
+	}
+}
+
+// expired 判断归档是否超过保留时间
+func (s *Service) expired(info os.FileInfo, now time.Time) bool {
+	return strings.HasSuffix(info.Name(), ".json") && now.Sub(info.ModTime()) > s.retention
+}
+
+// path 返回归档文件路径
+func (s *Service) path(id uint64) string {
+	return filepath.Join(s.dir, strconv.FormatUint(id, 10)+".json")
+}
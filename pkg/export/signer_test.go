@@ -0,0 +1,52 @@
+package export
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSignerVerify(t *testing.T) {
+	s := NewSigner("e4Tq8Wz1Nc6Ym3Hp9Kx2Lv5Rb7Dg0Sj4")
+	expires := time.Now().Add(time.Hour).Truncate(time.Second)
+	sig := s.Sign(42, expires)
+	tampered := []byte(sig)
+	tampered[0] ^= 1
+
+	tests := []struct {
+		name    string
+		signer  *Signer
+		id      uint64
+		expires time.Time
+		sig     string
+		want    bool
+	}{
+		{"valid", s, 42, expires, sig, true},
+		{"other id", s, 43, expires, sig, false},
+		{"extended expiry", s, 42, expires.Add(time.Hour), sig, false},
+		{"modified signature", s, 42, expires, string(tampered), false},
+		{"truncated signature", s, 42, expires, sig[:32], false},
+		{"empty signature", s, 42, expires, "", false},
+		{"other key", NewSigner("a-different-signing-key-for-tests"), 42, expires, sig, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.signer.Verify(tt.id, tt.expires, tt.sig); got != tt.want {
+				t.Fatalf("Verify = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSignerVerifyExpired(t *testing.T) {
+	s := NewSigner("e4Tq8Wz1Nc6Ym3Hp9Kx2Lv5Rb7Dg0Sj4")
+
+	// 签名有效但链接已过期
+	expired := time.Now().Add(-time.Second)
+	if s.Verify(42, expired, s.Sign(42, expired)) {
+		t.Fatal("expired link verified")
+	}
+	now := time.Now()
+	if s.Verify(42, now, s.Sign(42, now)) {
+		t.Fatal("link verified at its expiry time")
+	}
+}
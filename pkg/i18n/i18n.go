@@ -27,10 +27,16 @@ var messages = map[string]map[string]string{
 		"forbidden":     "禁止访问",
 		"token_expired": "登录已过期",
 
+		"invalid_two_factor_code": "两步验证码无效",
+		"account_locked":          "登录失败次数过多，账号已被临时锁定",
+		"too_many_attempts":       "操作过于频繁，请稍后重试",
+
 		// 业务逻辑错误
 		"not_found":     "资源不存在",
 		"already_exist": "资源已存在",
 		"invalid_state": "状态无效",
+		// 乐观锁版本冲突
+		"version_conflict": "数据已被他人修改，请刷新后重试",
 	},
 	"en": {
 		// System errors
@@ -48,10 +54,16 @@ var messages = map[string]map[string]string{
 		"forbidden":     "Forbidden",
 		"token_expired": "Token Expired",
 
+		"invalid_two_factor_code": "Invalid Two-Factor Code",
+		"account_locked":          "Too many failed logins, account temporarily locked",
+		"too_many_attempts":       "Too many attempts, please retry later",
+
 		// Business logic errors
 		"not_found":     "Resource Not Found",
 		"already_exist": "Resource Already Exists",
 		"invalid_state": "Invalid State",
+		// Optimistic locking conflict
+		"version_conflict": "The record was modified by someone else, reload and retry",
 	},
 }
 
@@ -5,6 +5,8 @@ import (
 	"sync"
 
 	"go-web/ent"
+	"go-web/ent/audit"
+	_ "go-web/ent/runtime"
 	"go-web/pkg/config"
 
 	"entgo.io/ent/dialect"
@@ -67,6 +69,8 @@ func NewMysql(cfg *config.Config, logger *zap.Logger) *ent.Client {
 		)
 
 		db = ent.NewClient(ent.Driver(ent_sql.OpenDB(dialect.MySQL, d)))
+		// 记录全部实体变更的审计日志
+		db.Use(audit.Hook())
 	})
 
 	return db
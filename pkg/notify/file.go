@@ -0,0 +1,53 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// FileNotifier 将通知以 JSON Lines 格式追加到本地文件
+type FileNotifier struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewFileNotifier 创建文件通知发送器，目录不存在时自动创建
+func NewFileNotifier(path string) (*FileNotifier, error) {
+	if path == "" {
+		return nil, fmt.Errorf("notify file path is required")
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create notify directory: %w", err)
+	}
+	return &FileNotifier{path: path}, nil
+}
+
+// Send 发送通知
+func (n *FileNotifier) Send(_ context.Context, msg *Message) error {
+	line, err := json.Marshal(struct {
+		*Message
+		SentAt time.Time `json:"sent_at"`
+	}{msg, time.Now()})
+	if err != nil {
+		return fmt.Errorf("failed to marshal notification: %w", err)
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	f, err := os.OpenFile(n.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open notify file: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write notification: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package notify
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+)
+
+// LogNotifier 将通知写入日志，适用于开发环境
+type LogNotifier struct {
+	logger *zap.Logger
+}
+
+// NewLogNotifier 创建日志通知发送器
+func NewLogNotifier(logger *zap.Logger) *LogNotifier {
+	return &LogNotifier{logger: logger.With(zap.String("component", "notifier"))}
+}
+
+// Send 发送通知
+func (n *LogNotifier) Send(_ context.Context, msg *Message) error {
+	n.logger.Info("notification",
+		zap.String("kind", msg.Kind),
+		zap.Uint64("user_id", msg.UserID),
+		zap.String("to", msg.To),
+		zap.String("subject", msg.Subject),
+		zap.String("body", msg.Body),
+	)
+	return nil
+}
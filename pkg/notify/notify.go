@@ -0,0 +1,54 @@
+// Package notify 向用户发送通知（密码重置、邮箱验证等）。
+// 默认实现写入日志或本地文件，无需外部服务即可使用；接入邮件或短信服务时实现 Notifier 即可。
+package notify
+
+import (
+	"context"
+	"fmt"
+
+	"go-web/pkg/config"
+
+	"github.com/google/wire"
+	"go.uber.org/zap"
+)
+
+var ProviderSet = wire.NewSet(NewNotifier)
+
+const (
+	// KindPasswordReset 密码重置
+	KindPasswordReset = "password_reset"
+	// KindEmailVerification 邮箱验证
+	KindEmailVerification = "email_verification"
+)
+
+// Message 通知内容
+type Message struct {
+	// 通知类型
+	Kind string `json:"kind"`
+	// 接收用户 ID
+	UserID uint64 `json:"user_id"`
+	// 接收地址，例如账号或邮箱
+	To string `json:"to"`
+	// 标题
+	Subject string `json:"subject"`
+	// 正文
+	Body string `json:"body"`
+}
+
+// Notifier 通知发送接口
+type Notifier interface {
+	// Send 发送通知
+	Send(ctx context.Context, msg *Message) error
+}
+
+// NewNotifier 根据配置创建通知发送器
+func NewNotifier(cfg *config.Config, logger *zap.Logger) (Notifier, error) {
+	switch cfg.Notify.Driver {
+	case "log":
+		return NewLogNotifier(logger), nil
+	case "file":
+		return NewFileNotifier(cfg.Notify.File)
+	default:
+		return nil, fmt.Errorf("unsupported notify driver %q", cfg.Notify.Driver)
+	}
+}
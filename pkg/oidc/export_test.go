@@ -0,0 +1,14 @@
+package oidc
+
+import "time"
+
+// AgeKeySet 将身份提供方 JWKS 缓存的拉取时间提前 d，模拟经过了一段时间
+func AgeKeySet(p *Provider, d time.Duration) {
+	p.mu.Lock()
+	keys := p.keys
+	p.mu.Unlock()
+
+	keys.mu.Lock()
+	defer keys.mu.Unlock()
+	keys.fetchedAt = keys.fetchedAt.Add(-d)
+}
@@ -0,0 +1,185 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultJWKSCacheTTL JWKS 缓存时间
+	defaultJWKSCacheTTL = time.Hour
+	// minJWKSRefreshInterval 遇到未知 kid 时强制刷新的最小间隔，防止被用来放大请求
+	minJWKSRefreshInterval = time.Minute
+)
+
+// JSONWebKey JWKS 中的单个公钥
+type JSONWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use,omitempty"`
+	Alg string `json:"alg,omitempty"`
+	// RSA
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+	// EC
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+// JSONWebKeySet JWKS 文档
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// KeySet 远程 JWKS 的本地缓存。缓存过期或遇到未知 kid 时重新拉取。
+type KeySet struct {
+	client *http.Client
+	url    string
+	ttl    time.Duration
+
+	mu        sync.Mutex
+	keys      map[string]crypto.PublicKey
+	fetchedAt time.Time
+}
+
+// NewKeySet 创建 JWKS 缓存
+func NewKeySet(client *http.Client, url string) *KeySet {
+	return &KeySet{
+		client: client,
+		url:    url,
+		ttl:    defaultJWKSCacheTTL,
+	}
+}
+
+// Key 返回指定 kid 的公钥
+func (s *KeySet) Key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if s.keys == nil || now.Sub(s.fetchedAt) > s.ttl {
+		if err := s.refresh(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if key, ok := s.lookup(kid); ok {
+		return key, nil
+	}
+
+	// 身份提供方可能已轮换签名密钥
+	if now.Sub(s.fetchedAt) >= minJWKSRefreshInterval {
+		if err := s.refresh(ctx); err != nil {
+			return nil, err
+		}
+		if key, ok := s.lookup(kid); ok {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("jwks: no key found for kid %q", kid)
+}
+
+// lookup 查找公钥，kid 为空且只有一个密钥时直接使用该密钥
+func (s *KeySet) lookup(kid string) (crypto.PublicKey, bool) {
+	if kid == "" && len(s.keys) == 1 {
+		for _, key := range s.keys {
+			return key, true
+		}
+	}
+	key, ok := s.keys[kid]
+	return key, ok
+}
+
+// refresh 拉取并解析 JWKS，忽略不支持的密钥类型
+func (s *KeySet) refresh(ctx context.Context) error {
+	set := &JSONWebKeySet{}
+	if err := getJSON(ctx, s.client, s.url, set); err != nil {
+		return fmt.Errorf("jwks: %w", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		key, err := k.PublicKey()
+		if err != nil {
+			continue
+		}
+		keys[k.Kid] = key
+	}
+	if len(keys) == 0 {
+		return fmt.Errorf("jwks: no usable signing keys at %s", s.url)
+	}
+
+	s.keys = keys
+	s.fetchedAt = time.Now()
+	return nil
+}
+
+// PublicKey 将 JWK 转换为 RSA 或 ECDSA 公钥
+func (k JSONWebKey) PublicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("jwk: invalid modulus: %w", err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, fmt.Errorf("jwk: invalid exponent: %w", err)
+		}
+		return &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("jwk: unsupported curve %q", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, fmt.Errorf("jwk: invalid x: %w", err)
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, fmt.Errorf("jwk: invalid y: %w", err)
+		}
+		return &ecdsa.PublicKey{
+			Curve: curve,
+			X:     new(big.Int).SetBytes(x),
+			Y:     new(big.Int).SetBytes(y),
+		}, nil
+	default:
+		return nil, fmt.Errorf("jwk: unsupported key type %q", k.Kty)
+	}
+}
+
+// NewRSAJSONWebKey 将 RSA 公钥编码为 JWK
+func NewRSAJSONWebKey(kid string, key *rsa.PublicKey) JSONWebKey {
+	return JSONWebKey{
+		Kty: "RSA",
+		Kid: kid,
+		Use: "sig",
+		Alg: "RS256",
+		N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}
+}
@@ -0,0 +1,52 @@
+// Package oidc 实现 OpenID Connect 授权码登录流程（PKCE），包括服务发现、JWKS 缓存与 ID 令牌校验。
+package oidc
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"go-web/pkg/config"
+
+	"github.com/google/wire"
+)
+
+var ProviderSet = wire.NewSet(NewRegistry)
+
+// defaultHTTPTimeout 请求身份提供方的超时时间
+const defaultHTTPTimeout = 10 * time.Second
+
+// Registry 已配置的身份提供方
+type Registry struct {
+	providers map[string]*Provider
+}
+
+// NewRegistry 根据配置创建身份提供方注册表，服务发现延迟到首次使用时进行
+func NewRegistry(cfg *config.Config) *Registry {
+	client := &http.Client{Timeout: defaultHTTPTimeout}
+	r := &Registry{providers: make(map[string]*Provider, len(cfg.Auth.OIDC.Providers))}
+	for name, p := range cfg.Auth.OIDC.Providers {
+		r.providers[name] = NewProvider(name, p, client)
+	}
+	return r
+}
+
+// Get 获取指定名称的身份提供方
+func (r *Registry) Get(name string) (*Provider, error) {
+	p, ok := r.providers[name]
+	if !ok {
+		return nil, fmt.Errorf("oidc provider %q is not configured", name)
+	}
+	return p, nil
+}
+
+// Names 返回全部身份提供方名称
+func (r *Registry) Names() []string {
+	names := make([]string, 0, len(r.providers))
+	for name := range r.providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
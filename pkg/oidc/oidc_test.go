@@ -0,0 +1,283 @@
+package oidc_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"go-web/pkg/oidc"
+	"go-web/pkg/oidc/oidctest"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	clientID     = "client-id"
+	clientSecret = "client-secret"
+	redirectURL  = "http://localhost/auth/oidc/mock/callback"
+)
+
+// newProvider 启动模拟身份提供方并创建指向它的身份提供方
+func newProvider(t *testing.T) (*oidc.Provider, *oidctest.Provider) {
+	t.Helper()
+	mock := oidctest.NewProvider(clientID, clientSecret)
+	t.Cleanup(mock.Close)
+	return oidc.NewProvider("mock", mock.Config(redirectURL), &http.Client{Timeout: 5 * time.Second}), mock
+}
+
+// newAuthRequest 生成授权请求参数
+func newAuthRequest(t *testing.T) oidc.AuthRequest {
+	t.Helper()
+	state, err := oidc.NewState()
+	if err != nil {
+		t.Fatal(err)
+	}
+	nonce, err := oidc.NewNonce()
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifier, err := oidc.NewCodeVerifier()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return oidc.AuthRequest{State: state, Nonce: nonce, Verifier: verifier}
+}
+
+// authorize 发起授权请求并返回授权码
+func authorize(t *testing.T, p *oidc.Provider, mock *oidctest.Provider, req oidc.AuthRequest) string {
+	t.Helper()
+	authURL, err := p.AuthCodeURL(context.Background(), req)
+	if err != nil {
+		t.Fatalf("AuthCodeURL: %v", err)
+	}
+	callback, err := mock.Authorize(authURL)
+	if err != nil {
+		t.Fatalf("Authorize: %v", err)
+	}
+	if got := callback.Query().Get("state"); got != req.State {
+		t.Fatalf("callback state = %q, want %q", got, req.State)
+	}
+	return callback.Query().Get("code")
+}
+
+// validClaims 返回能通过校验的 ID 令牌声明
+func validClaims(mock *oidctest.Provider, nonce string) *oidc.IDTokenClaims {
+	now := time.Now()
+	return &oidc.IDTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    mock.Issuer(),
+			Subject:   "subject",
+			Audience:  jwt.ClaimStrings{clientID},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(5 * time.Minute)),
+		},
+		Nonce: nonce,
+	}
+}
+
+func TestDiscover(t *testing.T) {
+	mock := oidctest.NewProvider(clientID, clientSecret)
+	defer mock.Close()
+	ctx := context.Background()
+
+	md, err := oidc.Discover(ctx, http.DefaultClient, mock.Issuer())
+	if err != nil {
+		t.Fatalf("Discover: %v", err)
+	}
+	if md.Issuer != mock.Issuer() || md.TokenEndpoint != mock.Issuer()+"/token" || md.JWKSURI != mock.Issuer()+"/jwks" {
+		t.Fatalf("unexpected metadata %+v", md)
+	}
+
+	// 文档中的 issuer 必须与配置完全一致
+	if _, err := oidc.Discover(ctx, http.DefaultClient, mock.Issuer()+"/"); err == nil {
+		t.Fatal("Discover accepted a document whose issuer differs from the configured one")
+	}
+	if _, err := oidc.Discover(ctx, http.DefaultClient, mock.Issuer()+"/missing"); err == nil {
+		t.Fatal("Discover accepted a missing discovery document")
+	}
+}
+
+func TestAuthorizationCodeFlowWithPKCE(t *testing.T) {
+	p, mock := newProvider(t)
+	ctx := context.Background()
+	req := newAuthRequest(t)
+
+	authURL, err := p.AuthCodeURL(ctx, req)
+	if err != nil {
+		t.Fatalf("AuthCodeURL: %v", err)
+	}
+	for _, want := range []string{
+		"code_challenge=" + oidc.CodeChallengeS256(req.Verifier),
+		"code_challenge_method=S256",
+		"scope=openid+email+profile",
+		"nonce=" + req.Nonce,
+	} {
+		if !strings.Contains(authURL, want) {
+			t.Fatalf("authorization URL %q does not contain %q", authURL, want)
+		}
+	}
+	if strings.Contains(authURL, req.Verifier) {
+		t.Fatal("authorization URL must not contain the code verifier")
+	}
+
+	code := authorize(t, p, mock, req)
+	token, err := p.Exchange(ctx, code, req.Verifier)
+	if err != nil {
+		t.Fatalf("Exchange: %v", err)
+	}
+	claims, err := p.VerifyIDToken(ctx, token.IDToken, req.Nonce)
+	if err != nil {
+		t.Fatalf("VerifyIDToken: %v", err)
+	}
+	if claims.Subject != "mock-subject" || claims.Email != "mock@example.com" {
+		t.Fatalf("unexpected claims %+v", claims)
+	}
+
+	// 授权码只能使用一次
+	if _, err := p.Exchange(ctx, code, req.Verifier); err == nil {
+		t.Fatal("Exchange accepted a reused code")
+	}
+}
+
+func TestExchangeRejectsWrongVerifier(t *testing.T) {
+	p, mock := newProvider(t)
+	req := newAuthRequest(t)
+	code := authorize(t, p, mock, req)
+
+	other, err := oidc.NewCodeVerifier()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.Exchange(context.Background(), code, other); err == nil {
+		t.Fatal("Exchange accepted a code verifier that does not match the challenge")
+	}
+}
+
+func TestVerifyIDTokenRejectsInvalidTokens(t *testing.T) {
+	p, mock := newProvider(t)
+	ctx := context.Background()
+	const nonce = "nonce"
+
+	sign := func(t *testing.T, claims *oidc.IDTokenClaims) string {
+		t.Helper()
+		raw, err := mock.SignIDToken(claims)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return raw
+	}
+
+	// 合法令牌先通过校验，确认下面的失败来自被修改的部分
+	if _, err := p.VerifyIDToken(ctx, sign(t, validClaims(mock, nonce)), nonce); err != nil {
+		t.Fatalf("VerifyIDToken rejected a valid token: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		raw  func(t *testing.T) string
+	}{
+		{"bad issuer", func(t *testing.T) string {
+			c := validClaims(mock, nonce)
+			c.Issuer = "https://attacker.example.com"
+			return sign(t, c)
+		}},
+		{"bad audience", func(t *testing.T) string {
+			c := validClaims(mock, nonce)
+			c.Audience = jwt.ClaimStrings{"other-client"}
+			return sign(t, c)
+		}},
+		{"multiple audiences without azp", func(t *testing.T) string {
+			c := validClaims(mock, nonce)
+			c.Audience = jwt.ClaimStrings{clientID, "other-client"}
+			return sign(t, c)
+		}},
+		{"bad nonce", func(t *testing.T) string {
+			return sign(t, validClaims(mock, "other-nonce"))
+		}},
+		{"expired", func(t *testing.T) string {
+			c := validClaims(mock, nonce)
+			c.IssuedAt = jwt.NewNumericDate(time.Now().Add(-time.Hour))
+			c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-10 * time.Minute))
+			return sign(t, c)
+		}},
+		{"missing expiry", func(t *testing.T) string {
+			c := validClaims(mock, nonce)
+			c.ExpiresAt = nil
+			return sign(t, c)
+		}},
+		{"missing subject", func(t *testing.T) string {
+			c := validClaims(mock, nonce)
+			c.Subject = ""
+			return sign(t, c)
+		}},
+		{"alg none", func(t *testing.T) string {
+			raw, err := jwt.NewWithClaims(jwt.SigningMethodNone, validClaims(mock, nonce)).
+				SignedString(jwt.UnsafeAllowNoneSignatureType)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return raw
+		}},
+		{"alg HS256", func(t *testing.T) string {
+			token := jwt.NewWithClaims(jwt.SigningMethodHS256, validClaims(mock, nonce))
+			token.Header["kid"] = "mock-key-1"
+			raw, err := token.SignedString([]byte(clientSecret))
+			if err != nil {
+				t.Fatal(err)
+			}
+			return raw
+		}},
+		{"tampered payload", func(t *testing.T) string {
+			parts := strings.Split(sign(t, validClaims(mock, nonce)), ".")
+			other := strings.Split(sign(t, &oidc.IDTokenClaims{
+				RegisteredClaims: validClaims(mock, nonce).RegisteredClaims,
+				Nonce:            nonce,
+				Email:            "admin@example.com",
+			}), ".")
+			return parts[0] + "." + other[1] + "." + parts[2]
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if claims, err := p.VerifyIDToken(ctx, tt.raw(t), nonce); err == nil {
+				t.Fatalf("VerifyIDToken accepted the token: %+v", claims)
+			}
+		})
+	}
+}
+
+func TestVerifyIDTokenFollowsKeyRotation(t *testing.T) {
+	p, mock := newProvider(t)
+	ctx := context.Background()
+	const nonce = "nonce"
+
+	oldToken, err := mock.SignIDToken(validClaims(mock, nonce))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.VerifyIDToken(ctx, oldToken, nonce); err != nil {
+		t.Fatalf("VerifyIDToken with the initial key: %v", err)
+	}
+
+	mock.RotateKey()
+	newToken, err := mock.SignIDToken(validClaims(mock, nonce))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 刚拉取过 JWKS 时，未知 kid 不触发重新拉取，防止被用来放大请求
+	if _, err := p.VerifyIDToken(ctx, newToken, nonce); err == nil {
+		t.Fatal("VerifyIDToken refreshed the key set within the minimum refresh interval")
+	}
+
+	oidc.AgeKeySet(p, 2*time.Minute)
+	if _, err := p.VerifyIDToken(ctx, newToken, nonce); err != nil {
+		t.Fatalf("VerifyIDToken after rotation: %v", err)
+	}
+	// 旧密钥已从 JWKS 中移除
+	if _, err := p.VerifyIDToken(ctx, oldToken, nonce); err == nil {
+		t.Fatal("VerifyIDToken accepted a token signed with a removed key")
+	}
+}
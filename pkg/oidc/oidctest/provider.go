@@ -0,0 +1,311 @@
+// Package oidctest 提供进程内的 OpenID Connect 模拟身份提供方，用于离线测试登录流程。
+//
+// 模拟身份提供方实现服务发现、JWKS、授权端点与令牌端点。授权端点不需要用户交互，
+// 直接以当前配置的用户身份签发授权码并重定向回 redirect_uri：
+//
+//	p := oidctest.NewProvider("client-id", "client-secret")
+//	defer p.Close()
+//	cfg := p.Config("http://localhost/auth/oidc/mock/callback")
+//	callback, _ := p.Authorize(authURL) // 即浏览器将访问的回调地址
+package oidctest
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"go-web/pkg/config"
+	"go-web/pkg/oidc"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// User 模拟身份提供方当前登录的用户
+type User struct {
+	Subject           string
+	Email             string
+	EmailVerified     bool
+	Name              string
+	PreferredUsername string
+}
+
+// authCode 已签发的授权码
+type authCode struct {
+	redirectURI string
+	challenge   string
+	nonce       string
+	user        User
+	expiresAt   time.Time
+}
+
+// Provider 进程内模拟身份提供方
+type Provider struct {
+	server       *httptest.Server
+	clientID     string
+	clientSecret string
+
+	mu    sync.Mutex
+	user  User
+	key   *rsa.PrivateKey
+	kid   string
+	keyN  int
+	codes map[string]*authCode
+	// IDTokenTTL 签发的 ID 令牌有效期
+	IDTokenTTL time.Duration
+}
+
+// NewProvider 启动模拟身份提供方，clientSecret 为空时作为公共客户端
+func NewProvider(clientID, clientSecret string) *Provider {
+	p := &Provider{
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		user: User{
+			Subject:           "mock-subject",
+			Email:             "mock@example.com",
+			EmailVerified:     true,
+			Name:              "Mock User",
+			PreferredUsername: "mock",
+		},
+		codes:      make(map[string]*authCode),
+		IDTokenTTL: 5 * time.Minute,
+	}
+	p.RotateKey()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", p.handleDiscovery)
+	mux.HandleFunc("/jwks", p.handleJWKS)
+	mux.HandleFunc("/authorize", p.handleAuthorize)
+	mux.HandleFunc("/token", p.handleToken)
+	p.server = httptest.NewServer(mux)
+
+	return p
+}
+
+// Close 关闭模拟身份提供方
+func (p *Provider) Close() {
+	p.server.Close()
+}
+
+// Issuer 签发者地址
+func (p *Provider) Issuer() string {
+	return p.server.URL
+}
+
+// Config 返回指向模拟身份提供方的配置
+func (p *Provider) Config(redirectURL string) config.OIDCProvider {
+	return config.OIDCProvider{
+		Issuer:       p.Issuer(),
+		ClientID:     p.clientID,
+		ClientSecret: p.clientSecret,
+		RedirectURL:  redirectURL,
+		Scopes:       []string{"email", "profile"},
+	}
+}
+
+// SetUser 设置后续授权请求使用的用户
+func (p *Provider) SetUser(u User) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.user = u
+}
+
+// RotateKey 生成新的签名密钥，旧密钥从 JWKS 中移除
+func (p *Provider) RotateKey() {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(fmt.Sprintf("oidctest: failed to generate key: %v", err))
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.keyN++
+	p.key = key
+	p.kid = "mock-key-" + strconv.Itoa(p.keyN)
+}
+
+// Authorize 模拟浏览器访问授权地址，返回身份提供方重定向的回调地址
+func (p *Provider) Authorize(authURL string) (*url.URL, error) {
+	client := &http.Client{
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	resp, err := client.Get(authURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusFound {
+		return nil, fmt.Errorf("oidctest: authorize returned status %d", resp.StatusCode)
+	}
+	return url.Parse(resp.Header.Get("Location"))
+}
+
+// SignIDToken 使用当前签名密钥签发任意声明的 ID 令牌，用于构造异常令牌
+func (p *Provider) SignIDToken(claims jwt.Claims) (string, error) {
+	p.mu.Lock()
+	key, kid := p.key, p.kid
+	p.mu.Unlock()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = kid
+	return token.SignedString(key)
+}
+
+// handleDiscovery 服务发现文档
+func (p *Provider) handleDiscovery(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, oidc.Metadata{
+		Issuer:                p.Issuer(),
+		AuthorizationEndpoint: p.Issuer() + "/authorize",
+		TokenEndpoint:         p.Issuer() + "/token",
+		JWKSURI:               p.Issuer() + "/jwks",
+		IDTokenSigningAlgs:    []string{"RS256"},
+		CodeChallengeMethods:  []string{"S256"},
+	})
+}
+
+// handleJWKS 当前签名公钥
+func (p *Provider) handleJWKS(w http.ResponseWriter, _ *http.Request) {
+	p.mu.Lock()
+	jwk := oidc.NewRSAJSONWebKey(p.kid, &p.key.PublicKey)
+	p.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, oidc.JSONWebKeySet{Keys: []oidc.JSONWebKey{jwk}})
+}
+
+// handleAuthorize 授权端点，直接以当前用户身份签发授权码
+func (p *Provider) handleAuthorize(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	redirectURI := q.Get("redirect_uri")
+	if q.Get("response_type") != "code" || q.Get("client_id") != p.clientID || redirectURI == "" {
+		http.Error(w, "invalid authorization request", http.StatusBadRequest)
+		return
+	}
+	if q.Get("code_challenge_method") != "S256" || q.Get("code_challenge") == "" {
+		http.Error(w, "pkce with S256 is required", http.StatusBadRequest)
+		return
+	}
+
+	code, err := oidc.NewState()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	p.mu.Lock()
+	p.codes[code] = &authCode{
+		redirectURI: redirectURI,
+		challenge:   q.Get("code_challenge"),
+		nonce:       q.Get("nonce"),
+		user:        p.user,
+		expiresAt:   time.Now().Add(time.Minute),
+	}
+	p.mu.Unlock()
+
+	target, err := url.Parse(redirectURI)
+	if err != nil {
+		http.Error(w, "invalid redirect_uri", http.StatusBadRequest)
+		return
+	}
+	params := target.Query()
+	params.Set("code", code)
+	params.Set("state", q.Get("state"))
+	target.RawQuery = params.Encode()
+	http.Redirect(w, r, target.String(), http.StatusFound)
+}
+
+// handleToken 令牌端点，校验授权码、redirect_uri、客户端凭证与 PKCE
+func (p *Provider) handleToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		tokenError(w, "invalid_request", "POST is required")
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		tokenError(w, "invalid_request", err.Error())
+		return
+	}
+	if r.PostForm.Get("grant_type") != "authorization_code" {
+		tokenError(w, "unsupported_grant_type", "")
+		return
+	}
+	if !p.authenticateClient(r) {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+		return
+	}
+
+	p.mu.Lock()
+	code, ok := p.codes[r.PostForm.Get("code")]
+	delete(p.codes, r.PostForm.Get("code"))
+	p.mu.Unlock()
+	if !ok || time.Now().After(code.expiresAt) || code.redirectURI != r.PostForm.Get("redirect_uri") {
+		tokenError(w, "invalid_grant", "unknown, expired or reused code")
+		return
+	}
+	sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	if subtle.ConstantTimeCompare([]byte(base64.RawURLEncoding.EncodeToString(sum[:])), []byte(code.challenge)) != 1 {
+		tokenError(w, "invalid_grant", "code_verifier does not match code_challenge")
+		return
+	}
+
+	now := time.Now()
+	idToken, err := p.SignIDToken(&oidc.IDTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    p.Issuer(),
+			Subject:   code.user.Subject,
+			Audience:  jwt.ClaimStrings{p.clientID},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(p.IDTokenTTL)),
+		},
+		Nonce:             code.nonce,
+		Email:             code.user.Email,
+		EmailVerified:     code.user.EmailVerified,
+		Name:              code.user.Name,
+		PreferredUsername: code.user.PreferredUsername,
+	})
+	if err != nil {
+		tokenError(w, "server_error", err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, oidc.Token{
+		AccessToken: "mock-access-token",
+		TokenType:   "Bearer",
+		IDToken:     idToken,
+		ExpiresIn:   int(p.IDTokenTTL.Seconds()),
+	})
+}
+
+// authenticateClient 校验 client_secret_basic 或公共客户端的 client_id
+func (p *Provider) authenticateClient(r *http.Request) bool {
+	if p.clientSecret == "" {
+		return r.PostForm.Get("client_id") == p.clientID
+	}
+	id, secret, ok := r.BasicAuth()
+	if !ok {
+		return false
+	}
+	id, _ = url.QueryUnescape(id)
+	secret, _ = url.QueryUnescape(secret)
+	return id == p.clientID && secret == p.clientSecret
+}
+
+// tokenError 令牌端点错误响应
+func tokenError(w http.ResponseWriter, code, description string) {
+	writeJSON(w, http.StatusBadRequest, map[string]string{"error": code, "error_description": description})
+}
+
+// writeJSON 写入 JSON 响应
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
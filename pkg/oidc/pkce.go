@@ -0,0 +1,38 @@
+package oidc
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+)
+
+// NewCodeVerifier 生成 PKCE code_verifier（RFC 7636，43 个字符）
+func NewCodeVerifier() (string, error) {
+	return randomString(32)
+}
+
+// CodeChallengeS256 计算 code_verifier 的 S256 code_challenge
+func CodeChallengeS256(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// NewState 生成授权请求的 state
+func NewState() (string, error) {
+	return randomString(32)
+}
+
+// NewNonce 生成 ID 令牌的 nonce
+func NewNonce() (string, error) {
+	return randomString(32)
+}
+
+// randomString 生成 n 字节随机数的 base64url 编码
+func randomString(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate random string: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
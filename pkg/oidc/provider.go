@@ -0,0 +1,173 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"go-web/pkg/config"
+)
+
+// Token 令牌端点的响应
+type Token struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	IDToken     string `json:"id_token"`
+	ExpiresIn   int    `json:"expires_in,omitempty"`
+}
+
+// tokenError 令牌端点的错误响应
+type tokenError struct {
+	Error       string `json:"error"`
+	Description string `json:"error_description"`
+}
+
+// AuthRequest 发起授权请求所需的参数
+type AuthRequest struct {
+	State    string
+	Nonce    string
+	Verifier string
+}
+
+// Provider 单个 OpenID Connect 身份提供方
+type Provider struct {
+	name   string
+	config config.OIDCProvider
+	client *http.Client
+
+	mu       sync.Mutex
+	metadata *Metadata
+	keys     *KeySet
+}
+
+// NewProvider 创建身份提供方
+func NewProvider(name string, cfg config.OIDCProvider, client *http.Client) *Provider {
+	return &Provider{
+		name:   name,
+		config: cfg,
+		client: client,
+	}
+}
+
+// Name 身份提供方名称
+func (p *Provider) Name() string {
+	return p.name
+}
+
+// Config 身份提供方配置
+func (p *Provider) Config() config.OIDCProvider {
+	return p.config
+}
+
+// discover 获取服务发现文档，成功后缓存，失败时下次重试
+func (p *Provider) discover(ctx context.Context) (*Metadata, *KeySet, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.metadata == nil {
+		md, err := Discover(ctx, p.client, p.config.Issuer)
+		if err != nil {
+			return nil, nil, err
+		}
+		p.metadata = md
+		p.keys = NewKeySet(p.client, md.JWKSURI)
+	}
+	return p.metadata, p.keys, nil
+}
+
+// AuthCodeURL 返回授权端点地址，使用 S256 PKCE
+func (p *Provider) AuthCodeURL(ctx context.Context, req AuthRequest) (string, error) {
+	md, _, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	scopes := []string{"openid"}
+	for _, s := range p.config.Scopes {
+		if s != "openid" {
+			scopes = append(scopes, s)
+		}
+	}
+
+	q := url.Values{}
+	q.Set("response_type", "code")
+	q.Set("client_id", p.config.ClientID)
+	q.Set("redirect_uri", p.config.RedirectURL)
+	q.Set("scope", strings.Join(scopes, " "))
+	q.Set("state", req.State)
+	q.Set("nonce", req.Nonce)
+	q.Set("code_challenge", CodeChallengeS256(req.Verifier))
+	q.Set("code_challenge_method", "S256")
+
+	sep := "?"
+	if strings.Contains(md.AuthorizationEndpoint, "?") {
+		sep = "&"
+	}
+	return md.AuthorizationEndpoint + sep + q.Encode(), nil
+}
+
+// Exchange 使用授权码和 code_verifier 换取令牌
+func (p *Provider) Exchange(ctx context.Context, code, verifier string) (*Token, error) {
+	md, _, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", p.config.RedirectURL)
+	form.Set("code_verifier", verifier)
+	if p.config.ClientSecret == "" {
+		form.Set("client_id", p.config.ClientID)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, md.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if p.config.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(p.config.ClientID), url.QueryEscape(p.config.ClientSecret))
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("oidc token exchange: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("oidc token exchange: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		var te tokenError
+		_ = json.Unmarshal(body, &te)
+		return nil, fmt.Errorf("oidc token exchange: status %d: %s %s", resp.StatusCode, te.Error, te.Description)
+	}
+
+	token := &Token{}
+	if err := json.Unmarshal(body, token); err != nil {
+		return nil, fmt.Errorf("oidc token exchange: %w", err)
+	}
+	if token.IDToken == "" {
+		return nil, fmt.Errorf("oidc token exchange: response has no id_token")
+	}
+	return token, nil
+}
+
+// VerifyIDToken 校验 ID 令牌的签名、签发者、受众、有效期与 nonce
+func (p *Provider) VerifyIDToken(ctx context.Context, raw, nonce string) (*IDTokenClaims, error) {
+	_, keys, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return NewVerifier(p.config.Issuer, p.config.ClientID, keys).Verify(ctx, raw, nonce)
+}
@@ -0,0 +1,80 @@
+package oidc
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// clockSkew 校验 ID 令牌时间时允许的时钟偏差
+const clockSkew = time.Minute
+
+// signingMethods 允许的 ID 令牌签名算法，拒绝 none 与 HMAC
+var signingMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+
+// IDTokenClaims ID 令牌声明
+type IDTokenClaims struct {
+	jwt.RegisteredClaims
+	// 防重放随机数，必须与授权请求一致
+	Nonce string `json:"nonce,omitempty"`
+	// 授权方，多受众时必须为本客户端
+	AuthorizedParty string `json:"azp,omitempty"`
+	// 邮箱
+	Email string `json:"email,omitempty"`
+	// 邮箱是否已验证
+	EmailVerified bool `json:"email_verified,omitempty"`
+	// 姓名
+	Name string `json:"name,omitempty"`
+	// 用户名
+	PreferredUsername string `json:"preferred_username,omitempty"`
+}
+
+// Verifier ID 令牌校验器
+type Verifier struct {
+	issuer   string
+	clientID string
+	keys     *KeySet
+}
+
+// NewVerifier 创建 ID 令牌校验器
+func NewVerifier(issuer, clientID string, keys *KeySet) *Verifier {
+	return &Verifier{
+		issuer:   issuer,
+		clientID: clientID,
+		keys:     keys,
+	}
+}
+
+// Verify 校验 ID 令牌并返回声明
+func (v *Verifier) Verify(ctx context.Context, raw, nonce string) (*IDTokenClaims, error) {
+	claims := &IDTokenClaims{}
+	parser := jwt.NewParser(
+		jwt.WithValidMethods(signingMethods),
+		jwt.WithIssuer(v.issuer),
+		jwt.WithAudience(v.clientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(clockSkew),
+	)
+	_, err := parser.ParseWithClaims(raw, claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		return v.keys.Key(ctx, kid)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid id token: %w", err)
+	}
+
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("invalid id token: missing subject")
+	}
+	if len(claims.Audience) > 1 && claims.AuthorizedParty != v.clientID {
+		return nil, fmt.Errorf("invalid id token: azp does not match client")
+	}
+	if subtle.ConstantTimeCompare([]byte(claims.Nonce), []byte(nonce)) != 1 {
+		return nil, fmt.Errorf("invalid id token: nonce mismatch")
+	}
+	return claims, nil
+}
@@ -0,0 +1,150 @@
+package password
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// Argon2Params argon2id 参数
+type Argon2Params struct {
+	// 内存开销 (KiB)
+	Memory uint32
+	// 迭代次数
+	Iterations uint32
+	// 并行度
+	Parallelism uint8
+	// 盐长度
+	SaltLength uint32
+	// 哈希长度
+	KeyLength uint32
+}
+
+// DefaultArgon2Params 返回默认的 argon2id 参数
+func DefaultArgon2Params() Argon2Params {
+	return Argon2Params{
+		Memory:      64 * 1024,
+		Iterations:  3,
+		Parallelism: 2,
+		SaltLength:  16,
+		KeyLength:   32,
+	}
+}
+
+// Argon2idHasher argon2id 哈希算法，编码格式为
+// $argon2id$v=19$m=65536,t=3,p=2$<salt>$<hash>
+type Argon2idHasher struct {
+	params Argon2Params
+}
+
+var _ Hasher = (*Argon2idHasher)(nil)
+
+// NewArgon2idHasher 创建 argon2id 哈希算法，零值参数使用默认值
+func NewArgon2idHasher(params Argon2Params) *Argon2idHasher {
+	def := DefaultArgon2Params()
+	if params.Memory == 0 {
+		params.Memory = def.Memory
+	}
+	if params.Iterations == 0 {
+		params.Iterations = def.Iterations
+	}
+	if params.Parallelism == 0 {
+		params.Parallelism = def.Parallelism
+	}
+	if params.SaltLength == 0 {
+		params.SaltLength = def.SaltLength
+	}
+	if params.KeyLength == 0 {
+		params.KeyLength = def.KeyLength
+	}
+	return &Argon2idHasher{params: params}
+}
+
+// Name 算法名称
+func (h *Argon2idHasher) Name() string {
+	return AlgorithmArgon2id
+}
+
+// Hash 生成编码后的哈希
+func (h *Argon2idHasher) Hash(password string) (string, error) {
+	salt := make([]byte, h.params.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("failed to generate salt: %w", err)
+	}
+	key := argon2.IDKey([]byte(password), salt, h.params.Iterations, h.params.Memory, h.params.Parallelism, h.params.KeyLength)
+
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version,
+		h.params.Memory,
+		h.params.Iterations,
+		h.params.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+// Verify 校验明文密码与编码哈希是否匹配
+func (h *Argon2idHasher) Verify(password, encoded string) (bool, error) {
+	params, salt, key, err := decodeArgon2id(encoded)
+	if err != nil {
+		return false, err
+	}
+	other := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Parallelism, uint32(len(key)))
+	return subtle.ConstantTimeCompare(key, other) == 1, nil
+}
+
+// Match 判断编码哈希是否由该算法生成
+func (h *Argon2idHasher) Match(encoded string) bool {
+	return strings.HasPrefix(encoded, "$argon2id$")
+}
+
+// NeedsRehash 判断编码哈希的参数是否弱于当前配置
+func (h *Argon2idHasher) NeedsRehash(encoded string) bool {
+	params, salt, key, err := decodeArgon2id(encoded)
+	if err != nil {
+		return true
+	}
+	return params.Memory < h.params.Memory ||
+		params.Iterations < h.params.Iterations ||
+		params.Parallelism < h.params.Parallelism ||
+		uint32(len(salt)) < h.params.SaltLength ||
+		uint32(len(key)) < h.params.KeyLength
+}
+
+// decodeArgon2id 解析 argon2id 编码哈希
+func decodeArgon2id(encoded string) (Argon2Params, []byte, []byte, error) {
+	var params Argon2Params
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 || parts[1] != AlgorithmArgon2id {
+		return params, nil, nil, fmt.Errorf("invalid argon2id hash format")
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return params, nil, nil, fmt.Errorf("invalid argon2id version: %w", err)
+	}
+	if version != argon2.Version {
+		return params, nil, nil, fmt.Errorf("unsupported argon2id version %d", version)
+	}
+
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism); err != nil {
+		return params, nil, nil, fmt.Errorf("invalid argon2id params: %w", err)
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return params, nil, nil, fmt.Errorf("invalid argon2id salt: %w", err)
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return params, nil, nil, fmt.Errorf("invalid argon2id key: %w", err)
+	}
+	params.SaltLength = uint32(len(salt))
+	params.KeyLength = uint32(len(key))
+
+	return params, salt, key, nil
+}
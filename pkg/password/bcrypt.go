@@ -0,0 +1,69 @@
+package password
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// DefaultBcryptCost 默认 bcrypt 计算成本
+const DefaultBcryptCost = 12
+
+// BcryptHasher bcrypt 哈希算法，编码格式为 $2a$<cost>$<salt+hash>
+type BcryptHasher struct {
+	cost int
+}
+
+var _ Hasher = (*BcryptHasher)(nil)
+
+// NewBcryptHasher 创建 bcrypt 哈希算法，非法 cost 使用默认值
+func NewBcryptHasher(cost int) *BcryptHasher {
+	if cost < bcrypt.MinCost || cost > bcrypt.MaxCost {
+		cost = DefaultBcryptCost
+	}
+	return &BcryptHasher{cost: cost}
+}
+
+// Name 算法名称
+func (h *BcryptHasher) Name() string {
+	return AlgorithmBcrypt
+}
+
+// Hash 生成编码后的哈希
+func (h *BcryptHasher) Hash(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), h.cost)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash password: %w", err)
+	}
+	return string(hash), nil
+}
+
+// Verify 校验明文密码与编码哈希是否匹配
+func (h *BcryptHasher) Verify(password, encoded string) (bool, error) {
+	err := bcrypt.CompareHashAndPassword([]byte(encoded), []byte(password))
+	if err == nil {
+		return true, nil
+	}
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return false, nil
+	}
+	return false, err
+}
+
+// Match 判断编码哈希是否由该算法生成
+func (h *BcryptHasher) Match(encoded string) bool {
+	return strings.HasPrefix(encoded, "$2a$") ||
+		strings.HasPrefix(encoded, "$2b$") ||
+		strings.HasPrefix(encoded, "$2y$")
+}
+
+// NeedsRehash 判断编码哈希的参数是否弱于当前配置
+func (h *BcryptHasher) NeedsRehash(encoded string) bool {
+	cost, err := bcrypt.Cost([]byte(encoded))
+	if err != nil {
+		return true
+	}
+	return cost < h.cost
+}
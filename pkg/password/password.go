@@ -0,0 +1,142 @@
+package password
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"go-web/pkg/config"
+)
+
+const (
+	// AlgorithmArgon2id argon2id 算法名
+	AlgorithmArgon2id = "argon2id"
+	// AlgorithmBcrypt bcrypt 算法名
+	AlgorithmBcrypt = "bcrypt"
+)
+
+// ErrEmptyPassword 密码为空
+var ErrEmptyPassword = errors.New("password cannot be empty")
+
+// Hasher 密码哈希算法接口，哈希结果中编码了算法参数
+type Hasher interface {
+	// Name 算法名称
+	Name() string
+	// Hash 生成编码后的哈希
+	Hash(password string) (string, error)
+	// Verify 校验明文密码与编码哈希是否匹配
+	Verify(password, encoded string) (bool, error)
+	// Match 判断编码哈希是否由该算法生成
+	Match(encoded string) bool
+	// NeedsRehash 判断编码哈希的参数是否弱于当前配置
+	NeedsRehash(encoded string) bool
+}
+
+// Service 密码哈希服务，使用默认算法生成哈希并兼容校验其它已注册算法
+type Service struct {
+	current Hasher
+	hashers []Hasher
+}
+
+var (
+	mu       sync.RWMutex
+	defaultS = NewService(NewArgon2idHasher(DefaultArgon2Params()), NewBcryptHasher(DefaultBcryptCost))
+)
+
+// NewService 创建密码哈希服务，current 为新密码使用的算法，others 仅用于校验存量哈希
+func NewService(current Hasher, others ...Hasher) *Service {
+	hashers := []Hasher{current}
+	for _, h := range others {
+		if h.Name() != current.Name() {
+			hashers = append(hashers, h)
+		}
+	}
+	return &Service{current: current, hashers: hashers}
+}
+
+// NewServiceFromConfig 根据配置创建密码哈希服务
+func NewServiceFromConfig(cfg *config.Config) (*Service, error) {
+	pc := cfg.Password
+	argon2id := NewArgon2idHasher(Argon2Params{
+		Memory:      pc.Argon2.Memory,
+		Iterations:  pc.Argon2.Iterations,
+		Parallelism: pc.Argon2.Parallelism,
+		SaltLength:  pc.Argon2.SaltLength,
+		KeyLength:   pc.Argon2.KeyLength,
+	})
+	bcrypt := NewBcryptHasher(pc.Bcrypt.Cost)
+
+	switch strings.ToLower(pc.Algorithm) {
+	case "", AlgorithmArgon2id:
+		return NewService(argon2id, bcrypt), nil
+	case AlgorithmBcrypt:
+		return NewService(bcrypt, argon2id), nil
+	default:
+		return nil, fmt.Errorf("unsupported password algorithm %q", pc.Algorithm)
+	}
+}
+
+// Setup 根据配置初始化全局默认密码哈希服务
+func Setup(cfg *config.Config) error {
+	s, err := NewServiceFromConfig(cfg)
+	if err != nil {
+		return err
+	}
+	SetDefault(s)
+	return nil
+}
+
+// Default 返回全局默认密码哈希服务
+func Default() *Service {
+	mu.RLock()
+	defer mu.RUnlock()
+	return defaultS
+}
+
+// SetDefault 替换全局默认密码哈希服务
+func SetDefault(s *Service) {
+	mu.Lock()
+	defer mu.Unlock()
+	defaultS = s
+}
+
+// Hash 使用当前算法生成密码哈希
+func (s *Service) Hash(password string) (string, error) {
+	if password == "" {
+		return "", ErrEmptyPassword
+	}
+	return s.current.Hash(password)
+}
+
+// IsHashed 判断字符串是否为已注册算法生成的哈希
+func (s *Service) IsHashed(encoded string) bool {
+	return s.lookup(encoded) != nil
+}
+
+// Verify 校验密码，rehash 表示校验成功且存储值需要按当前算法重新生成。
+// 无法识别的存储值视为历史明文密码。
+func (s *Service) Verify(password, encoded string) (ok bool, rehash bool, err error) {
+	h := s.lookup(encoded)
+	if h == nil {
+		ok = subtle.ConstantTimeCompare([]byte(password), []byte(encoded)) == 1
+		return ok, ok, nil
+	}
+
+	ok, err = h.Verify(password, encoded)
+	if err != nil || !ok {
+		return false, false, err
+	}
+	return true, h.Name() != s.current.Name() || h.NeedsRehash(encoded), nil
+}
+
+// lookup 查找生成该哈希的算法
+func (s *Service) lookup(encoded string) Hasher {
+	for _, h := range s.hashers {
+		if h.Match(encoded) {
+			return h
+		}
+	}
+	return nil
+}
@@ -0,0 +1,17 @@
+// Package requestid 在 context 中传递请求 ID，供日志、审计等无法访问 gin.Context 的代码使用。
+package requestid
+
+import "context"
+
+type ctxKey struct{}
+
+// NewContext 返回携带请求 ID 的 context
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, ctxKey{}, id)
+}
+
+// FromContext 从 context 获取请求 ID，不存在时返回空字符串
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(ctxKey{}).(string)
+	return id
+}
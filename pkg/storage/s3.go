@@ -0,0 +1,180 @@
+package storage
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// S3Options S3 兼容对象存储的连接参数
+type S3Options struct {
+	// 服务地址，例如 https://s3.us-east-1.amazonaws.com 或 http://localhost:9000
+	Endpoint string
+	// 区域
+	Region string
+	// 存储桶
+	Bucket string
+	// 访问密钥 ID
+	AccessKeyID string
+	// 访问密钥
+	SecretAccessKey string
+	// 使用路径形式的地址（endpoint/bucket/key），否则使用虚拟主机形式（bucket.endpoint/key）
+	UsePathStyle bool
+	// 单个请求的超时时间，包括读取对象内容，为 0 时使用 defaultS3Timeout
+	Timeout time.Duration
+}
+
+// defaultS3Timeout 未配置时单个请求的超时时间
+const defaultS3Timeout = time.Minute
+
+// S3Storage 将对象保存到 S3 兼容的对象存储，请求使用 AWS Signature Version 4 签名。
+// 只使用 PutObject、GetObject 与 DeleteObject 三个接口，MinIO 等兼容服务可以直接替代 S3。
+type S3Storage struct {
+	endpoint *url.URL
+	opts     S3Options
+	client   *http.Client
+	logger   *zap.Logger
+}
+
+// NewS3Storage 创建 S3 存储，不检查存储桶是否存在
+func NewS3Storage(opts S3Options, logger *zap.Logger) (*S3Storage, error) {
+	u, err := url.Parse(opts.Endpoint)
+	if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		return nil, fmt.Errorf("invalid s3 endpoint %q", opts.Endpoint)
+	}
+	if opts.Region == "" || opts.Bucket == "" || opts.AccessKeyID == "" || opts.SecretAccessKey == "" {
+		return nil, fmt.Errorf("s3 region, bucket and credentials are required")
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = defaultS3Timeout
+	}
+	return &S3Storage{
+		endpoint: u,
+		opts:     opts,
+		client:   &http.Client{Timeout: opts.Timeout},
+		logger:   logger.With(zap.String("component", "s3_storage")),
+	}, nil
+}
+
+// Put 写入对象，内容不参与签名（UNSIGNED-PAYLOAD），无需预先读取整个文件计算哈希
+func (s *S3Storage) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	req, err := s.newRequest(ctx, http.MethodPut, key, r)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	if size == 0 {
+		req.Body = http.NoBody
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	resp, err := s.do(req, unsignedPayload)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return s.responseError(resp, "put", key)
+	}
+	return nil
+}
+
+// Get 读取对象，调用方负责关闭返回的内容
+func (s *S3Storage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	req, err := s.newRequest(ctx, http.MethodGet, key, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.do(req, emptyPayloadHash)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return resp.Body, nil
+	case http.StatusNotFound:
+		resp.Body.Close()
+		return nil, ErrNotExist
+	default:
+		defer resp.Body.Close()
+		return nil, s.responseError(resp, "get", key)
+	}
+}
+
+// Delete 删除对象，S3 删除不存在的对象时同样返回成功
+func (s *S3Storage) Delete(ctx context.Context, key string) error {
+	req, err := s.newRequest(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := s.do(req, emptyPayloadHash)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusNoContent, http.StatusNotFound:
+		return nil
+	default:
+		return s.responseError(resp, "delete", key)
+	}
+}
+
+// newRequest 创建对象请求。对象路径只包含无需转义的字符，直接拼接即为签名使用的规范路径
+func (s *S3Storage) newRequest(ctx context.Context, method, key string, body io.Reader) (*http.Request, error) {
+	if err := validateKey(key); err != nil {
+		return nil, err
+	}
+	u := *s.endpoint
+	base := strings.TrimSuffix(u.Path, "/")
+	if s.opts.UsePathStyle {
+		u.Path = base + "/" + s.opts.Bucket + "/" + key
+	} else {
+		u.Host = s.opts.Bucket + "." + u.Host
+		u.Path = base + "/" + key
+	}
+	return http.NewRequestWithContext(ctx, method, u.String(), body)
+}
+
+// do 签名并发送请求
+func (s *S3Storage) do(req *http.Request, payloadHash string) (*http.Response, error) {
+	signV4(req, payloadHash, credentials{
+		accessKeyID:     s.opts.AccessKeyID,
+		secretAccessKey: s.opts.SecretAccessKey,
+		region:          s.opts.Region,
+		service:         "s3",
+	}, time.Now())
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("s3 request failed: %w", err)
+	}
+	return resp, nil
+}
+
+// responseError 解析 S3 的 XML 错误响应
+func (s *S3Storage) responseError(resp *http.Response, op, key string) error {
+	var body struct {
+		Code    string `xml:"Code"`
+		Message string `xml:"Message"`
+	}
+	_ = xml.NewDecoder(io.LimitReader(resp.Body, 64<<10)).Decode(&body)
+	s.logger.Warn("s3 request failed",
+		zap.String("op", op),
+		zap.String("key", key),
+		zap.Int("status", resp.StatusCode),
+		zap.String("code", body.Code),
+		zap.String("message", body.Message),
+	)
+	if body.Code != "" {
+		return fmt.Errorf("s3 %s %s: %s: %s", op, key, resp.Status, body.Code)
+	}
+	return fmt.Errorf("s3 %s %s: %s", op, key, resp.Status)
+}
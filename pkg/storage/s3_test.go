@@ -0,0 +1,242 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// fakeS3 进程内的 S3 替身，保存对象并记录收到的请求
+type fakeS3 struct {
+	server *httptest.Server
+
+	mu       sync.Mutex
+	objects  map[string]fakeObject
+	requests []*http.Request
+	// fail 不为空时所有请求返回该 S3 错误码
+	fail string
+}
+
+type fakeObject struct {
+	data        []byte
+	contentType string
+}
+
+func newFakeS3(t *testing.T) *fakeS3 {
+	t.Helper()
+	f := &fakeS3{objects: make(map[string]fakeObject)}
+	f.server = httptest.NewServer(http.HandlerFunc(f.serve))
+	t.Cleanup(f.server.Close)
+	return f
+}
+
+func (f *fakeS3) serve(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.requests = append(f.requests, r.Clone(context.Background()))
+
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AKID/") {
+		writeS3Error(w, http.StatusForbidden, "AccessDenied", "missing signature")
+		return
+	}
+	if f.fail != "" {
+		writeS3Error(w, http.StatusInternalServerError, f.fail, "simulated failure")
+		return
+	}
+
+	// 路径形式与虚拟主机形式都以 bucket/key 作为对象名
+	name := strings.TrimPrefix(r.URL.Path, "/")
+	if host, _, _ := net.SplitHostPort(r.Host); strings.HasPrefix(host, "bucket.") {
+		name = "bucket/" + name
+	}
+	switch r.Method {
+	case http.MethodPut:
+		data, _ := io.ReadAll(r.Body)
+		f.objects[name] = fakeObject{data: data, contentType: r.Header.Get("Content-Type")}
+	case http.MethodGet:
+		obj, ok := f.objects[name]
+		if !ok {
+			writeS3Error(w, http.StatusNotFound, "NoSuchKey", "The specified key does not exist.")
+			return
+		}
+		w.Header().Set("Content-Type", obj.contentType)
+		_, _ = w.Write(obj.data)
+	case http.MethodDelete:
+		delete(f.objects, name)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func writeS3Error(w http.ResponseWriter, status int, code, message string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	_, _ = io.WriteString(w, `<?xml version="1.0" encoding="UTF-8"?><Error><Code>`+code+`</Code><Message>`+message+`</Message></Error>`)
+}
+
+func (f *fakeS3) lastRequest() *http.Request {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.requests[len(f.requests)-1]
+}
+
+func newTestS3Storage(t *testing.T, f *fakeS3, pathStyle bool) *S3Storage {
+	t.Helper()
+	s, err := NewS3Storage(S3Options{
+		Endpoint:        f.server.URL,
+		Region:          "us-east-1",
+		Bucket:          "bucket",
+		AccessKeyID:     "AKID",
+		SecretAccessKey: "secret",
+		UsePathStyle:    pathStyle,
+	}, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 虚拟主机形式的地址无法解析，所有连接都发往替身
+	addr := f.server.Listener.Addr().String()
+	s.client.Transport = &http.Transport{
+		DialContext: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, network, addr)
+		},
+	}
+	return s
+}
+
+func TestS3PutGetDelete(t *testing.T) {
+	for _, pathStyle := range []bool{true, false} {
+		name := "virtual host"
+		if pathStyle {
+			name = "path style"
+		}
+		t.Run(name, func(t *testing.T) {
+			f := newFakeS3(t)
+			s := newTestS3Storage(t, f, pathStyle)
+			ctx := context.Background()
+			content := []byte("\x89PNG avatar")
+
+			if err := s.Put(ctx, "files/1", bytes.NewReader(content), int64(len(content)), "image/png"); err != nil {
+				t.Fatalf("Put: %v", err)
+			}
+			put := f.lastRequest()
+			if put.Header.Get("X-Amz-Content-Sha256") != unsignedPayload || put.ContentLength != int64(len(content)) {
+				t.Fatalf("unexpected put request headers %v, length %d", put.Header, put.ContentLength)
+			}
+			if pathStyle && put.URL.Path != "/bucket/files/1" {
+				t.Fatalf("path style request path = %s", put.URL.Path)
+			}
+			if !pathStyle && (put.URL.Path != "/files/1" || !strings.HasPrefix(put.Host, "bucket.")) {
+				t.Fatalf("virtual host request = %s%s", put.Host, put.URL.Path)
+			}
+			if obj := f.objects["bucket/files/1"]; !bytes.Equal(obj.data, content) || obj.contentType != "image/png" {
+				t.Fatalf("stored object = %+v", obj)
+			}
+
+			rc, err := s.Get(ctx, "files/1")
+			if err != nil {
+				t.Fatalf("Get: %v", err)
+			}
+			got, _ := io.ReadAll(rc)
+			rc.Close()
+			if !bytes.Equal(got, content) {
+				t.Fatalf("Get = %q, want %q", got, content)
+			}
+
+			if err := s.Delete(ctx, "files/1"); err != nil {
+				t.Fatalf("Delete: %v", err)
+			}
+			if _, err := s.Get(ctx, "files/1"); !errors.Is(err, ErrNotExist) {
+				t.Fatalf("Get after Delete: err = %v, want ErrNotExist", err)
+			}
+			// 删除不存在的对象同样成功
+			if err := s.Delete(ctx, "files/1"); err != nil {
+				t.Fatalf("Delete missing object: %v", err)
+			}
+		})
+	}
+}
+
+func TestS3ErrorResponse(t *testing.T) {
+	f := newFakeS3(t)
+	s := newTestS3Storage(t, f, true)
+	f.fail = "SlowDown"
+	ctx := context.Background()
+
+	err := s.Put(ctx, "files/1", strings.NewReader("x"), 1, "text/plain")
+	if err == nil || !strings.Contains(err.Error(), "SlowDown") || !strings.Contains(err.Error(), "500") {
+		t.Fatalf("Put error = %v, want the S3 error code and status", err)
+	}
+	if _, err := s.Get(ctx, "files/1"); err == nil || errors.Is(err, ErrNotExist) || !strings.Contains(err.Error(), "SlowDown") {
+		t.Fatalf("Get error = %v, want the S3 error code", err)
+	}
+	if err := s.Delete(ctx, "files/1"); err == nil || !strings.Contains(err.Error(), "SlowDown") {
+		t.Fatalf("Delete error = %v, want the S3 error code", err)
+	}
+}
+
+func TestS3RejectsInvalidKeys(t *testing.T) {
+	f := newFakeS3(t)
+	s := newTestS3Storage(t, f, true)
+
+	for _, key := range []string{"", "../secret", "files/../../secret", "files/a b"} {
+		if err := s.Put(context.Background(), key, strings.NewReader("x"), 1, ""); err == nil {
+			t.Fatalf("Put accepted key %q", key)
+		}
+	}
+	if len(f.requests) != 0 {
+		t.Fatalf("invalid keys reached the server: %d requests", len(f.requests))
+	}
+}
+
+func TestS3Timeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) { <-release }))
+	defer server.Close()
+	defer close(release)
+
+	s, err := NewS3Storage(S3Options{
+		Endpoint:        server.URL,
+		Region:          "us-east-1",
+		Bucket:          "bucket",
+		AccessKeyID:     "AKID",
+		SecretAccessKey: "secret",
+		UsePathStyle:    true,
+		Timeout:         50 * time.Millisecond,
+	}, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	if _, err := s.Get(context.Background(), "files/1"); err == nil {
+		t.Fatal("Get succeeded against a server that never responds")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("Get took %s, want the configured timeout", elapsed)
+	}
+}
+
+func TestNewS3StorageDefaultTimeout(t *testing.T) {
+	s, err := NewS3Storage(S3Options{
+		Endpoint:        "http://localhost:9000",
+		Region:          "us-east-1",
+		Bucket:          "bucket",
+		AccessKeyID:     "AKID",
+		SecretAccessKey: "secret",
+	}, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.client.Timeout != defaultS3Timeout {
+		t.Fatalf("client timeout = %s, want %s", s.client.Timeout, defaultS3Timeout)
+	}
+}
@@ -0,0 +1,69 @@
+// Package storage 保存上传的文件。
+// 默认保存在本地目录，只适合单实例部署；部署多个实例时使用 S3 兼容的对象存储，例如 AWS S3 或 MinIO。
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"go-web/pkg/config"
+
+	"github.com/google/wire"
+	"go.uber.org/zap"
+)
+
+var ProviderSet = wire.NewSet(NewStorage)
+
+// ErrNotExist 对象不存在
+var ErrNotExist = errors.New("storage: object does not exist")
+
+// keyPattern 对象路径只允许字母、数字与 . _ -，以 / 分隔
+var keyPattern = regexp.MustCompile(`^[A-Za-z0-9._-]+(/[A-Za-z0-9._-]+)*$`)
+
+// Storage 文件存储接口。key 为对象路径，由调用方生成，不能直接使用用户提供的文件名。
+type Storage interface {
+	// Put 写入对象，已存在时覆盖。size 为内容长度，读取的字节数与 size 不一致时返回错误
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	// Get 读取对象，不存在时返回 ErrNotExist
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete 删除对象，不存在时不返回错误
+	Delete(ctx context.Context, key string) error
+}
+
+// NewStorage 根据配置创建文件存储
+func NewStorage(cfg *config.Config, logger *zap.Logger) (Storage, error) {
+	sc := cfg.Storage
+	switch sc.Driver {
+	case "local":
+		return NewLocalStorage(sc.Local.Dir)
+	case "s3":
+		return NewS3Storage(S3Options{
+			Endpoint:        sc.S3.Endpoint,
+			Region:          sc.S3.Region,
+			Bucket:          sc.S3.Bucket,
+			AccessKeyID:     sc.S3.AccessKeyID,
+			SecretAccessKey: sc.S3.SecretAccessKey,
+			UsePathStyle:    sc.S3.UsePathStyle,
+			Timeout:         sc.S3.Timeout,
+		}, logger)
+	default:
+		return nil, fmt.Errorf("unsupported storage driver %q", sc.Driver)
+	}
+}
+
+// validateKey 校验对象路径，拒绝 . 与 .. 等可能越出存储目录的路径
+func validateKey(key string) error {
+	if !keyPattern.MatchString(key) {
+		return fmt.Errorf("storage: invalid key %q", key)
+	}
+	for _, seg := range strings.Split(key, "/") {
+		if seg == "." || seg == ".." {
+			return fmt.Errorf("storage: invalid key %q", key)
+		}
+	}
+	return nil
+}
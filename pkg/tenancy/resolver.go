@@ -0,0 +1,69 @@
+package tenancy
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go-web/ent"
+	"go-web/ent/tenant"
+	"go-web/pkg/config"
+	goWebErrors "go-web/pkg/errors"
+	"go-web/pkg/viewer"
+
+	"github.com/google/wire"
+)
+
+var ProviderSet = wire.NewSet(NewResolver)
+
+// cachedTenant 缓存的租户 ID
+type cachedTenant struct {
+	id        uint64
+	expiresAt time.Time
+}
+
+// Resolver 根据租户标识查找租户 ID，结果在本地缓存一段时间，避免每个请求都查询数据库
+type Resolver struct {
+	client *ent.Client
+	ttl    time.Duration
+
+	mu    sync.RWMutex
+	cache map[string]cachedTenant
+}
+
+// NewResolver 创建租户解析器
+func NewResolver(cfg *config.Config, client *ent.Client) *Resolver {
+	return &Resolver{
+		client: client,
+		ttl:    cfg.Tenant.CacheTTL,
+		cache:  make(map[string]cachedTenant),
+	}
+}
+
+// Resolve 返回租户标识对应的租户 ID，租户不存在时返回 ErrNotFound
+func (r *Resolver) Resolve(ctx context.Context, slug string) (uint64, error) {
+	now := time.Now()
+	r.mu.RLock()
+	c, ok := r.cache[slug]
+	r.mu.RUnlock()
+	if ok && now.Before(c.expiresAt) {
+		return c.id, nil
+	}
+
+	// 解析租户时访问者与租户尚未确定，以系统身份查询
+	id, err := r.client.Tenant.Query().
+		Where(tenant.Slug(slug)).
+		OnlyID(viewer.NewSystemContext(ctx))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return 0, goWebErrors.New(goWebErrors.ErrNotFound, "not_found", fmt.Sprintf("tenant %q not found", slug))
+		}
+		return 0, fmt.Errorf("failed to query tenant: %w", err)
+	}
+
+	r.mu.Lock()
+	r.cache[slug] = cachedTenant{id: id, expiresAt: now.Add(r.ttl)}
+	r.mu.Unlock()
+	return id, nil
+}
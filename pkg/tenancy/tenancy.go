@@ -0,0 +1,21 @@
+// Package tenancy 在 context 中传递当前请求所属的租户。
+// 租户由 HTTP 中间件解析后写入，ent 的 TenantMixin 据此限定所有查询与变更的范围。
+package tenancy
+
+import "context"
+
+// DefaultSlug 默认租户标识，迁移时创建，存量数据都归属该租户
+const DefaultSlug = "default"
+
+type ctxKey struct{}
+
+// NewContext 返回携带租户 ID 的 context
+func NewContext(ctx context.Context, tenantID uint64) context.Context {
+	return context.WithValue(ctx, ctxKey{}, tenantID)
+}
+
+// FromContext 从 context 获取租户 ID
+func FromContext(ctx context.Context) (uint64, bool) {
+	id, ok := ctx.Value(ctxKey{}).(uint64)
+	return id, ok
+}
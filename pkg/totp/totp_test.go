@@ -0,0 +1,67 @@
+package totp
+
+import (
+	"testing"
+	"time"
+)
+
+// rfcSecret RFC 6238 附录 B 中 SHA1 测试使用的密钥 "12345678901234567890"
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestCodeRFC6238(t *testing.T) {
+	// RFC 6238 附录 B 的 8 位验证码取后 6 位
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+	for _, tt := range tests {
+		got, err := Code(rfcSecret, Step(time.Unix(tt.unix, 0)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Fatalf("Code at %d = %s, want %s", tt.unix, got, tt.want)
+		}
+	}
+}
+
+func TestValidateSkew(t *testing.T) {
+	now := time.Unix(1234567890, 0)
+	current := Step(now)
+	tests := []struct {
+		step uint64
+		ok   bool
+	}{
+		{current - 2, false},
+		{current - 1, true},
+		{current, true},
+		{current + 1, true},
+		{current + 2, false},
+	}
+	for _, tt := range tests {
+		code, err := Code(rfcSecret, tt.step)
+		if err != nil {
+			t.Fatal(err)
+		}
+		step, ok := Validate(rfcSecret, code, now)
+		if ok != tt.ok || (ok && step != tt.step) {
+			t.Fatalf("code of step %+d: Validate = (%d, %v), want ok=%v", int64(tt.step-current), step, ok, tt.ok)
+		}
+	}
+
+	for _, code := range []string{"", "00592", "0059240", "abcdef"} {
+		if _, ok := Validate(rfcSecret, code, now); ok {
+			t.Fatalf("Validate(%q) accepted a malformed code", code)
+		}
+	}
+	if _, ok := Validate("not base32!", "005924", now); ok {
+		t.Fatal("Validate accepted an invalid secret")
+	}
+}
@@ -1,12 +1,17 @@
 package uid
 
 import (
+	"os"
+	"strconv"
 	"sync"
 	"time"
 
 	"github.com/sony/sonyflake"
 )
 
+// machineIDEnv 机器 ID 环境变量，未设置时使用私有 IP 地址的低 16 位，没有私有 IP 的环境（容器、测试）需要设置
+const machineIDEnv = "SONYFLAKE_MACHINE_ID"
+
 var (
 	startTime = time.Unix(1658709116, 0)
 	once      sync.Once
@@ -25,6 +30,12 @@ func NewUniqueIDGenerator() *UniqueIDGenerator {
 	once.Do(func() {
 		var st sonyflake.Settings
 		st.StartTime = startTime
+		if v := os.Getenv(machineIDEnv); v != "" {
+			st.MachineID = func() (uint16, error) {
+				id, err := strconv.ParseUint(v, 10, 16)
+				return uint16(id), err
+			}
+		}
 		uniqueIDGenerator = new(UniqueIDGenerator)
 		uniqueIDGenerator.sf = sonyflake.NewSonyflake(st)
 		if uniqueIDGenerator.sf == nil {
@@ -0,0 +1,280 @@
+// Package upload 保存用户上传的文件：校验大小与类型，写入 pkg/storage 并记录 File 实体，
+// 通过签名链接读取。
+package upload
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"go-web/ent"
+	"go-web/pkg/config"
+	goWebErrors "go-web/pkg/errors"
+	"go-web/pkg/export"
+	"go-web/pkg/storage"
+	"go-web/pkg/uid"
+	"go-web/pkg/viewer"
+
+	"github.com/google/wire"
+	"go.uber.org/zap"
+)
+
+var ProviderSet = wire.NewSet(NewService)
+
+const (
+	// sniffLen 识别文件类型读取的字节数，与 http.DetectContentType 一致
+	sniffLen = 512
+	// maxNameLen 文件名的最大长度（字节）
+	maxNameLen = 255
+)
+
+// Limits 一类文件的上传限制
+type Limits struct {
+	// 最大大小（字节）
+	MaxSize int64
+	// 允许的文件类型
+	ContentTypes []string
+}
+
+// Service 文件上传服务
+type Service struct {
+	client  *ent.Client
+	storage storage.Storage
+	logger  *zap.Logger
+	signer  *export.Signer
+	baseURL string
+	linkTTL time.Duration
+	avatar  Limits
+}
+
+// NewService 创建文件上传服务
+func NewService(cfg *config.Config, client *ent.Client, store storage.Storage, logger *zap.Logger) *Service {
+	uc := cfg.Upload
+	return &Service{
+		client:  client,
+		storage: store,
+		logger:  logger.With(zap.String("component", "upload")),
+		signer:  export.NewSigner(uc.SigningKey),
+		baseURL: strings.TrimSuffix(uc.BaseURL, "/"),
+		linkTTL: uc.LinkTTL,
+		avatar:  Limits{MaxSize: uc.Avatar.MaxSize, ContentTypes: uc.Avatar.ContentTypes},
+	}
+}
+
+// SetAvatar 上传用户头像，替换原有头像并删除原头像文件
+func (s *Service) SetAvatar(ctx context.Context, userID uint64, name string, r io.Reader, size int64) (*ent.User, error) {
+	sysCtx := viewer.NewSystemContext(ctx)
+	obj, err := s.put(ctx, name, r, size, s.avatar)
+	if err != nil {
+		return nil, err
+	}
+
+	var old *uint64
+	err = s.withTx(sysCtx, func(tx *ent.Tx) error {
+		u, err := tx.User.Get(sysCtx, userID)
+		if err != nil {
+			return err
+		}
+		old = u.AvatarID
+		if err := tx.File.Create().
+			SetID(obj.id).
+			SetName(obj.name).
+			SetSize(obj.size).
+			SetContentType(obj.contentType).
+			SetChecksum(obj.checksum).
+			SetOwnerID(userID).
+			Exec(sysCtx); err != nil {
+			return err
+		}
+		if err := tx.User.UpdateOneID(userID).SetAvatarID(obj.id).Exec(sysCtx); err != nil {
+			return err
+		}
+		if old != nil {
+			return tx.File.DeleteOneID(*old).Exec(sysCtx)
+		}
+		return nil
+	})
+	if err != nil {
+		s.remove(obj.id)
+		return nil, fmt.Errorf("failed to set avatar: %w", err)
+	}
+	if old != nil {
+		s.remove(*old)
+	}
+	return s.client.User.Get(ctx, userID)
+}
+
+// RemoveAvatar 删除用户头像，没有头像时不做任何修改
+func (s *Service) RemoveAvatar(ctx context.Context, userID uint64) (*ent.User, error) {
+	sysCtx := viewer.NewSystemContext(ctx)
+	var old *uint64
+	err := s.withTx(sysCtx, func(tx *ent.Tx) error {
+		u, err := tx.User.Get(sysCtx, userID)
+		if err != nil {
+			return err
+		}
+		if old = u.AvatarID; old == nil {
+			return nil
+		}
+		if err := tx.User.UpdateOneID(userID).ClearAvatarID().Exec(sysCtx); err != nil {
+			return err
+		}
+		return tx.File.DeleteOneID(*old).Exec(sysCtx)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove avatar: %w", err)
+	}
+	if old != nil {
+		s.remove(*old)
+	}
+	return s.client.User.Get(ctx, userID)
+}
+
+// URL 返回文件的签名链接，每次调用生成新的链接
+func (s *Service) URL(f *ent.File) string {
+	expires := time.Now().Add(s.linkTTL).Truncate(time.Second)
+	q := url.Values{}
+	q.Set("expires", strconv.FormatInt(expires.Unix(), 10))
+	q.Set("signature", s.signer.Sign(f.ID, expires))
+	return fmt.Sprintf("%s/files/%d?%s", s.baseURL, f.ID, q.Encode())
+}
+
+// Open 校验文件链接并打开文件内容，签名无效、链接过期或文件不存在时返回 ErrNotFound，不区分具体原因。
+// 调用方负责关闭返回的内容。
+func (s *Service) Open(ctx context.Context, id uint64, expires time.Time, signature string) (*ent.File, io.ReadCloser, error) {
+	notFound := goWebErrors.New(goWebErrors.ErrNotFound, "not_found", "file not found or link expired")
+	if !s.signer.Verify(id, expires, signature) {
+		return nil, nil, notFound
+	}
+	// 链接不携带租户，ID 在全部租户中唯一，签名已证明持有者有权读取
+	f, err := s.client.File.Get(viewer.NewSystemContext(context.Background()), id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, notFound
+		}
+		return nil, nil, fmt.Errorf("failed to query file: %w", err)
+	}
+	body, err := s.storage.Get(ctx, key(id))
+	if err != nil {
+		if errors.Is(err, storage.ErrNotExist) {
+			return nil, nil, notFound
+		}
+		return nil, nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	return f, body, nil
+}
+
+// Remove 删除文件内容，文件记录已删除后调用，例如注销账号
+func (s *Service) Remove(ctx context.Context, ids ...uint64) error {
+	for _, id := range ids {
+		if err := s.storage.Delete(ctx, key(id)); err != nil {
+			return fmt.Errorf("failed to delete file %d: %w", id, err)
+		}
+	}
+	return nil
+}
+
+// object 已写入存储的文件
+type object struct {
+	id          uint64
+	name        string
+	size        int64
+	contentType string
+	checksum    string
+}
+
+// put 校验大小与类型后写入存储。类型按文件内容识别，不信任客户端提供的类型与扩展名
+func (s *Service) put(ctx context.Context, name string, r io.Reader, size int64, limits Limits) (*object, error) {
+	if size <= 0 {
+		return nil, goWebErrors.New(goWebErrors.ErrInvalidParam, "invalid_param", "file is empty")
+	}
+	if size > limits.MaxSize {
+		return nil, goWebErrors.New(goWebErrors.ErrInvalidParam, "invalid_param",
+			fmt.Sprintf("file is larger than %d bytes", limits.MaxSize))
+	}
+
+	br := bufio.NewReaderSize(r, sniffLen)
+	head, err := br.Peek(sniffLen)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, bufio.ErrBufferFull) {
+		return nil, fmt.Errorf("failed to read upload: %w", err)
+	}
+	contentType, _, _ := mime.ParseMediaType(http.DetectContentType(head))
+	if !slices.Contains(limits.ContentTypes, contentType) {
+		return nil, goWebErrors.New(goWebErrors.ErrInvalidParam, "invalid_param",
+			fmt.Sprintf("file type %s is not allowed", contentType))
+	}
+
+	obj := &object{
+		id:          uid.NewUniqueIDGenerator().NewID(),
+		name:        cleanName(name),
+		size:        size,
+		contentType: contentType,
+	}
+	h := sha256.New()
+	if err := s.storage.Put(ctx, key(obj.id), io.TeeReader(br, h), size, contentType); err != nil {
+		return nil, fmt.Errorf("failed to store file: %w", err)
+	}
+	obj.checksum = hex.EncodeToString(h.Sum(nil))
+	return obj, nil
+}
+
+// remove 删除文件内容，失败时只记录日志，残留的内容不会再被引用
+func (s *Service) remove(id uint64) {
+	if err := s.storage.Delete(context.Background(), key(id)); err != nil {
+		s.logger.Warn("failed to delete file", zap.Uint64("file_id", id), zap.Error(err))
+	}
+}
+
+// withTx 在事务中执行 fn
+func (s *Service) withTx(ctx context.Context, fn func(tx *ent.Tx) error) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: %v", err, rerr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// key 返回文件在存储中的路径，只由 ID 决定，不使用用户提供的文件名
+func key(id uint64) string {
+	return "files/" + strconv.FormatUint(id, 10)
+}
+
+// cleanName 去除文件名中的路径与控制字符，并按字符边界截断到最大长度
+func cleanName(name string) string {
+	name = path.Base(strings.ReplaceAll(name, "\\", "/"))
+	name = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) || r == utf8.RuneError {
+			return -1
+		}
+		return r
+	}, name)
+	name = strings.TrimSpace(name)
+	if name == "" || name == "." || name == "/" {
+		return "file"
+	}
+	for len(name) > maxNameLen {
+		_, size := utf8.DecodeLastRuneInString(name)
+		name = name[:len(name)-size]
+	}
+	return name
+}
@@ -0,0 +1,359 @@
+package userimport
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+
+	"go-web/ent"
+	"go-web/ent/schema"
+	"go-web/ent/user"
+	"go-web/pkg/auth"
+	goWebErrors "go-web/pkg/errors"
+	"go-web/pkg/password"
+	"go-web/pkg/util"
+
+	"go.uber.org/zap"
+)
+
+// DefaultBatchSize 每批写入的用户数量
+const DefaultBatchSize = 500
+
+// Options 导入选项
+type Options struct {
+	// Format 文件格式
+	Format Format
+	// BatchSize 每批写入的用户数量，不大于 0 时使用 DefaultBatchSize
+	BatchSize int
+	// DryRun 只校验不写入
+	DryRun bool
+	// Offset 跳过行号不大于该值的记录，用于从上次中断处继续导入
+	Offset int
+}
+
+// Result 导入结果
+type Result struct {
+	// Read 读取的记录数，不包含被 Offset 跳过的记录
+	Read int
+	// Imported 写入的用户数，DryRun 时为校验通过的用户数
+	Imported int
+	// Skipped 账号已存在而跳过的用户数
+	Skipped int
+	// Failed 校验或写入失败的记录数
+	Failed int
+	// Committed 已提交的最后一行行号，中断后以此作为 Offset 继续导入
+	Committed int
+}
+
+// Importer 从 CSV 或 JSONL 批量导入用户
+type Importer struct {
+	client *ent.Client
+	logger *zap.Logger
+}
+
+// New 创建用户导入器
+func New(client *ent.Client, logger *zap.Logger) *Importer {
+	return &Importer{client: client, logger: logger}
+}
+
+// Run 从 r 读取用户并按批写入 ctx 所属的租户，ctx 必须携带租户与写入用户的权限。
+// 每行的校验规则与 GraphQL 的 createUser 相同，校验失败与账号已存在的记录写入 report，不中断导入。
+// 同一批用户在一个事务中写入，账号在写入时已存在的记录被忽略，因此重复导入同一文件不会产生重复用户。
+func (im *Importer) Run(ctx context.Context, r io.Reader, report io.Writer, opts Options) (*Result, error) {
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = DefaultBatchSize
+	}
+	rows, err := newReader(r, opts.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	run := &run{
+		Importer: im,
+		opts:     opts,
+		report:   newReport(report),
+		accounts: make(map[string]int),
+		emails:   make(map[string]int),
+		result:   &Result{Committed: opts.Offset},
+	}
+	batch := make([]*row, 0, opts.BatchSize)
+	for {
+		rw, err := rows.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return run.result, fmt.Errorf("failed to read line %d: %w", run.lastLine+1, err)
+		}
+		if rw.Line <= opts.Offset {
+			continue
+		}
+		run.result.Read++
+		run.lastLine = rw.Line
+
+		if err := run.check(rw); err != nil {
+			run.fail(rw, err)
+			continue
+		}
+		batch = append(batch, rw)
+		if len(batch) < opts.BatchSize {
+			continue
+		}
+		if err := run.flush(ctx, batch); err != nil {
+			return run.result, err
+		}
+		batch = batch[:0]
+	}
+	if err := run.flush(ctx, batch); err != nil {
+		return run.result, err
+	}
+	run.result.Committed = max(run.result.Committed, run.lastLine)
+	return run.result, run.report.Flush()
+}
+
+// run 一次导入的状态
+type run struct {
+	*Importer
+	opts   Options
+	report *report
+	result *Result
+	// accounts 与 emails 记录文件中已出现的账号与邮箱及其行号，用于发现文件内的重复
+	accounts map[string]int
+	emails   map[string]int
+	lastLine int
+}
+
+// check 按 createUser 的规则校验一行记录，并规范化邮箱
+func (r *run) check(rw *row) error {
+	if rw.Err != nil {
+		return rw.Err
+	}
+	in := &rw.Input
+	if in.Account == "" {
+		return errors.New("account is required")
+	}
+	if err := user.NameValidator(in.Name); err != nil {
+		return fmt.Errorf("name: %w", err)
+	}
+	if err := user.AccountValidator(in.Account); err != nil {
+		return fmt.Errorf("account: %w", err)
+	}
+	if in.Email != nil {
+		email := util.NormalizeEmail(*in.Email)
+		if err := user.EmailValidator(email); err != nil {
+			return fmt.Errorf("email: %w", err)
+		}
+		in.Email = &email
+	}
+	if err := auth.ValidatePassword(in.Password); err != nil {
+		return errors.New(errorMessage(err))
+	}
+
+	// 数据库的账号与邮箱索引不区分大小写，文件内按小写判断重复
+	account := strings.ToLower(in.Account)
+	if line, ok := r.accounts[account]; ok {
+		return fmt.Errorf("account duplicates line %d", line)
+	}
+	if in.Email != nil {
+		if line, ok := r.emails[*in.Email]; ok {
+			return fmt.Errorf("email duplicates line %d", line)
+		}
+		r.emails[*in.Email] = rw.Line
+	}
+	r.accounts[account] = rw.Line
+	return nil
+}
+
+// flush 跳过账号或邮箱已存在的记录后写入一批用户
+func (r *run) flush(ctx context.Context, batch []*row) error {
+	if len(batch) == 0 {
+		return nil
+	}
+	batch, err := r.exclude(ctx, batch)
+	if err != nil {
+		return err
+	}
+
+	if !r.opts.DryRun && len(batch) > 0 {
+		if err := hashPasswords(batch); err != nil {
+			return err
+		}
+		if err := r.insert(ctx, batch); err != nil {
+			// 整批写入失败时逐条写入，找出失败的记录
+			r.logger.Warn("batch insert failed, retrying rows one by one", zap.Error(err))
+			for _, rw := range batch {
+				if err := r.insert(ctx, []*row{rw}); err != nil {
+					r.fail(rw, err)
+					continue
+				}
+				r.result.Imported++
+			}
+			batch = nil
+		}
+	}
+	r.result.Imported += len(batch)
+	r.result.Committed = r.lastLine
+	if !r.opts.DryRun {
+		r.logger.Info("imported users",
+			zap.Int("imported", r.result.Imported),
+			zap.Int("committed_line", r.result.Committed),
+		)
+	}
+	return r.report.Flush()
+}
+
+// exclude 查询租户中已存在的账号与邮箱，账号已存在的记录记为跳过，邮箱已被占用的记录记为失败。
+// 已软删除的用户仍占用账号与邮箱，查询时一并包含。
+func (r *run) exclude(ctx context.Context, batch []*row) ([]*row, error) {
+	accounts := make([]string, 0, len(batch))
+	var emails []string
+	for _, rw := range batch {
+		accounts = append(accounts, rw.Input.Account)
+		if rw.Input.Email != nil {
+			emails = append(emails, *rw.Input.Email)
+		}
+	}
+	ctx = schema.IncludeDeleted(ctx)
+	existing, err := r.client.User.Query().
+		Where(user.Or(user.AccountIn(accounts...), user.EmailIn(emails...))).
+		Select(user.FieldAccount, user.FieldEmail).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query existing users: %w", err)
+	}
+	takenAccounts := make(map[string]bool, len(existing))
+	takenEmails := make(map[string]bool, len(existing))
+	for _, u := range existing {
+		takenAccounts[strings.ToLower(u.Account)] = true
+		if u.Email != nil {
+			takenEmails[*u.Email] = true
+		}
+	}
+
+	kept := batch[:0]
+	for _, rw := range batch {
+		switch {
+		case takenAccounts[strings.ToLower(rw.Input.Account)]:
+			r.result.Skipped++
+			r.report.Write(rw, "skipped", "account already exists")
+		case rw.Input.Email != nil && takenEmails[*rw.Input.Email]:
+			r.fail(rw, errors.New("email is already in use"))
+		default:
+			kept = append(kept, rw)
+		}
+	}
+	return kept, nil
+}
+
+// insert 在一个事务中写入用户，账号已存在的记录被忽略
+func (r *run) insert(ctx context.Context, batch []*row) error {
+	// 密码已由 hashPasswords 计算为哈希
+	ctx = schema.PasswordHashed(ctx)
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	builders := make([]*ent.UserCreate, 0, len(batch))
+	for _, rw := range batch {
+		builders = append(builders, tx.User.Create().SetInput(rw.Input))
+	}
+	err = tx.User.CreateBulk(builders...).
+		OnConflictColumns(user.FieldTenantID, user.FieldAccount).
+		DoNothing().
+		Exec(ctx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	return tx.Commit()
+}
+
+// fail 记录一行失败的记录
+func (r *run) fail(rw *row, err error) {
+	r.result.Failed++
+	r.report.Write(rw, "failed", errorMessage(err))
+}
+
+// hashPasswords 并发计算一批用户的密码哈希，insert 以 schema.PasswordHashed 写入，hashPassword hook 不再重复计算
+func hashPasswords(batch []*row) error {
+	passwords := password.Default()
+	var (
+		wg    sync.WaitGroup
+		once  sync.Once
+		first error
+		next  = make(chan *row)
+	)
+	for range min(runtime.GOMAXPROCS(0), len(batch)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for rw := range next {
+				hash, err := passwords.Hash(rw.Input.Password)
+				if err != nil {
+					once.Do(func() { first = fmt.Errorf("failed to hash password: %w", err) })
+					continue
+				}
+				rw.Input.Password = hash
+			}
+		}()
+	}
+	for _, rw := range batch {
+		next <- rw
+	}
+	close(next)
+	wg.Wait()
+	return first
+}
+
+// rollback 回滚事务并返回原始错误
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		err = fmt.Errorf("%w: %v", err, rerr)
+	}
+	return err
+}
+
+// errorMessage 返回写入报告的错误描述，业务错误只保留详情
+func errorMessage(err error) string {
+	var e *goWebErrors.Error
+	if errors.As(err, &e) && e.Details != "" {
+		return e.Details
+	}
+	return err.Error()
+}
+
+// report 逐行记录校验失败与跳过的记录，格式为 CSV
+type report struct {
+	w *csv.Writer
+}
+
+func newReport(w io.Writer) *report {
+	if w == nil {
+		return &report{}
+	}
+	cw := csv.NewWriter(w)
+	_ = cw.Write([]string{"line", "account", "status", "error"})
+	return &report{w: cw}
+}
+
+// Write 写入一行报告
+func (r *report) Write(rw *row, status, message string) {
+	if r.w == nil {
+		return
+	}
+	_ = r.w.Write([]string{strconv.Itoa(rw.Line), rw.Input.Account, status, message})
+}
+
+// Flush 将已写入的报告刷新到底层 writer
+func (r *report) Flush() error {
+	if r.w == nil {
+		return nil
+	}
+	r.w.Flush()
+	return r.w.Error()
+}
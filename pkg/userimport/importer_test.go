@@ -0,0 +1,43 @@
+package userimport
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"go-web/ent/user"
+	"go-web/pkg/password"
+	"go-web/pkg/testutil"
+	"go-web/pkg/viewer"
+
+	"go.uber.org/zap"
+)
+
+func TestImportHashesPasswords(t *testing.T) {
+	client := testutil.NewClient(t)
+	_, ctx := testutil.NewTenant(t, client, "acme")
+
+	const hashShaped = "$2a$04$abcdefghijklmnopqrstuuABCDEFGHIJKLMNOPQRSTUVWXYZ01234"
+	csv := "name,sex,age,account,password\n" +
+		"Alice,false,30,alice,correct horse\n" +
+		"Bob,true,40,bob," + hashShaped + "\n"
+
+	res, err := New(client, zap.NewNop()).Run(ctx, strings.NewReader(csv), io.Discard, Options{Format: FormatCSV})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Imported != 2 || res.Failed != 0 {
+		t.Fatalf("result = %+v, want 2 imported", res)
+	}
+
+	for account, plain := range map[string]string{"alice": "correct horse", "bob": hashShaped} {
+		u := client.User.Query().Where(user.Account(account)).OnlyX(viewer.NewSystemContext(context.Background()))
+		if u.Password == plain {
+			t.Fatalf("%s: password stored as given", account)
+		}
+		if ok, _, err := password.Default().Verify(plain, u.Password); err != nil || !ok {
+			t.Fatalf("%s: stored hash does not verify the imported password: ok=%v err=%v", account, ok, err)
+		}
+	}
+}
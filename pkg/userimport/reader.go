@@ -0,0 +1,189 @@
+package userimport
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"go-web/ent"
+)
+
+// Format 导入文件格式
+type Format string
+
+const (
+	// FormatCSV 首行为表头的 CSV，列为 name,sex,age,account,email,password，email 可以省略
+	FormatCSV Format = "csv"
+	// FormatJSONL 每行一个 JSON 对象，字段与 GraphQL 的 CreateUserInput 相同
+	FormatJSONL Format = "jsonl"
+)
+
+// csvColumns CSV 必须包含的列
+var csvColumns = []string{"name", "sex", "age", "account", "password"}
+
+// row 读取到的一条记录，Err 不为空时表示该行无法解析
+type row struct {
+	Line  int
+	Input ent.CreateUserInput
+	Err   error
+}
+
+// reader 逐行读取记录，不会一次性载入整个文件
+type reader interface {
+	// Next 返回下一条记录，读取完毕时返回 io.EOF
+	Next() (*row, error)
+}
+
+// newReader 根据格式创建读取器
+func newReader(r io.Reader, format Format) (reader, error) {
+	switch format {
+	case FormatCSV:
+		return newCSVReader(r)
+	case FormatJSONL:
+		return &jsonlReader{scanner: newScanner(r)}, nil
+	default:
+		return nil, fmt.Errorf("unsupported format %q", format)
+	}
+}
+
+// csvReader 读取 CSV，行号为记录在文件中的起始行
+type csvReader struct {
+	r       *csv.Reader
+	columns map[string]int
+}
+
+func newCSVReader(r io.Reader) (*csvReader, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+	cr.ReuseRecord = true
+
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read csv header: %w", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = i
+	}
+	for _, name := range csvColumns {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("csv header is missing column %q", name)
+		}
+	}
+	return &csvReader{r: cr, columns: columns}, nil
+}
+
+func (r *csvReader) Next() (*row, error) {
+	record, err := r.r.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, io.EOF
+	}
+	line, _ := r.r.FieldPos(0)
+	if err != nil {
+		var pe *csv.ParseError
+		if errors.As(err, &pe) {
+			return &row{Line: pe.StartLine, Err: pe.Err}, nil
+		}
+		return nil, err
+	}
+
+	get := func(name string) string {
+		if i, ok := r.columns[name]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+	out := &row{Line: line}
+	out.Input.Name = get("name")
+	out.Input.Account = get("account")
+	out.Input.Password = get("password")
+	if email := get("email"); email != "" {
+		out.Input.Email = &email
+	}
+	if out.Input.Sex, err = strconv.ParseBool(get("sex")); err != nil {
+		out.Err = fmt.Errorf("sex must be true or false")
+		return out, nil
+	}
+	if out.Input.Age, err = strconv.Atoi(get("age")); err != nil {
+		out.Err = fmt.Errorf("age must be an integer")
+		return out, nil
+	}
+	return out, nil
+}
+
+// jsonlReader 读取 JSONL，空行被忽略
+type jsonlReader struct {
+	scanner *bufio.Scanner
+	line    int
+}
+
+// jsonlRecord JSONL 中的一条记录，必填字段为指针以区分缺失与零值
+type jsonlRecord struct {
+	Name     *string `json:"name"`
+	Sex      *bool   `json:"sex"`
+	Age      *int    `json:"age"`
+	Account  *string `json:"account"`
+	Email    *string `json:"email"`
+	Password *string `json:"password"`
+}
+
+func (r *jsonlReader) Next() (*row, error) {
+	for r.scanner.Scan() {
+		r.line++
+		data := bytes.TrimSpace(r.scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+
+		out := &row{Line: r.line}
+		var rec jsonlRecord
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&rec); err != nil {
+			out.Err = fmt.Errorf("invalid json: %w", err)
+			return out, nil
+		}
+		for _, f := range []struct {
+			name    string
+			missing bool
+		}{
+			{"name", rec.Name == nil}, {"sex", rec.Sex == nil}, {"age", rec.Age == nil},
+			{"account", rec.Account == nil}, {"password", rec.Password == nil},
+		} {
+			if f.missing {
+				out.Err = fmt.Errorf("%s is required", f.name)
+				if rec.Account != nil {
+					out.Input.Account = *rec.Account
+				}
+				return out, nil
+			}
+		}
+		out.Input = ent.CreateUserInput{
+			Name:     *rec.Name,
+			Sex:      *rec.Sex,
+			Age:      *rec.Age,
+			Account:  *rec.Account,
+			Email:    rec.Email,
+			Password: *rec.Password,
+		}
+		return out, nil
+	}
+	if err := r.scanner.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.EOF
+}
+
+// newScanner 创建按行读取的 scanner，允许较长的行
+func newScanner(r io.Reader) *bufio.Scanner {
+	s := bufio.NewScanner(r)
+	s.Buffer(make([]byte, 64*1024), 1024*1024)
+	return s
+}
@@ -0,0 +1,21 @@
+package util
+
+import (
+	"errors"
+	"net/mail"
+	"strings"
+)
+
+// NormalizeEmail 去除邮箱首尾空白并转为小写
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// ValidateEmail 校验邮箱格式，只接受不带显示名称的地址
+func ValidateEmail(email string) error {
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email {
+		return errors.New("invalid email address")
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package viewer
+
+import (
+	"context"
+)
+
+const (
+	// RoleAdmin 管理员角色，拥有全部权限
+	RoleAdmin = "admin"
+	// PermissionEmailVerified 邮箱已验证的用户自动获得的隐式权限，
+	// 用于限制未验证邮箱的用户访问，不需要在权限表中创建
+	PermissionEmailVerified = "email:verified"
+)
+
+// Viewer 当前请求的访问者
+type Viewer struct {
+	// 用户 ID
+	ID uint64
+	// 角色列表
+	Roles []string
+	// 权限列表
+	Permissions []string
+}
+
+// HasRole 判断访问者是否拥有指定角色
+func (v *Viewer) HasRole(role string) bool {
+	if v == nil {
+		return false
+	}
+	for _, r := range v.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// HasPermission 判断访问者是否拥有指定权限，管理员拥有全部权限
+func (v *Viewer) HasPermission(permission string) bool {
+	if v == nil {
+		return false
+	}
+	if v.HasRole(RoleAdmin) {
+		return true
+	}
+	for _, p := range v.Permissions {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+type (
+	ctxKey    struct{}
+	systemKey struct{}
+)
+
+// NewContext 返回携带访问者信息的 context
+func NewContext(ctx context.Context, v *Viewer) context.Context {
+	return context.WithValue(ctx, ctxKey{}, v)
+}
+
+// FromContext 从 context 获取访问者，匿名访问返回 nil
+func FromContext(ctx context.Context) *Viewer {
+	v, _ := ctx.Value(ctxKey{}).(*Viewer)
+	return v
+}
+
+// NewSystemContext 返回以系统身份访问的 context，ent 隐私策略对其全部放行。
+// 仅用于迁移、后台任务、管理工具以及登录前的凭证校验等不存在访问者的场景。
+func NewSystemContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, systemKey{}, true)
+}
+
+// IsSystem 判断 context 是否以系统身份访问
+func IsSystem(ctx context.Context) bool {
+	system, _ := ctx.Value(systemKey{}).(bool)
+	return system
+}
@@ -7,6 +7,7 @@ import (
 
 	"go-web/interface/http"
 	"go-web/pkg/config"
+	"go-web/pkg/erasure"
 	"go-web/pkg/log"
 	"go-web/pkg/mysql"
 
@@ -18,22 +19,26 @@ import (
 var ProviderSet = wire.NewSet(NewServer, NewTopLevelCtx)
 
 type Server struct {
-	ctx    context.Context
-	http   *http.Server
-	logger *zap.Logger
-	config *config.Config
+	ctx     context.Context
+	http    *http.Server
+	erasure *erasure.Service
+	logger  *zap.Logger
+	config  *config.Config
+	// stop 停止后台任务
+	stop context.CancelFunc
 }
 
 func NewTopLevelCtx() context.Context {
 	return context.Background()
 }
 
-func NewServer(ctx context.Context, http *http.Server, logger *zap.Logger, cfg *config.Config) *Server {
+func NewServer(ctx context.Context, http *http.Server, erasure *erasure.Service, logger *zap.Logger, cfg *config.Config) *Server {
 	return &Server{
-		ctx:    ctx,
-		http:   http,
-		logger: logger,
-		config: cfg,
+		ctx:     ctx,
+		http:    http,
+		erasure: erasure,
+		logger:  logger,
+		config:  cfg,
 	}
 }
 
@@ -41,6 +46,13 @@ func (server *Server) Start() {
 	if server.http != nil {
 		server.http.StartServer()
 	}
+
+	// 后台任务：执行冷静期结束的账号注销
+	ctx, stop := context.WithCancel(server.ctx)
+	server.stop = stop
+	if server.erasure != nil {
+		go server.erasure.Run(ctx)
+	}
 }
 
 func (server *Server) AwaitSignal() {
@@ -68,6 +80,10 @@ func (server *Server) AwaitSignal() {
 			}
 		}
 
+		if server.stop != nil {
+			server.stop()
+		}
+
 		server.logger.Info("closing database connections...")
 		if err := mysql.CloseMysql(); err != nil {
 			server.logger.Error("failed to close database connections",